@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	"github.com/gaterace/dml-go/pkg/dml"
 
@@ -27,6 +28,7 @@ import (
 	"os"
 	"os/user"
 	"regexp"
+	"sort"
 	"strconv"
 
 	pb "github.com/gaterace/mledger/pkg/mserviceledger"
@@ -49,18 +51,79 @@ var name = flag.String("name", "", "name")
 var description = flag.String("desc", "", "description")
 var sdate = flag.String("sdate", "", "start date")
 var edate = flag.String("edate", "", "end date")
+var adate = flag.String("adate", "", "as of date")
 var guid = flag.String("guid", "", "guid")
 var orgid = flag.String("orgid", "", "orgid")
 var version = flag.Int64("version", -1, "version")
 var id = flag.Int64("id", 0, "id")
 var type_name = flag.String("type", "", "type")
 var type_id = flag.Int64("type_id", 0, "type id")
+var category = flag.String("category", "", "account category")
+var normal = flag.String("normal", "", "normal balance side")
 var tdate = flag.String("tdate", "", "transaction date")
 var from_party = flag.Int64("from_party", -1, "from party id")
 var to_party = flag.Int64("to_party", -1, "to party id")
 var via_key = flag.String("via_key", "", "posted via key")
 var via_date = flag.String("via_date", "", "posted via date")
 var json_str = flag.String("json", "", "transaction details as json")
+var calendar = flag.String("calendar", "", "fiscal calendar type")
+var period = flag.Int64("period", 0, "fiscal period number")
+var status = flag.String("status", "", "fiscal period or transaction status")
+var parent = flag.String("parent", "", "parent account guid")
+var rollup = flag.Bool("rollup", false, "roll child account balances up into parents")
+var code = flag.String("code", "", "account code")
+var range_start = flag.Int64("range_start", -1, "start of account code range")
+var range_end = flag.Int64("range_end", -1, "end of account code range")
+var currency = flag.String("currency", "", "currency code")
+var rate = flag.String("rate", "", "exchange rate")
+var gain = flag.String("gain", "", "foreign exchange gain account guid")
+var loss = flag.String("loss", "", "foreign exchange loss account guid")
+var source = flag.String("source", "", "exchange rate source")
+var reverse = flag.Bool("reverse", false, "reverse on the first day of the next period")
+var value = flag.String("value", "", "dimension value")
+var dim = flag.String("dim", "", "dimension filter as name=value")
+var amt = flag.String("amt", "", "amount")
+var from_period = flag.Int64("from_period", 0, "first fiscal period number")
+var to_period = flag.Int64("to_period", 0, "last fiscal period number")
+var by_period = flag.Bool("by_period", false, "report each fiscal period separately")
+var replace = flag.Bool("replace", false, "replace existing entries")
+var frequency = flag.String("frequency", "", "recurrence frequency")
+var day = flag.Int64("day", 0, "day of month")
+var count = flag.Int64("count", 0, "number of upcoming occurrences")
+var reverse_mode = flag.String("reverse_mode", "", "automatic reversal mode")
+var rdate = flag.String("rdate", "", "automatic reversal date")
+var ddate = flag.String("ddate", "", "payment due date")
+var partner = flag.String("partner", "", "partner organization guid")
+var due_from = flag.String("due_from", "", "intercompany due from account guid")
+var due_to = flag.String("due_to", "", "intercompany due to account guid")
+var partner_json = flag.String("partner_json", "", "partner organization transaction details as json")
+var group = flag.String("group", "", "organization group name")
+var partner_account = flag.String("partner_account", "", "partner organization account guid")
+var format = flag.String("format", "", "bank statement format")
+var file = flag.String("file", "", "bank statement file")
+var window = flag.Int64("window", 0, "days between statement and transaction dates when matching")
+var match_status = flag.String("match_status", "", "bank statement line match status")
+var tran_id = flag.Int64("tran_id", 0, "transaction id")
+var seq = flag.Int64("seq", 0, "transaction detail sequence number")
+var subledger = flag.String("subledger", "", "receivable or payable subledger")
+var party = flag.Int64("party", 0, "transaction party id")
+var charge_id = flag.Int64("charge_id", 0, "charge transaction id")
+var charge_seq = flag.Int64("charge_seq", 0, "charge transaction detail sequence number")
+var include_closed = flag.Bool("include_closed", false, "include fully applied open items")
+var by_tdate = flag.Bool("by_tdate", false, "age open items from the transaction date")
+var tax_type = flag.String("tax_type", "", "recoverable or payable tax")
+var acq_date = flag.String("acq_date", "", "fixed asset acquisition date")
+var cost = flag.String("cost", "", "fixed asset acquisition cost")
+var salvage = flag.String("salvage", "", "fixed asset salvage value")
+var life = flag.Int64("life", 0, "fixed asset useful life in months")
+var method = flag.String("method", "", "depreciation method")
+var factor = flag.String("factor", "", "declining balance factor")
+var asset_account = flag.String("asset_account", "", "fixed asset account guid")
+var accum_account = flag.String("accum_account", "", "accumulated depreciation account guid")
+var expense_account = flag.String("expense_account", "", "depreciation expense account guid")
+var proceeds_account = flag.String("proceeds_account", "", "disposal proceeds account guid")
+var gain_loss = flag.String("gain_loss", "", "disposal gain or loss account guid")
+var asset_status = flag.String("asset_status", "", "fixed asset status")
 
 func main() {
 	flag.Parse(true)
@@ -92,13 +155,17 @@ func main() {
 		prog := os.Args[0]
 		fmt.Printf("Command line client for mledger grpc service\n")
 		fmt.Printf("usage:\n")
-		fmt.Printf("    %s create_organization --name <name> --sdate <start_date> [--edate <end_date>]\n", prog)
+		fmt.Printf("    %s create_organization --name <name> --sdate <start_date> [--edate <end_date>] [--currency <base_currency>]\n", prog)
 		fmt.Printf("    %s update_organization --orgid <orgid> --version <version> --name <name> --sdate <start_date> [--edate <end_date>]\n", prog)
+		fmt.Printf("                  [--guid <retained_earnings_account_id>] [--currency <base_currency>] [--gain <fx_gain_account_id>]\n")
+		fmt.Printf("                  [--loss <fx_loss_account_id>]\n")
 		fmt.Printf("    %s delete_organization --orgid <orgid> --version <version>\n", prog)
 		fmt.Printf("    %s get_organization_by_id --orgid <orgid> \n", prog)
 		fmt.Printf("    %s get_organizations_by_mservice \n", prog)
-		fmt.Printf("    %s create_account_type --id <id> --type <type> \n", prog)
-		fmt.Printf("    %s update_account_type --id <id> --version <version> --type <type>\n", prog)
+		fmt.Printf("    %s create_account_type --id <id> --type <type> --category <category> [--normal <debit|credit>]\n", prog)
+		fmt.Printf("    %s update_account_type --id <id> --version <version> --type <type> --category <category> [--normal <debit|credit>]\n", prog)
+		fmt.Printf("                  category is one of asset, liability, equity, revenue, expense,\n")
+		fmt.Printf("                  contra_asset, contra_liability, contra_equity, contra_revenue, contra_expense\n")
 		fmt.Printf("    %s delete_account_type --id <id> --version <version>\n", prog)
 		fmt.Printf("    %s get_account_type_by_id --id <id>\n", prog)
 		fmt.Printf("    %s get_account_types_by_mservice\n", prog)
@@ -112,22 +179,156 @@ func main() {
 		fmt.Printf("    %s delete_party --id <id> --version <version>\n", prog)
 		fmt.Printf("    %s get_party_by_id --id <id> \n", prog)
 		fmt.Printf("    %s get_parties_by_mservice\n", prog)
-		fmt.Printf("    %s create_account --orgid <orgid> --name <name> --desc <description> --type_id <type_id>\n", prog)
-		fmt.Printf("    %s update_account --guid <guid> --version <version> --name <name> --desc <description> --type_id <type_id>\n", prog)
+		fmt.Printf("    %s create_account --orgid <orgid> --name <name> --desc <description> --type_id <type_id> [--parent <parent_guid>]\n", prog)
+		fmt.Printf("                  [--code <account_code>]\n")
+		fmt.Printf("    %s update_account --guid <guid> --version <version> --name <name> --desc <description> --type_id <type_id> [--parent <parent_guid>]\n", prog)
+		fmt.Printf("                  [--code <account_code>]\n")
 		fmt.Printf("    %s delete_account --guid <guid> --version <version>\n", prog)
 		fmt.Printf("    %s get_account_by_id --guid <guid>\n", prog)
 		fmt.Printf("    %s get_accounts_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s get_account_tree --orgid <orgid>\n", prog)
+		fmt.Printf("    %s create_account_code_range --orgid <orgid> --category <category> --range_start <range_start> --range_end <range_end>\n", prog)
+		fmt.Printf("    %s update_account_code_range --orgid <orgid> --category <category> --version <version> --range_start <range_start> --range_end <range_end>\n", prog)
+		fmt.Printf("    %s delete_account_code_range --orgid <orgid> --category <category> --version <version>\n", prog)
+		fmt.Printf("    %s get_account_code_ranges_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s create_exchange_rate --orgid <orgid> --currency <currency> --sdate <effective_date> --rate <rate>\n", prog)
+		fmt.Printf("    %s update_exchange_rate --orgid <orgid> --currency <currency> --sdate <effective_date> --version <version> --rate <rate>\n", prog)
+		fmt.Printf("    %s delete_exchange_rate --orgid <orgid> --currency <currency> --sdate <effective_date> --version <version>\n", prog)
+		fmt.Printf("    %s get_exchange_rates_by_organization --orgid <orgid> [--currency <currency>]\n", prog)
+		fmt.Printf("    %s revalue_foreign_balances --orgid <orgid> --adate <revaluation_date> --type_id <type_id> [--source <exchange_rates|request>]\n", prog)
+		fmt.Printf("                  [--json <json>] [--reverse]\n")
+		fmt.Println("    example: --source request --json '[{\"cur\": \"EUR\", \"rate\": \"1.1234\"}, {\"cur\": \"GBP\", \"rate\": \"1.2845\"}]'")
+		fmt.Printf("    %s create_dimension --orgid <orgid> --name <dimension_name> [--desc <description>]\n", prog)
+		fmt.Printf("    %s update_dimension --orgid <orgid> --name <dimension_name> --version <version> [--desc <description>]\n", prog)
+		fmt.Printf("    %s delete_dimension --orgid <orgid> --name <dimension_name> --version <version>\n", prog)
+		fmt.Printf("    %s get_dimensions_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s create_dimension_value --orgid <orgid> --name <dimension_name> --value <value> [--desc <description>]\n", prog)
+		fmt.Printf("    %s update_dimension_value --orgid <orgid> --name <dimension_name> --value <value> --version <version> [--desc <description>]\n", prog)
+		fmt.Printf("    %s delete_dimension_value --orgid <orgid> --name <dimension_name> --value <value> --version <version>\n", prog)
+		fmt.Printf("    %s get_dimension_balances --orgid <orgid> --name <dimension_name> --edate <end_date> [--sdate <start_date>] [--status <status>]\n", prog)
 		fmt.Printf("    %s create_transaction --orgid <orgid> --tdate <tdate> --desc <description> --type_id <type_id> [--from_party <from_party>] \n", prog)
-		fmt.Printf("                  [--to_party <to_party> ] [--via_key <via_key> --via_date <via_date>]\n")
+		fmt.Printf("                  [--to_party <to_party> ] [--via_key <via_key> --via_date <via_date>] [--reverse_mode <immediate|on_date> [--rdate <reversal_date>]]\n")
+		fmt.Printf("                  [--ddate <due_date>]\n")
 		fmt.Printf("    %s update_transaction --id <id>  --version <version>  --tdate <tdate> --desc <description> --type_id <type_id> [--from_party <from_party>] \n", prog)
-		fmt.Printf("                  [--to_party <to_party> ] [--via_key <via_key> --via_date <via_date>]\n")
+		fmt.Printf("                  [--to_party <to_party> ] [--via_key <via_key> --via_date <via_date>] [--reverse_mode <immediate|on_date> [--rdate <reversal_date>]]\n")
+		fmt.Printf("                  [--ddate <due_date>]\n")
 		fmt.Printf("    %s delete_transaction --id <id> --version <version>\n", prog)
+		fmt.Printf("    %s reverse_transaction --id <id> --version <version> --tdate <tdate> [--desc <description>]\n", prog)
+		fmt.Printf("    %s void_transaction --id <id> --version <version>\n", prog)
+		fmt.Printf("    %s post_transaction --id <id> --version <version>\n", prog)
 		fmt.Printf("    %s get_transaction_by_id --id <id> \n", prog)
 		fmt.Printf("    %s get_transaction_wrapper_by_id --id <id> \n", prog)
-		fmt.Printf("    %s get_transaction_wrappers_by_date  --orgid <orgid> --sdate <start_date> --edate <end_date>\n", prog)
+		fmt.Printf("    %s get_transaction_wrappers_by_date  --orgid <orgid> --sdate <start_date> --edate <end_date> [--status <status>]\n", prog)
 		fmt.Printf("    %s add_transaction_details --id <id> --json <json>\n", prog)
+		fmt.Printf("    %s replace_transaction_details --id <id> --version <version> --json <json>\n", prog)
 		fmt.Println("    example: --json '[{\"aid\": \"0123456789abcdef0123456789abcdef\", \"amt\": \"10.00\", \"debit\": true}, [\"aid\": \"3210456789abcdef0123456789abcdef\", \"amt\":\"10.00\"}]'")
-
+		fmt.Println("    foreign currency: {\"aid\": \"0123456789abcdef0123456789abcdef\", \"cur\": \"EUR\", \"famt\": \"9.25\", \"debit\": true}, amt converted when omitted")
+		fmt.Println("    dimensions: {\"aid\": \"0123456789abcdef0123456789abcdef\", \"amt\": \"10.00\", \"dims\": {\"cost_center\": \"CC100\"}}")
+		fmt.Printf("    %s post_journal_entry --orgid <orgid> --tdate <tdate> --desc <description> --type_id <type_id> --json <json> [--from_party <from_party>] \n", prog)
+		fmt.Printf("                  [--to_party <to_party> ] [--via_key <via_key> --via_date <via_date>] [--reverse_mode <immediate|on_date> [--rdate <reversal_date>]]\n")
+		fmt.Printf("                  [--ddate <due_date>]\n")
+		fmt.Println("    --reverse_mode reverses the transaction on --rdate, by default the start of the next fiscal period")
+		fmt.Println("    --ddate is when payment of the receivable or payable open items of the transaction is due")
+
+		fmt.Printf("    %s get_trial_balance --orgid <orgid> --adate <as_of_date> [--status <status>] [--rollup] [--dim <name=value>]\n", prog)
+		fmt.Printf("    %s get_account_balance --guid <guid> --adate <as_of_date> [--status <status>] [--rollup] [--dim <name=value>]\n", prog)
+		fmt.Printf("    %s get_account_ledger --guid <guid> --sdate <start_date> --edate <end_date> [--status <status>] [--dim <name=value>]\n", prog)
+		fmt.Printf("    %s get_balance_sheet --orgid <orgid> --adate <as_of_date> [--status <status>] [--rollup] [--dim <name=value>]\n", prog)
+		fmt.Printf("    %s get_income_statement --orgid <orgid> --sdate <start_date> --edate <end_date> [--status <status>] [--rollup] [--dim <name=value>]\n", prog)
+		fmt.Println("    --dim <name=value> only counts details with that dimension value, --dim <name=> details without the dimension")
+		fmt.Printf("    %s create_fiscal_year --orgid <orgid> --id <fiscal_year> --sdate <start_date> --calendar <monthly|four_four_five|custom> [--json <json>]\n", prog)
+		fmt.Println("    example: --json '[{\"name\": \"Q1\", \"sdate\": \"2024-01-01\", \"edate\": \"2024-03-31\"}, {\"name\": \"Q2\", \"sdate\": \"2024-04-01\", \"edate\": \"2024-06-30\"}]'")
+		fmt.Printf("    %s delete_fiscal_year --orgid <orgid> --id <fiscal_year> --version <version>\n", prog)
+		fmt.Printf("    %s get_fiscal_years_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s get_fiscal_periods_by_year --orgid <orgid> --id <fiscal_year>\n", prog)
+		fmt.Printf("    %s update_fiscal_period_status --orgid <orgid> --id <fiscal_year> --period <period> --version <version> --status <open|soft_closed|hard_locked>\n", prog)
+		fmt.Printf("    %s close_fiscal_year --orgid <orgid> --id <fiscal_year> --version <version> --type_id <type_id>\n", prog)
+		fmt.Printf("    %s reopen_fiscal_year --orgid <orgid> --id <fiscal_year> --version <version>\n", prog)
+		fmt.Printf("    %s create_budget --orgid <orgid> --name <budget_name> --id <fiscal_year> [--desc <description>]\n", prog)
+		fmt.Printf("    %s update_budget --orgid <orgid> --name <budget_name> --version <version> [--desc <description>]\n", prog)
+		fmt.Printf("    %s delete_budget --orgid <orgid> --name <budget_name> --version <version>\n", prog)
+		fmt.Printf("    %s get_budgets_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s create_budget_amount --orgid <orgid> --name <budget_name> --guid <account_guid> --period <period> --amt <amount>\n", prog)
+		fmt.Printf("    %s update_budget_amount --orgid <orgid> --name <budget_name> --guid <account_guid> --period <period> --version <version> --amt <amount>\n", prog)
+		fmt.Printf("    %s delete_budget_amount --orgid <orgid> --name <budget_name> --guid <account_guid> --period <period> --version <version>\n", prog)
+		fmt.Printf("    %s get_budget_amounts --orgid <orgid> --name <budget_name>\n", prog)
+		fmt.Printf("    %s load_budget_amounts --orgid <orgid> --name <budget_name> --json <json> [--replace]\n", prog)
+		fmt.Println("    example: --json '[{\"aid\": \"0123456789abcdef0123456789abcdef\", \"period\": 1, \"amt\": \"1500.00\"}, {\"aid\": \"0123456789abcdef0123456789abcdef\", \"period\": 2, \"amt\": \"1750.00\"}]'")
+		fmt.Printf("    %s get_budget_vs_actual --orgid <orgid> --name <budget_name> [--from_period <period>] [--to_period <period>] [--status <status>] [--by_period]\n", prog)
+		fmt.Printf("    %s create_recurring_entry --orgid <orgid> --desc <description> --type_id <type_id> --frequency <monthly|quarterly|annually>\n", prog)
+		fmt.Printf("                  --day <day_of_month> --sdate <start_date> [--edate <end_date>] --json <json> [--from_party <from_party>] [--to_party <to_party>]\n")
+		fmt.Printf("    %s update_recurring_entry --id <id> --version <version> --desc <description> --type_id <type_id> --frequency <monthly|quarterly|annually>\n", prog)
+		fmt.Printf("                  --day <day_of_month> --sdate <start_date> [--edate <end_date>] --json <json> [--from_party <from_party>] [--to_party <to_party>]\n")
+		fmt.Printf("    %s delete_recurring_entry --id <id> --version <version>\n", prog)
+		fmt.Printf("    %s get_recurring_entry_by_id --id <id>\n", prog)
+		fmt.Printf("    %s get_recurring_entries_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s get_recurring_instances --id <id> [--count <upcoming_count>]\n", prog)
+		fmt.Printf("    %s create_intercompany_account --orgid <orgid> --partner <partner_orgid> --due_from <due_from_account_id> --due_to <due_to_account_id>\n", prog)
+		fmt.Printf("    %s update_intercompany_account --orgid <orgid> --partner <partner_orgid> --version <version> --due_from <due_from_account_id> --due_to <due_to_account_id>\n", prog)
+		fmt.Printf("    %s delete_intercompany_account --orgid <orgid> --partner <partner_orgid> --version <version>\n", prog)
+		fmt.Printf("    %s get_intercompany_accounts_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s post_intercompany_entry --orgid <orgid> --partner <partner_orgid> --tdate <tdate> --desc <description> --type_id <type_id> --json <json> --partner_json <json>\n", prog)
+		fmt.Println("    the due to or due from accounts balance each organization, --json and --partner_json must offset each other")
+		fmt.Printf("    %s create_organization_group --group <group_name> [--desc <description>]\n", prog)
+		fmt.Printf("    %s update_organization_group --group <group_name> --version <version> [--desc <description>]\n", prog)
+		fmt.Printf("    %s delete_organization_group --group <group_name> --version <version>\n", prog)
+		fmt.Printf("    %s get_organization_groups\n", prog)
+		fmt.Printf("    %s create_organization_group_member --group <group_name> --orgid <orgid>\n", prog)
+		fmt.Printf("    %s delete_organization_group_member --group <group_name> --orgid <orgid> --version <version>\n", prog)
+		fmt.Printf("    %s get_organization_group_members --group <group_name>\n", prog)
+		fmt.Printf("    %s create_group_account_map --group <group_name> --guid <account_guid> --code <group_account_code> --name <group_account_name>\n", prog)
+		fmt.Printf("    %s update_group_account_map --group <group_name> --guid <account_guid> --version <version> --code <group_account_code> --name <group_account_name>\n", prog)
+		fmt.Printf("    %s delete_group_account_map --group <group_name> --guid <account_guid> --version <version>\n", prog)
+		fmt.Printf("    %s get_group_account_maps --group <group_name>\n", prog)
+		fmt.Printf("    %s create_elimination_rule --group <group_name> --guid <account_guid> --partner_account <account_guid> [--desc <description>]\n", prog)
+		fmt.Printf("    %s update_elimination_rule --id <id> --version <version> --guid <account_guid> --partner_account <account_guid> [--desc <description>]\n", prog)
+		fmt.Printf("    %s delete_elimination_rule --id <id> --version <version>\n", prog)
+		fmt.Printf("    %s get_elimination_rules --group <group_name>\n", prog)
+		fmt.Printf("    %s get_consolidated_trial_balance --group <group_name> --adate <as_of_date> [--status <status>]\n", prog)
+		fmt.Printf("    %s get_consolidated_balance_sheet --group <group_name> --adate <as_of_date> [--status <status>]\n", prog)
+		fmt.Printf("    %s get_consolidated_income_statement --group <group_name> --sdate <start_date> --edate <end_date> [--status <status>]\n", prog)
+		fmt.Printf("    %s import_bank_statement --guid <account_guid> --format <ofx|camt053> --file <statement_file> [--window <days>]\n", prog)
+		fmt.Printf("    %s auto_match_bank_statement --guid <account_guid> [--window <days>]\n", prog)
+		fmt.Printf("    %s confirm_bank_match --id <statement_line_id> --version <version> [--tran_id <transaction_id> --seq <sequence_number>]\n", prog)
+		fmt.Println("    without --tran_id the suggested match is confirmed")
+		fmt.Printf("    %s unmatch_bank_statement_line --id <statement_line_id> --version <version>\n", prog)
+		fmt.Printf("    %s delete_bank_statement_line --id <statement_line_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_bank_statement_lines --guid <account_guid> --sdate <start_date> --edate <end_date> [--match_status <unmatched|suggested|confirmed>]\n", prog)
+		fmt.Printf("    %s get_bank_reconciliation --guid <account_guid> --sdate <start_date> --edate <end_date>\n", prog)
+		fmt.Printf("    %s create_control_account --guid <account_guid> --subledger <receivable|payable>\n", prog)
+		fmt.Printf("    %s update_control_account --guid <account_guid> --version <version> --subledger <receivable|payable>\n", prog)
+		fmt.Printf("    %s delete_control_account --guid <account_guid> --version <version>\n", prog)
+		fmt.Printf("    %s get_control_accounts_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s get_open_items --orgid <orgid> [--subledger <receivable|payable>] [--party <party_id>] [--adate <as_of_date>] [--include_closed]\n", prog)
+		fmt.Printf("    %s apply_open_item --id <credit_transaction_id> --seq <sequence_number> [--charge_id <charge_transaction_id> --charge_seq <sequence_number>]\n", prog)
+		fmt.Printf("                  [--amt <amount>]\n")
+		fmt.Println("    without --charge_id the credit is applied to the oldest open charges of the party")
+		fmt.Printf("    %s delete_open_item_application --id <application_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_open_item_applications --id <transaction_id>\n", prog)
+		fmt.Printf("    %s get_party_balances --orgid <orgid> [--subledger <receivable|payable>] [--party <party_id>] [--adate <as_of_date>]\n", prog)
+		fmt.Printf("    %s get_party_aging --orgid <orgid> [--subledger <receivable|payable>] [--party <party_id>] [--adate <as_of_date>] [--by_tdate]\n", prog)
+		fmt.Printf("    %s create_tax_code --orgid <orgid> --code <tax_code> --desc <description> --rate <percent> --guid <tax_account_guid>\n", prog)
+		fmt.Printf("                  --tax_type <recoverable|payable>\n")
+		fmt.Printf("    %s update_tax_code --orgid <orgid> --code <tax_code> --version <version> --desc <description> --rate <percent>\n", prog)
+		fmt.Printf("                  --guid <tax_account_guid> --tax_type <recoverable|payable>\n")
+		fmt.Printf("    %s delete_tax_code --orgid <orgid> --code <tax_code> --version <version>\n", prog)
+		fmt.Printf("    %s get_tax_codes_by_organization --orgid <orgid>\n", prog)
+		fmt.Printf("    %s get_tax_report --orgid <orgid> --sdate <start_date> --edate <end_date>\n", prog)
+		fmt.Println("    a detail with \"tax\": \"<tax_code>\" in --json is entered net of tax, its tax line is generated")
+		fmt.Printf("    %s create_fixed_asset --orgid <orgid> --name <asset_name> [--desc <description>] --acq_date <acquisition_date> --cost <cost>\n", prog)
+		fmt.Printf("                  [--salvage <salvage_value>] --life <months> --method <straight_line|declining_balance> [--factor <factor>]\n")
+		fmt.Printf("                  --asset_account <guid> --accum_account <guid> --expense_account <guid>\n")
+		fmt.Printf("    %s update_fixed_asset --id <fixed_asset_id> --version <version> --name <asset_name> [--desc <description>]\n", prog)
+		fmt.Printf("                  --acq_date <acquisition_date> --cost <cost> [--salvage <salvage_value>] --life <months>\n")
+		fmt.Printf("                  --method <straight_line|declining_balance> [--factor <factor>]\n")
+		fmt.Printf("                  --asset_account <guid> --accum_account <guid> --expense_account <guid>\n")
+		fmt.Printf("    %s delete_fixed_asset --id <fixed_asset_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_fixed_asset_by_id --id <fixed_asset_id>\n", prog)
+		fmt.Printf("    %s get_fixed_assets_by_organization --orgid <orgid> [--asset_status <active|fully_depreciated|disposed>]\n", prog)
+		fmt.Printf("    %s run_depreciation --orgid <orgid> --id <fiscal_year> --period <period> --type_id <type_id> [--desc <description>]\n", prog)
+		fmt.Printf("    %s dispose_fixed_asset --id <fixed_asset_id> --version <version> --tdate <disposal_date> [--amt <proceeds>]\n", prog)
+		fmt.Printf("                  [--proceeds_account <guid>] --gain_loss <guid> --type_id <type_id>\n")
+		fmt.Printf("    %s get_depreciation_entries --id <fixed_asset_id>\n", prog)
 		fmt.Printf("    %s get_server_version \n", prog)
 
 		os.Exit(1)
@@ -138,11 +339,79 @@ func main() {
 	validParams := true
 	var start_date *dml.DateTime
 	var end_date *dml.DateTime
+	var as_of_date *dml.DateTime
 	var transaction_date *dml.DateTime
 	var v_date *dml.DateTime
 	var organization_id *dml.Guid
 	var account_id *dml.Guid
+	var parent_id *dml.Guid
+	var account_category pb.AccountCategory
+	var normal_balance pb.NormalBalance
 	var details []*pb.GLTransactionDetail
+	var calendar_type pb.FiscalCalendarType
+	var period_status pb.PeriodStatus
+	var transaction_status pb.TransactionStatus
+	var periods []*pb.GLFiscalPeriod
+	var gain_id *dml.Guid
+	var loss_id *dml.Guid
+	var rate_source pb.RateSource
+	var exchange_rates []*pb.GLExchangeRate
+	var dimension_filter *pb.GLDetailDimension
+	var budget_amounts []*pb.GLBudgetAmount
+	var recurrence_frequency pb.RecurrenceFrequency
+	var auto_reverse_mode pb.AutoReverseMode
+	var auto_reverse_date *dml.DateTime
+	var due_date *dml.DateTime
+	var partner_id *dml.Guid
+	var due_from_id *dml.Guid
+	var due_to_id *dml.Guid
+	var partner_details []*pb.GLTransactionDetail
+	var partner_account_id *dml.Guid
+	var statement_format pb.StatementFormat
+	var statement_data []byte
+	var line_match_status pb.MatchStatus
+	var subledger_type pb.SubledgerType
+	var tax_type_value pb.TaxType
+	var acquisition_date *dml.DateTime
+	var asset_account_id *dml.Guid
+	var accum_account_id *dml.Guid
+	var expense_account_id *dml.Guid
+	var proceeds_account_id *dml.Guid
+	var gain_loss_id *dml.Guid
+	var depreciation_method pb.DepreciationMethod
+	var asset_status_value pb.AssetStatus
+
+	if *dim != "" {
+		dimension_filter, err = ParseDimensionFilter(*dim)
+		if err != nil {
+			fmt.Println("dim parameter not in name=value format")
+			validParams = false
+		}
+	}
+
+	auto_reverse_mode, err = ParseAutoReverseMode(*reverse_mode)
+	if err != nil {
+		fmt.Println("reverse_mode parameter invalid")
+		validParams = false
+	}
+
+	if *rdate != "" {
+		if dateValidator.MatchString(*rdate) {
+			auto_reverse_date = dml.DateTimeFromString(*rdate)
+		} else {
+			fmt.Println("rdate parameter not in yyyy-mm-dd format")
+			validParams = false
+		}
+	}
+
+	if *ddate != "" {
+		if dateValidator.MatchString(*ddate) {
+			due_date = dml.DateTimeFromString(*ddate)
+		} else {
+			fmt.Println("ddate parameter not in yyyy-mm-dd format")
+			validParams = false
+		}
+	}
 
 	switch cmd {
 	case "create_organization":
@@ -201,6 +470,30 @@ func main() {
 			validParams = false
 		}
 
+		if *guid != "" {
+			account_id, err = dml.GuidFromString(*guid)
+			if err != nil {
+				fmt.Println("guid parameter invalid")
+				validParams = false
+			}
+		}
+
+		if *gain != "" {
+			gain_id, err = dml.GuidFromString(*gain)
+			if err != nil {
+				fmt.Println("gain parameter invalid")
+				validParams = false
+			}
+		}
+
+		if *loss != "" {
+			loss_id, err = dml.GuidFromString(*loss)
+			if err != nil {
+				fmt.Println("loss parameter invalid")
+				validParams = false
+			}
+		}
+
 	case "delete_organization":
 		organization_id, err = dml.GuidFromString(*orgid)
 		if err != nil {
@@ -231,6 +524,16 @@ func main() {
 			fmt.Println("type parameter missing or invalid")
 			validParams = false
 		}
+		account_category, err = ParseAccountCategory(*category)
+		if (err != nil) || (*category == "") {
+			fmt.Println("category parameter missing or invalid")
+			validParams = false
+		}
+		normal_balance, err = ParseNormalBalance(*normal)
+		if err != nil {
+			fmt.Println("normal parameter invalid")
+			validParams = false
+		}
 
 	case "update_account_type":
 		if *id <= 0 {
@@ -241,6 +544,16 @@ func main() {
 			fmt.Println("type parameter missing or invalid")
 			validParams = false
 		}
+		account_category, err = ParseAccountCategory(*category)
+		if (err != nil) || (*category == "") {
+			fmt.Println("category parameter missing or invalid")
+			validParams = false
+		}
+		normal_balance, err = ParseNormalBalance(*normal)
+		if err != nil {
+			fmt.Println("normal parameter invalid")
+			validParams = false
+		}
 		if *version == -1 {
 			fmt.Println("version parameter missing")
 			validParams = false
@@ -358,6 +671,14 @@ func main() {
 			fmt.Println("orgid parameter missing or invalid")
 			validParams = false
 		}
+
+		if *parent != "" {
+			parent_id, err = dml.GuidFromString(*parent)
+			if err != nil {
+				fmt.Println("parent parameter invalid")
+				validParams = false
+			}
+		}
 	case "update_account":
 		account_id, err = dml.GuidFromString(*guid)
 		if err != nil {
@@ -381,6 +702,14 @@ func main() {
 			fmt.Println("desc parameter missing or invalid")
 			validParams = false
 		}
+
+		if *parent != "" {
+			parent_id, err = dml.GuidFromString(*parent)
+			if err != nil {
+				fmt.Println("parent parameter invalid")
+				validParams = false
+			}
+		}
 	case "delete_account":
 		account_id, err = dml.GuidFromString(*guid)
 		if err != nil {
@@ -403,7 +732,244 @@ func main() {
 			fmt.Println("orgid parameter missing or invalid")
 			validParams = false
 		}
+	case "get_account_tree":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "create_account_code_range":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		account_category, err = ParseAccountCategory(*category)
+		if (err != nil) || (*category == "") {
+			fmt.Println("category parameter missing or invalid")
+			validParams = false
+		}
+		if *range_start < 0 {
+			fmt.Println("range_start parameter missing or invalid")
+			validParams = false
+		}
+		if *range_end < 0 {
+			fmt.Println("range_end parameter missing or invalid")
+			validParams = false
+		}
+	case "update_account_code_range":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		account_category, err = ParseAccountCategory(*category)
+		if (err != nil) || (*category == "") {
+			fmt.Println("category parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *range_start < 0 {
+			fmt.Println("range_start parameter missing or invalid")
+			validParams = false
+		}
+		if *range_end < 0 {
+			fmt.Println("range_end parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_account_code_range":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		account_category, err = ParseAccountCategory(*category)
+		if (err != nil) || (*category == "") {
+			fmt.Println("category parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_account_code_ranges_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "create_exchange_rate":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *currency == "" {
+			fmt.Println("currency parameter missing")
+			validParams = false
+		}
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("effective_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+		if !validDecimal.MatchString(*rate) {
+			fmt.Println("rate parameter missing or invalid")
+			validParams = false
+		}
+	case "update_exchange_rate":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *currency == "" {
+			fmt.Println("currency parameter missing")
+			validParams = false
+		}
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("effective_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if !validDecimal.MatchString(*rate) {
+			fmt.Println("rate parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_exchange_rate":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *currency == "" {
+			fmt.Println("currency parameter missing")
+			validParams = false
+		}
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("effective_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_exchange_rates_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+
+	case "create_dimension":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+	case "update_dimension", "delete_dimension":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_dimensions_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "create_dimension_value":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *value == "" {
+			fmt.Println("value parameter missing")
+			validParams = false
+		}
+	case "update_dimension_value", "delete_dimension_value":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *value == "" {
+			fmt.Println("value parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_dimension_balances":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *sdate != "" {
+			date := *sdate
+			if !dateValidator.MatchString(date) {
+				fmt.Println("start_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+
+			start_date = dml.DateTimeFromString(date)
+		}
+
+		date := *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		end_date = dml.DateTimeFromString(date)
 
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
 	case "create_transaction":
 		organization_id, err = dml.GuidFromString(*orgid)
 		if err != nil {
@@ -438,13 +1004,10 @@ func main() {
 			}
 		}
 
-	case "update_transaction":
-		if *id <= 0 {
-			fmt.Println("id parameter missing or invalid")
-			validParams = false
-		}
-		if *version == -1 {
-			fmt.Println("version parameter missing")
+	case "post_journal_entry":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
 			validParams = false
 		}
 		date := *tdate
@@ -474,7 +1037,14 @@ func main() {
 				validParams = false
 			}
 		}
-	case "delete_transaction":
+
+		details, err = TransformDetails(0, *json_str)
+		if (err != nil) || (len(details) == 0) {
+			fmt.Println("json parameter missing or invalid")
+			validParams = false
+		}
+
+	case "update_transaction":
 		if *id <= 0 {
 			fmt.Println("id parameter missing or invalid")
 			validParams = false
@@ -483,9 +1053,80 @@ func main() {
 			fmt.Println("version parameter missing")
 			validParams = false
 		}
-	case "get_transaction_by_id":
-		if *id <= 0 {
-			fmt.Println("id parameter missing or invalid")
+		date := *tdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("transaction_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		transaction_date = dml.DateTimeFromString(date)
+
+		if *description == "" {
+			fmt.Println("desc parameter missing or invalid")
+			validParams = false
+		}
+
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+
+		date = *via_date
+		if date != "" {
+			if dateValidator.MatchString(date) {
+				v_date = dml.DateTimeFromString(date)
+			} else {
+				fmt.Println("via_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+		}
+	case "delete_transaction":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "reverse_transaction":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+		date := *tdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("tdate parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		transaction_date = dml.DateTimeFromString(date)
+	case "void_transaction":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "post_transaction":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_transaction_by_id":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
 			validParams = false
 		}
 	case "get_transaction_wrapper_by_id":
@@ -516,6 +1157,11 @@ func main() {
 
 		end_date = dml.DateTimeFromString(date)
 
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
 	case "add_transaction_details":
 		if *id <= 0 {
 			fmt.Println("id parameter missing or invalid")
@@ -526,298 +1172,2235 @@ func main() {
 			validParams = false
 		}
 		fmt.Printf("details: %v\n", details)
-	case "get_server_version":
-		validParams = true
+	case "replace_transaction_details":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		details, err = TransformDetails(*id, *json_str)
+		if err != nil {
+			validParams = false
+		}
+	case "get_trial_balance":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
 
-	default:
-		fmt.Printf("unknown command: %s\n", cmd)
-		validParams = false
-	}
+		date := *adate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("as_of_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	if !validParams {
-		os.Exit(1)
-	}
+		as_of_date = dml.DateTimeFromString(date)
 
-	tokenFilename := "token.txt"
-	usr, err = user.Current()
-	if err == nil {
-		homeDir := usr.HomeDir
-		tokenFilename = homeDir + string(os.PathSeparator) + ".mservice.token"
-	}
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "get_account_balance":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
 
-	address := server + ":" + strconv.Itoa(int(port))
+		date := *adate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("as_of_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	var opts []grpc.DialOption
-	if tls {
-		var sn string
-		if server_host_override != "" {
-			sn = server_host_override
+		as_of_date = dml.DateTimeFromString(date)
+
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
 		}
-		var creds credentials.TransportCredentials
-		if ca_file != "" {
-			var err error
-			creds, err = credentials.NewClientTLSFromFile(ca_file, sn)
-			if err != nil {
-				grpclog.Fatalf("Failed to create TLS credentials %v", err)
-			}
-		} else {
-			creds = credentials.NewClientTLSFromCert(nil, sn)
+	case "get_balance_sheet":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
 		}
-		opts = append(opts, grpc.WithTransportCredentials(creds))
-	} else {
-		opts = append(opts, grpc.WithInsecure())
-	}
 
-	// set up connection to server
-	conn, err := grpc.Dial(address, opts...)
-	if err != nil {
-		log.Fatalf("did not connect: %v", err)
-	}
+		date := *adate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("as_of_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	defer conn.Close()
+		as_of_date = dml.DateTimeFromString(date)
 
-	client := pb.NewMServiceLedgerClient(conn)
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "get_income_statement":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
 
-	ctx := context.Background()
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	savedToken := ""
+		start_date = dml.DateTimeFromString(date)
 
-	data, err := ioutil.ReadFile(tokenFilename)
+		date = *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	if err == nil {
-		savedToken = string(data)
-	}
+		end_date = dml.DateTimeFromString(date)
 
-	md := metadata.Pairs("token", savedToken)
-	mctx := metadata.NewOutgoingContext(ctx, md)
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "get_account_ledger":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
 
-	switch cmd {
-	case "create_organization":
-		req := pb.CreateOrganizationRequest{}
-		req.OrganizationName = *name
-		req.FromDate = start_date
-		if *edate != "" {
-			req.ToDate = end_date
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
 		}
 
-		resp, err := client.CreateOrganization(mctx, &req)
-		printResponse(resp, err)
+		start_date = dml.DateTimeFromString(date)
 
-	case "update_organization":
-		req := pb.UpdateOrganizationRequest{}
-		req.OrganizationId = organization_id
-		req.Version = int32(*version)
-		req.OrganizationName = *name
-		req.FromDate = start_date
-		if *edate != "" {
-			req.ToDate = end_date
+		date = *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
 		}
 
-		resp, err := client.UpdateOrganization(mctx, &req)
-		printResponse(resp, err)
+		end_date = dml.DateTimeFromString(date)
 
-	case "delete_organization":
-		req := pb.DeleteOrganizationRequest{}
-		req.OrganizationId = organization_id
-		req.Version = int32(*version)
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "create_fiscal_year":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
 
-		resp, err := client.DeleteOrganization(mctx, &req)
-		printResponse(resp, err)
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
 
-	case "get_organization_by_id":
-		req := pb.GetOrganizationByIdRequest{}
-		req.OrganizationId = organization_id
-		resp, err := client.GetOrganizationById(mctx, &req)
-		printResponse(resp, err)
+		start_date = dml.DateTimeFromString(date)
 
-	case "get_organizations_by_mservice":
-		req := pb.GetOrganizationsByMserviceRequest{}
-		resp, err := client.GetOrganizationsByMservice(mctx, &req)
-		printResponse(resp, err)
-	case "create_account_type":
-		req := pb.CreateAccountTypeRequest{}
-		req.AccountTypeId = int32(*id)
-		req.AccountType = *type_name
-		resp, err := client.CreateAccountType(mctx, &req)
-		printResponse(resp, err)
+		calendar_type, err = ParseFiscalCalendarType(*calendar)
+		if (err != nil) || (*calendar == "") {
+			fmt.Println("calendar parameter missing or invalid")
+			validParams = false
+		}
+		if calendar_type == pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM {
+			periods, err = TransformPeriods(*json_str)
+			if err != nil {
+				validParams = false
+			}
+		}
+	case "delete_fiscal_year":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_fiscal_years_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "get_fiscal_periods_by_year":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+	case "update_fiscal_period_status":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *period <= 0 {
+			fmt.Println("period parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		period_status, err = ParsePeriodStatus(*status)
+		if (err != nil) || (*status == "") {
+			fmt.Println("status parameter missing or invalid")
+			validParams = false
+		}
+	case "create_budget":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+	case "update_budget", "delete_budget":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_budgets_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "create_budget_amount":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+		if *period <= 0 {
+			fmt.Println("period parameter missing or invalid")
+			validParams = false
+		}
+		if !validDecimal.MatchString(*amt) {
+			fmt.Println("amt parameter missing or invalid")
+			validParams = false
+		}
+	case "update_budget_amount":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+		if *period <= 0 {
+			fmt.Println("period parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if !validDecimal.MatchString(*amt) {
+			fmt.Println("amt parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_budget_amount":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+		if *period <= 0 {
+			fmt.Println("period parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_budget_amounts":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+	case "load_budget_amounts":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		budget_amounts, err = TransformBudgetAmounts(*json_str)
+		if err != nil {
+			validParams = false
+		}
+	case "get_budget_vs_actual":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "revalue_foreign_balances":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		date := *adate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("revaluation_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		as_of_date = dml.DateTimeFromString(date)
+
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+		rate_source, err = ParseRateSource(*source)
+		if err != nil {
+			fmt.Println("source parameter invalid")
+			validParams = false
+		}
+		if rate_source == pb.RateSource_RATE_SOURCE_REQUEST {
+			exchange_rates, err = TransformRates(*json_str)
+			if err != nil {
+				fmt.Println("json parameter missing or invalid")
+				validParams = false
+			}
+		}
+	case "close_fiscal_year":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+	case "reopen_fiscal_year":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "create_recurring_entry", "update_recurring_entry":
+		if cmd == "create_recurring_entry" {
+			organization_id, err = dml.GuidFromString(*orgid)
+			if err != nil {
+				fmt.Println("orgid parameter missing or invalid")
+				validParams = false
+			}
+		} else {
+			if *id <= 0 {
+				fmt.Println("id parameter missing or invalid")
+				validParams = false
+			}
+			if *version == -1 {
+				fmt.Println("version parameter missing")
+				validParams = false
+			}
+		}
+
+		if *description == "" {
+			fmt.Println("desc parameter missing or invalid")
+			validParams = false
+		}
+
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+
+		recurrence_frequency, err = ParseRecurrenceFrequency(*frequency)
+		if (err != nil) || (*frequency == "") {
+			fmt.Println("frequency parameter missing or invalid")
+			validParams = false
+		}
+
+		if (*day < 1) || (*day > 31) {
+			fmt.Println("day parameter missing or invalid")
+			validParams = false
+		}
+
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+
+		date = *edate
+		if date != "" {
+			if dateValidator.MatchString(date) {
+				end_date = dml.DateTimeFromString(date)
+			} else {
+				fmt.Println("end_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+		}
+
+		details, err = TransformDetails(0, *json_str)
+		if (err != nil) || (len(details) == 0) {
+			fmt.Println("json parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_recurring_entry":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_recurring_entry_by_id", "get_recurring_instances":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+	case "get_recurring_entries_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "create_intercompany_account", "update_intercompany_account", "delete_intercompany_account":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		partner_id, err = dml.GuidFromString(*partner)
+		if err != nil {
+			fmt.Println("partner parameter missing or invalid")
+			validParams = false
+		}
+		if (cmd != "create_intercompany_account") && (*version == -1) {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if cmd != "delete_intercompany_account" {
+			due_from_id, err = dml.GuidFromString(*due_from)
+			if err != nil {
+				fmt.Println("due_from parameter missing or invalid")
+				validParams = false
+			}
+			due_to_id, err = dml.GuidFromString(*due_to)
+			if err != nil {
+				fmt.Println("due_to parameter missing or invalid")
+				validParams = false
+			}
+		}
+	case "get_intercompany_accounts_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "post_intercompany_entry":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		partner_id, err = dml.GuidFromString(*partner)
+		if err != nil {
+			fmt.Println("partner parameter missing or invalid")
+			validParams = false
+		}
+		date := *tdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("transaction_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		transaction_date = dml.DateTimeFromString(date)
+
+		if *description == "" {
+			fmt.Println("desc parameter missing or invalid")
+			validParams = false
+		}
+
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+
+		details, err = TransformDetails(0, *json_str)
+		if (err != nil) || (len(details) == 0) {
+			fmt.Println("json parameter missing or invalid")
+			validParams = false
+		}
+
+		partner_details, err = TransformDetails(0, *partner_json)
+		if (err != nil) || (len(partner_details) == 0) {
+			fmt.Println("partner_json parameter missing or invalid")
+			validParams = false
+		}
+	case "create_organization_group", "update_organization_group", "delete_organization_group",
+		"create_organization_group_member", "delete_organization_group_member", "get_organization_group_members",
+		"create_group_account_map", "update_group_account_map", "delete_group_account_map", "get_group_account_maps",
+		"get_elimination_rules":
+		if *group == "" {
+			fmt.Println("group parameter missing")
+			validParams = false
+		}
+		if strings.HasPrefix(cmd, "update_") || strings.HasPrefix(cmd, "delete_") {
+			if *version == -1 {
+				fmt.Println("version parameter missing")
+				validParams = false
+			}
+		}
+		if strings.HasSuffix(cmd, "_group_member") {
+			organization_id, err = dml.GuidFromString(*orgid)
+			if err != nil {
+				fmt.Println("orgid parameter missing or invalid")
+				validParams = false
+			}
+		}
+		if strings.HasSuffix(cmd, "_group_account_map") {
+			account_id, err = dml.GuidFromString(*guid)
+			if err != nil {
+				fmt.Println("guid parameter missing or invalid")
+				validParams = false
+			}
+			if (cmd != "delete_group_account_map") && ((*code == "") || (*name == "")) {
+				fmt.Println("code or name parameter missing")
+				validParams = false
+			}
+		}
+	case "get_organization_groups":
+		validParams = true
+	case "create_elimination_rule", "update_elimination_rule":
+		if cmd == "create_elimination_rule" {
+			if *group == "" {
+				fmt.Println("group parameter missing")
+				validParams = false
+			}
+		} else {
+			if *id <= 0 {
+				fmt.Println("id parameter missing or invalid")
+				validParams = false
+			}
+			if *version == -1 {
+				fmt.Println("version parameter missing")
+				validParams = false
+			}
+		}
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+		partner_account_id, err = dml.GuidFromString(*partner_account)
+		if err != nil {
+			fmt.Println("partner_account parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_elimination_rule":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_consolidated_trial_balance", "get_consolidated_balance_sheet":
+		if *group == "" {
+			fmt.Println("group parameter missing")
+			validParams = false
+		}
+
+		date := *adate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("as_of_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		as_of_date = dml.DateTimeFromString(date)
+
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "get_consolidated_income_statement":
+		if *group == "" {
+			fmt.Println("group parameter missing")
+			validParams = false
+		}
+
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+
+		date = *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		end_date = dml.DateTimeFromString(date)
+
+		transaction_status, err = ParseTransactionStatus(*status)
+		if err != nil {
+			fmt.Println("status parameter invalid")
+			validParams = false
+		}
+	case "import_bank_statement":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+
+		statement_format, err = ParseStatementFormat(*format)
+		if (err != nil) || (statement_format == pb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED) {
+			fmt.Println("format parameter missing or invalid")
+			validParams = false
+		}
+
+		statement_data, err = ioutil.ReadFile(*file)
+		if err != nil {
+			fmt.Println("file parameter missing or not readable")
+			validParams = false
+		}
+	case "auto_match_bank_statement":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+	case "confirm_bank_match", "unmatch_bank_statement_line", "delete_bank_statement_line":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_bank_statement_lines", "get_bank_reconciliation":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+
+		date = *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		end_date = dml.DateTimeFromString(date)
+
+		line_match_status, err = ParseMatchStatus(*match_status)
+		if err != nil {
+			fmt.Println("match_status parameter invalid")
+			validParams = false
+		}
+	case "create_control_account", "update_control_account":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+
+		if (cmd == "update_control_account") && (*version == -1) {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+		subledger_type, err = ParseSubledgerType(*subledger)
+		if (err != nil) || (subledger_type == pb.SubledgerType_SUBLEDGER_TYPE_UNSPECIFIED) {
+			fmt.Println("subledger parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_control_account":
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_control_accounts_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "get_open_items", "get_party_balances", "get_party_aging":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+
+		subledger_type, err = ParseSubledgerType(*subledger)
+		if err != nil {
+			fmt.Println("subledger parameter invalid")
+			validParams = false
+		}
+
+		if *adate != "" {
+			if dateValidator.MatchString(*adate) {
+				as_of_date = dml.DateTimeFromString(*adate)
+			} else {
+				fmt.Println("as_of_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+		}
+	case "apply_open_item":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *seq <= 0 {
+			fmt.Println("seq parameter missing or invalid")
+			validParams = false
+		}
+		if (*charge_id > 0) && (*charge_seq <= 0) {
+			fmt.Println("charge_seq parameter missing or invalid")
+			validParams = false
+		}
+		if (*amt != "") && !validDecimal.MatchString(*amt) {
+			fmt.Println("amt parameter not a valid decimal")
+			validParams = false
+		}
+	case "delete_open_item_application":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_open_item_applications":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+	case "create_tax_code", "update_tax_code":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *code == "" {
+			fmt.Println("code parameter missing")
+			validParams = false
+		}
+		if (cmd == "update_tax_code") && (*version == -1) {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if !validDecimal.MatchString(*rate) {
+			fmt.Println("rate parameter missing or invalid")
+			validParams = false
+		}
+		account_id, err = dml.GuidFromString(*guid)
+		if err != nil {
+			fmt.Println("guid parameter missing or invalid")
+			validParams = false
+		}
+
+		tax_type_value, err = ParseTaxType(*tax_type)
+		if (err != nil) || (tax_type_value == pb.TaxType_TAX_TYPE_UNSPECIFIED) {
+			fmt.Println("tax_type parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_tax_code":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *code == "" {
+			fmt.Println("code parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_tax_codes_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+	case "get_tax_report":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("start_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+
+		date = *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("end_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		end_date = dml.DateTimeFromString(date)
+	case "create_fixed_asset", "update_fixed_asset":
+		if cmd == "create_fixed_asset" {
+			organization_id, err = dml.GuidFromString(*orgid)
+			if err != nil {
+				fmt.Println("orgid parameter missing or invalid")
+				validParams = false
+			}
+		} else {
+			if *id <= 0 {
+				fmt.Println("id parameter missing or invalid")
+				validParams = false
+			}
+			if *version == -1 {
+				fmt.Println("version parameter missing")
+				validParams = false
+			}
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+
+		date := *acq_date
+		if !dateValidator.MatchString(date) {
+			fmt.Println("acq_date parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		acquisition_date = dml.DateTimeFromString(date)
+
+		if !validDecimal.MatchString(*cost) {
+			fmt.Println("cost parameter missing or invalid")
+			validParams = false
+		}
+		if (*salvage != "") && !validDecimal.MatchString(*salvage) {
+			fmt.Println("salvage parameter invalid")
+			validParams = false
+		}
+		if *life <= 0 {
+			fmt.Println("life parameter missing or invalid")
+			validParams = false
+		}
+
+		depreciation_method, err = ParseDepreciationMethod(*method)
+		if (err != nil) || (depreciation_method == pb.DepreciationMethod_DEPRECIATION_METHOD_UNSPECIFIED) {
+			fmt.Println("method parameter missing or invalid")
+			validParams = false
+		}
+
+		if (*factor != "") && !validDecimal.MatchString(*factor) {
+			fmt.Println("factor parameter invalid")
+			validParams = false
+		}
+
+		asset_account_id, err = dml.GuidFromString(*asset_account)
+		if err != nil {
+			fmt.Println("asset_account parameter missing or invalid")
+			validParams = false
+		}
+		accum_account_id, err = dml.GuidFromString(*accum_account)
+		if err != nil {
+			fmt.Println("accum_account parameter missing or invalid")
+			validParams = false
+		}
+		expense_account_id, err = dml.GuidFromString(*expense_account)
+		if err != nil {
+			fmt.Println("expense_account parameter missing or invalid")
+			validParams = false
+		}
+	case "delete_fixed_asset":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "get_fixed_asset_by_id", "get_depreciation_entries":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+	case "get_fixed_assets_by_organization":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+
+		asset_status_value, err = ParseAssetStatus(*asset_status)
+		if err != nil {
+			fmt.Println("asset_status parameter invalid")
+			validParams = false
+		}
+	case "run_depreciation":
+		organization_id, err = dml.GuidFromString(*orgid)
+		if err != nil {
+			fmt.Println("orgid parameter missing or invalid")
+			validParams = false
+		}
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *period <= 0 {
+			fmt.Println("period parameter missing or invalid")
+			validParams = false
+		}
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+	case "dispose_fixed_asset":
+		if *id <= 0 {
+			fmt.Println("id parameter missing or invalid")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+		date := *tdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("tdate parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		transaction_date = dml.DateTimeFromString(date)
+
+		if (*amt != "") && !validDecimal.MatchString(*amt) {
+			fmt.Println("amt parameter invalid")
+			validParams = false
+		}
+		if *proceeds_account != "" {
+			proceeds_account_id, err = dml.GuidFromString(*proceeds_account)
+			if err != nil {
+				fmt.Println("proceeds_account parameter invalid")
+				validParams = false
+			}
+		}
+
+		gain_loss_id, err = dml.GuidFromString(*gain_loss)
+		if err != nil {
+			fmt.Println("gain_loss parameter missing or invalid")
+			validParams = false
+		}
+		if *type_id <= 0 {
+			fmt.Println("type_id parameter missing or invalid")
+			validParams = false
+		}
+	case "get_server_version":
+		validParams = true
+
+	default:
+		fmt.Printf("unknown command: %s\n", cmd)
+		validParams = false
+	}
+
+	if !validParams {
+		os.Exit(1)
+	}
+
+	tokenFilename := "token.txt"
+	usr, err = user.Current()
+	if err == nil {
+		homeDir := usr.HomeDir
+		tokenFilename = homeDir + string(os.PathSeparator) + ".mservice.token"
+	}
+
+	address := server + ":" + strconv.Itoa(int(port))
+
+	var opts []grpc.DialOption
+	if tls {
+		var sn string
+		if server_host_override != "" {
+			sn = server_host_override
+		}
+		var creds credentials.TransportCredentials
+		if ca_file != "" {
+			var err error
+			creds, err = credentials.NewClientTLSFromFile(ca_file, sn)
+			if err != nil {
+				grpclog.Fatalf("Failed to create TLS credentials %v", err)
+			}
+		} else {
+			creds = credentials.NewClientTLSFromCert(nil, sn)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	// set up connection to server
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	defer conn.Close()
+
+	client := pb.NewMServiceLedgerClient(conn)
+
+	ctx := context.Background()
+
+	savedToken := ""
+
+	data, err := ioutil.ReadFile(tokenFilename)
+
+	if err == nil {
+		savedToken = string(data)
+	}
+
+	md := metadata.Pairs("token", savedToken)
+	mctx := metadata.NewOutgoingContext(ctx, md)
+
+	switch cmd {
+	case "create_organization":
+		req := pb.CreateOrganizationRequest{}
+		req.OrganizationName = *name
+		req.FromDate = start_date
+		if *edate != "" {
+			req.ToDate = end_date
+		}
+		req.BaseCurrency = *currency
+
+		resp, err := client.CreateOrganization(mctx, &req)
+		printResponse(resp, err)
+
+	case "update_organization":
+		req := pb.UpdateOrganizationRequest{}
+		req.OrganizationId = organization_id
+		req.Version = int32(*version)
+		req.OrganizationName = *name
+		req.FromDate = start_date
+		if *edate != "" {
+			req.ToDate = end_date
+		}
+		req.RetainedEarningsAccountId = account_id
+		req.BaseCurrency = *currency
+		req.FxGainAccountId = gain_id
+		req.FxLossAccountId = loss_id
+
+		resp, err := client.UpdateOrganization(mctx, &req)
+		printResponse(resp, err)
+
+	case "delete_organization":
+		req := pb.DeleteOrganizationRequest{}
+		req.OrganizationId = organization_id
+		req.Version = int32(*version)
+
+		resp, err := client.DeleteOrganization(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_organization_by_id":
+		req := pb.GetOrganizationByIdRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetOrganizationById(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_organizations_by_mservice":
+		req := pb.GetOrganizationsByMserviceRequest{}
+		resp, err := client.GetOrganizationsByMservice(mctx, &req)
+		printResponse(resp, err)
+	case "create_account_type":
+		req := pb.CreateAccountTypeRequest{}
+		req.AccountTypeId = int32(*id)
+		req.AccountType = *type_name
+		req.AccountCategory = account_category
+		req.NormalBalance = normal_balance
+		resp, err := client.CreateAccountType(mctx, &req)
+		printResponse(resp, err)
 	case "update_account_type":
 		req := pb.UpdateAccountTypeRequest{}
 		req.AccountTypeId = int32(*id)
 		req.AccountType = *type_name
+		req.AccountCategory = account_category
+		req.NormalBalance = normal_balance
+		req.Version = int32(*version)
+		resp, err := client.UpdateAccountType(mctx, &req)
+		printResponse(resp, err)
+	case "delete_account_type":
+		req := pb.DeleteAccountTypeRequest{}
+		req.AccountTypeId = int32(*id)
+		req.Version = int32(*version)
+		resp, err := client.DeleteAccountType(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_type_by_id":
+		req := pb.GetAccountTypeByIdRequest{}
+		req.AccountTypeId = int32(*id)
+		resp, err := client.GetAccountTypeById(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_types_by_mservice":
+		req := pb.GetAccountTypesByMserviceRequest{}
+		resp, err := client.GetAccountTypesByMservice(mctx, &req)
+		printResponse(resp, err)
+	case "create_transaction_type":
+		req := pb.CreateTransactionTypeRequest{}
+		req.TransactionTypeId = int32(*id)
+		req.TransactionType = *type_name
+		resp, err := client.CreateTransactionType(mctx, &req)
+		printResponse(resp, err)
+	case "update_transaction_type":
+		req := pb.UpdateTransactionTypeRequest{}
+		req.TransactionTypeId = int32(*id)
+		req.TransactionType = *type_name
+		req.Version = int32(*version)
+		resp, err := client.UpdateTransactionType(mctx, &req)
+		printResponse(resp, err)
+	case "delete_transaction_type":
+		req := pb.DeleteTransactionTypeRequest{}
+		req.TransactionTypeId = int32(*id)
+		req.Version = int32(*version)
+		resp, err := client.DeleteTransactionType(mctx, &req)
+		printResponse(resp, err)
+	case "get_transaction_type_by_id":
+		req := pb.GetTransactionTypeByIdRequest{}
+		req.TransactionTypeId = int32(*id)
+		resp, err := client.GetTransactionTypeById(mctx, &req)
+		printResponse(resp, err)
+	case "get_transaction_types_by_mservice":
+		req := pb.GetTransactionTypesByMserviceRequest{}
+		resp, err := client.GetTransactionTypesByMservice(mctx, &req)
+		printResponse(resp, err)
+	case "create_party":
+		req := pb.CreatePartyRequest{}
+		req.PartyId = *id
+		req.PartyName = *name
+		resp, err := client.CreateParty(mctx, &req)
+		printResponse(resp, err)
+	case "update_party":
+		req := pb.UpdatePartyRequest{}
+		req.PartyId = *id
+		req.PartyName = *name
+		req.Version = int32(*version)
+		resp, err := client.UpdateParty(mctx, &req)
+		printResponse(resp, err)
+	case "delete_party":
+		req := pb.DeletePartyRequest{}
+		req.PartyId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteParty(mctx, &req)
+		printResponse(resp, err)
+	case "get_party_by_id":
+		req := pb.GetPartyByIdRequest{}
+		req.PartyId = *id
+		resp, err := client.GetPartyById(mctx, &req)
+		printResponse(resp, err)
+	case "get_parties_by_mservice":
+		req := pb.GetPartiesByMserviceRequest{}
+		resp, err := client.GetPartiesByMservice(mctx, &req)
+		printResponse(resp, err)
+	case "create_account":
+		req := pb.CreateAccountRequest{}
+		req.OrganizationId = organization_id
+		req.AccountName = *name
+		req.AccountDescription = *description
+		req.AccountTypeId = int32(*type_id)
+		req.ParentAccountId = parent_id
+		req.AccountCode = *code
+		resp, err := client.CreateAccount(mctx, &req)
+		printResponse(resp, err)
+	case "update_account":
+		req := pb.UpdateAccountRequest{}
+		req.GlAccountId = account_id
+		req.Version = int32(*version)
+		req.AccountName = *name
+		req.AccountDescription = *description
+		req.AccountTypeId = int32(*type_id)
+		req.ParentAccountId = parent_id
+		req.AccountCode = *code
+		resp, err := client.UpdateAccount(mctx, &req)
+		printResponse(resp, err)
+	case "delete_account":
+		req := pb.DeleteAccountRequest{}
+		req.GlAccountId = account_id
+		req.Version = int32(*version)
+		resp, err := client.DeleteAccount(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_by_id":
+		req := pb.GetAccountByIdRequest{}
+		req.GlAccountId = account_id
+		resp, err := client.GetAccountById(mctx, &req)
+		printResponse(resp, err)
+	case "get_accounts_by_organization":
+		req := pb.GetAccountsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetAccountsByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_tree":
+		req := pb.GetAccountTreeRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetAccountTree(mctx, &req)
+		printResponse(resp, err)
+	case "create_account_code_range":
+		req := pb.CreateAccountCodeRangeRequest{}
+		req.OrganizationId = organization_id
+		req.AccountCategory = account_category
+		req.RangeStart = *range_start
+		req.RangeEnd = *range_end
+		resp, err := client.CreateAccountCodeRange(mctx, &req)
+		printResponse(resp, err)
+	case "update_account_code_range":
+		req := pb.UpdateAccountCodeRangeRequest{}
+		req.OrganizationId = organization_id
+		req.AccountCategory = account_category
+		req.Version = int32(*version)
+		req.RangeStart = *range_start
+		req.RangeEnd = *range_end
+		resp, err := client.UpdateAccountCodeRange(mctx, &req)
+		printResponse(resp, err)
+	case "delete_account_code_range":
+		req := pb.DeleteAccountCodeRangeRequest{}
+		req.OrganizationId = organization_id
+		req.AccountCategory = account_category
+		req.Version = int32(*version)
+		resp, err := client.DeleteAccountCodeRange(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_code_ranges_by_organization":
+		req := pb.GetAccountCodeRangesByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetAccountCodeRangesByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "create_exchange_rate":
+		req := pb.CreateExchangeRateRequest{}
+		req.OrganizationId = organization_id
+		req.CurrencyCode = *currency
+		req.EffectiveDate = start_date
+		req.Rate, _ = dml.DecimalFromString(*rate)
+		resp, err := client.CreateExchangeRate(mctx, &req)
+		printResponse(resp, err)
+	case "update_exchange_rate":
+		req := pb.UpdateExchangeRateRequest{}
+		req.OrganizationId = organization_id
+		req.CurrencyCode = *currency
+		req.EffectiveDate = start_date
+		req.Version = int32(*version)
+		req.Rate, _ = dml.DecimalFromString(*rate)
+		resp, err := client.UpdateExchangeRate(mctx, &req)
+		printResponse(resp, err)
+	case "delete_exchange_rate":
+		req := pb.DeleteExchangeRateRequest{}
+		req.OrganizationId = organization_id
+		req.CurrencyCode = *currency
+		req.EffectiveDate = start_date
+		req.Version = int32(*version)
+		resp, err := client.DeleteExchangeRate(mctx, &req)
+		printResponse(resp, err)
+	case "get_exchange_rates_by_organization":
+		req := pb.GetExchangeRatesByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		req.CurrencyCode = *currency
+		resp, err := client.GetExchangeRatesByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "create_dimension":
+		req := pb.CreateDimensionRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.Description = *description
+		resp, err := client.CreateDimension(mctx, &req)
+		printResponse(resp, err)
+	case "update_dimension":
+		req := pb.UpdateDimensionRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.Version = int32(*version)
+		req.Description = *description
+		resp, err := client.UpdateDimension(mctx, &req)
+		printResponse(resp, err)
+	case "delete_dimension":
+		req := pb.DeleteDimensionRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.Version = int32(*version)
+		resp, err := client.DeleteDimension(mctx, &req)
+		printResponse(resp, err)
+	case "get_dimensions_by_organization":
+		req := pb.GetDimensionsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetDimensionsByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "create_dimension_value":
+		req := pb.CreateDimensionValueRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.DimensionValue = *value
+		req.Description = *description
+		resp, err := client.CreateDimensionValue(mctx, &req)
+		printResponse(resp, err)
+	case "update_dimension_value":
+		req := pb.UpdateDimensionValueRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.DimensionValue = *value
+		req.Version = int32(*version)
+		req.Description = *description
+		resp, err := client.UpdateDimensionValue(mctx, &req)
+		printResponse(resp, err)
+	case "delete_dimension_value":
+		req := pb.DeleteDimensionValueRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.DimensionValue = *value
+		req.Version = int32(*version)
+		resp, err := client.DeleteDimensionValue(mctx, &req)
+		printResponse(resp, err)
+	case "get_dimension_balances":
+		req := pb.GetDimensionBalancesRequest{}
+		req.OrganizationId = organization_id
+		req.DimensionName = *name
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.TransactionStatus = transaction_status
+		resp, err := client.GetDimensionBalances(mctx, &req)
+		printResponse(resp, err)
+	case "create_transaction":
+		req := pb.CreateTransactionRequest{}
+		req.OrganizationId = organization_id
+		req.TransactionDate = transaction_date
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		if *from_party > 0 {
+			req.FromPartyId = *from_party
+		}
+		if *to_party > 0 {
+			req.ToPartyId = *to_party
+		}
+		req.PostedViaKey = *via_key
+
+		if *via_date != "" {
+			req.PostedViaDate = v_date
+		}
+		req.AutoReverseMode = auto_reverse_mode
+		req.AutoReverseDate = auto_reverse_date
+		req.DueDate = due_date
+		resp, err := client.CreateTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "post_journal_entry":
+		req := pb.PostJournalEntryRequest{}
+		req.OrganizationId = organization_id
+		req.TransactionDate = transaction_date
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		if *from_party > 0 {
+			req.FromPartyId = *from_party
+		}
+		if *to_party > 0 {
+			req.ToPartyId = *to_party
+		}
+		req.PostedViaKey = *via_key
+
+		if *via_date != "" {
+			req.PostedViaDate = v_date
+		}
+		req.AutoReverseMode = auto_reverse_mode
+		req.AutoReverseDate = auto_reverse_date
+		req.DueDate = due_date
+		req.GlTransactionDetails = details
+		resp, err := client.PostJournalEntry(mctx, &req)
+		printResponse(resp, err)
+	case "update_transaction":
+		req := pb.UpdateTransactionRequest{}
+		req.GlTransactionId = *id
+		req.Version = int32(*version)
+		req.TransactionDate = transaction_date
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		if *from_party > 0 {
+			req.FromPartyId = *from_party
+		}
+		if *to_party > 0 {
+			req.ToPartyId = *to_party
+		}
+		req.PostedViaKey = *via_key
+
+		if *via_date != "" {
+			req.PostedViaDate = v_date
+		}
+		req.AutoReverseMode = auto_reverse_mode
+		req.AutoReverseDate = auto_reverse_date
+		req.DueDate = due_date
+		resp, err := client.UpdateTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "delete_transaction":
+		req := pb.DeleteTransactionRequest{}
+		req.GlTransactionId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "reverse_transaction":
+		req := pb.ReverseTransactionRequest{}
+		req.GlTransactionId = *id
+		req.Version = int32(*version)
+		req.TransactionDate = transaction_date
+		req.TransactionDescription = *description
+		resp, err := client.ReverseTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "void_transaction":
+		req := pb.VoidTransactionRequest{}
+		req.GlTransactionId = *id
+		req.Version = int32(*version)
+		resp, err := client.VoidTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "post_transaction":
+		req := pb.PostTransactionRequest{}
+		req.GlTransactionId = *id
+		req.Version = int32(*version)
+		resp, err := client.PostTransaction(mctx, &req)
+		printResponse(resp, err)
+	case "get_transaction_by_id":
+		req := pb.GetTransactionByIdRequest{}
+		req.GlTransactionId = *id
+		resp, err := client.GetTransactionById(mctx, &req)
+		printResponse(resp, err)
+	case "get_transaction_wrapper_by_id":
+		req := pb.GetTransactionWrapperByIdRequest{}
+		req.GlTransactionId = *id
+		resp, err := client.GetTransactionWrapperById(mctx, &req)
+		printResponse(resp, err)
+	case "get_transaction_wrappers_by_date":
+		req := pb.GetTransactionWrappersByDateRequest{}
+		req.OrganizationId = organization_id
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.TransactionStatus = transaction_status
+		resp, err := client.GetTransactionWrappersByDate(mctx, &req)
+		printResponse(resp, err)
+	case "add_transaction_details":
+		req := pb.AddTransactionDetailsRequest{}
+		req.GlTransactionId = *id
+		req.GlTransactionDetails = details
+		resp, err := client.AddTransactionDetails(mctx, &req)
+		printResponse(resp, err)
+	case "replace_transaction_details":
+		req := pb.ReplaceTransactionDetailsRequest{}
+		req.GlTransactionId = *id
 		req.Version = int32(*version)
-		resp, err := client.UpdateAccountType(mctx, &req)
+		req.GlTransactionDetails = details
+		resp, err := client.ReplaceTransactionDetails(mctx, &req)
 		printResponse(resp, err)
-	case "delete_account_type":
-		req := pb.DeleteAccountTypeRequest{}
-		req.AccountTypeId = int32(*id)
+	case "get_trial_balance":
+		req := pb.GetTrialBalanceRequest{}
+		req.OrganizationId = organization_id
+		req.AsOfDate = as_of_date
+		req.TransactionStatus = transaction_status
+		req.RollupChildren = *rollup
+		req.DimensionFilter = dimension_filter
+		resp, err := client.GetTrialBalance(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_balance":
+		req := pb.GetAccountBalanceRequest{}
+		req.GlAccountId = account_id
+		req.AsOfDate = as_of_date
+		req.TransactionStatus = transaction_status
+		req.RollupChildren = *rollup
+		req.DimensionFilter = dimension_filter
+		resp, err := client.GetAccountBalance(mctx, &req)
+		printResponse(resp, err)
+	case "get_account_ledger":
+		req := pb.GetAccountLedgerRequest{}
+		req.GlAccountId = account_id
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.TransactionStatus = transaction_status
+		req.DimensionFilter = dimension_filter
+		resp, err := client.GetAccountLedger(mctx, &req)
+		printResponse(resp, err)
+	case "get_balance_sheet":
+		req := pb.GetBalanceSheetRequest{}
+		req.OrganizationId = organization_id
+		req.AsOfDate = as_of_date
+		req.TransactionStatus = transaction_status
+		req.RollupChildren = *rollup
+		req.DimensionFilter = dimension_filter
+		resp, err := client.GetBalanceSheet(mctx, &req)
+		printBalanceSheet(resp, err)
+	case "get_income_statement":
+		req := pb.GetIncomeStatementRequest{}
+		req.OrganizationId = organization_id
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.TransactionStatus = transaction_status
+		req.RollupChildren = *rollup
+		req.DimensionFilter = dimension_filter
+		resp, err := client.GetIncomeStatement(mctx, &req)
+		printIncomeStatement(resp, err)
+	case "create_fiscal_year":
+		req := pb.CreateFiscalYearRequest{}
+		req.OrganizationId = organization_id
+		req.FiscalYear = int32(*id)
+		req.StartDate = start_date
+		req.CalendarType = calendar_type
+		req.GlFiscalPeriods = periods
+		resp, err := client.CreateFiscalYear(mctx, &req)
+		printResponse(resp, err)
+	case "delete_fiscal_year":
+		req := pb.DeleteFiscalYearRequest{}
+		req.OrganizationId = organization_id
+		req.FiscalYear = int32(*id)
 		req.Version = int32(*version)
-		resp, err := client.DeleteAccountType(mctx, &req)
+		resp, err := client.DeleteFiscalYear(mctx, &req)
 		printResponse(resp, err)
-	case "get_account_type_by_id":
-		req := pb.GetAccountTypeByIdRequest{}
-		req.AccountTypeId = int32(*id)
-		resp, err := client.GetAccountTypeById(mctx, &req)
+	case "get_fiscal_years_by_organization":
+		req := pb.GetFiscalYearsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetFiscalYearsByOrganization(mctx, &req)
 		printResponse(resp, err)
-	case "get_account_types_by_mservice":
-		req := pb.GetAccountTypesByMserviceRequest{}
-		resp, err := client.GetAccountTypesByMservice(mctx, &req)
+	case "get_fiscal_periods_by_year":
+		req := pb.GetFiscalPeriodsByYearRequest{}
+		req.OrganizationId = organization_id
+		req.FiscalYear = int32(*id)
+		resp, err := client.GetFiscalPeriodsByYear(mctx, &req)
 		printResponse(resp, err)
-	case "create_transaction_type":
-		req := pb.CreateTransactionTypeRequest{}
-		req.TransactionTypeId = int32(*id)
-		req.TransactionType = *type_name
-		resp, err := client.CreateTransactionType(mctx, &req)
+	case "create_budget":
+		req := pb.CreateBudgetRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.FiscalYear = int32(*id)
+		req.Description = *description
+		resp, err := client.CreateBudget(mctx, &req)
 		printResponse(resp, err)
-	case "update_transaction_type":
-		req := pb.UpdateTransactionTypeRequest{}
-		req.TransactionTypeId = int32(*id)
-		req.TransactionType = *type_name
+	case "update_budget":
+		req := pb.UpdateBudgetRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
 		req.Version = int32(*version)
-		resp, err := client.UpdateTransactionType(mctx, &req)
+		req.Description = *description
+		resp, err := client.UpdateBudget(mctx, &req)
 		printResponse(resp, err)
-	case "delete_transaction_type":
-		req := pb.DeleteTransactionTypeRequest{}
-		req.TransactionTypeId = int32(*id)
+	case "delete_budget":
+		req := pb.DeleteBudgetRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
 		req.Version = int32(*version)
-		resp, err := client.DeleteTransactionType(mctx, &req)
+		resp, err := client.DeleteBudget(mctx, &req)
 		printResponse(resp, err)
-	case "get_transaction_type_by_id":
-		req := pb.GetTransactionTypeByIdRequest{}
-		req.TransactionTypeId = int32(*id)
-		resp, err := client.GetTransactionTypeById(mctx, &req)
+	case "get_budgets_by_organization":
+		req := pb.GetBudgetsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetBudgetsByOrganization(mctx, &req)
 		printResponse(resp, err)
-	case "get_transaction_types_by_mservice":
-		req := pb.GetTransactionTypesByMserviceRequest{}
-		resp, err := client.GetTransactionTypesByMservice(mctx, &req)
+	case "create_budget_amount":
+		req := pb.CreateBudgetAmountRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.GlAccountId = account_id
+		req.PeriodNumber = int32(*period)
+		req.Amount, _ = dml.DecimalFromString(*amt)
+		resp, err := client.CreateBudgetAmount(mctx, &req)
 		printResponse(resp, err)
-	case "create_party":
-		req := pb.CreatePartyRequest{}
-		req.PartyId = *id
-		req.PartyName = *name
-		resp, err := client.CreateParty(mctx, &req)
+	case "update_budget_amount":
+		req := pb.UpdateBudgetAmountRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.GlAccountId = account_id
+		req.PeriodNumber = int32(*period)
+		req.Version = int32(*version)
+		req.Amount, _ = dml.DecimalFromString(*amt)
+		resp, err := client.UpdateBudgetAmount(mctx, &req)
 		printResponse(resp, err)
-	case "update_party":
-		req := pb.UpdatePartyRequest{}
-		req.PartyId = *id
-		req.PartyName = *name
+	case "delete_budget_amount":
+		req := pb.DeleteBudgetAmountRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.GlAccountId = account_id
+		req.PeriodNumber = int32(*period)
 		req.Version = int32(*version)
-		resp, err := client.UpdateParty(mctx, &req)
+		resp, err := client.DeleteBudgetAmount(mctx, &req)
 		printResponse(resp, err)
-	case "delete_party":
-		req := pb.DeletePartyRequest{}
-		req.PartyId = *id
+	case "get_budget_amounts":
+		req := pb.GetBudgetAmountsRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		resp, err := client.GetBudgetAmounts(mctx, &req)
+		printResponse(resp, err)
+	case "load_budget_amounts":
+		req := pb.LoadBudgetAmountsRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.GlBudgetAmounts = budget_amounts
+		req.ReplaceExisting = *replace
+		resp, err := client.LoadBudgetAmounts(mctx, &req)
+		printResponse(resp, err)
+	case "get_budget_vs_actual":
+		req := pb.GetBudgetVsActualRequest{}
+		req.OrganizationId = organization_id
+		req.BudgetName = *name
+		req.StartPeriod = int32(*from_period)
+		req.EndPeriod = int32(*to_period)
+		req.TransactionStatus = transaction_status
+		req.ByPeriod = *by_period
+		resp, err := client.GetBudgetVsActual(mctx, &req)
+		printResponse(resp, err)
+	case "update_fiscal_period_status":
+		req := pb.UpdateFiscalPeriodStatusRequest{}
+		req.OrganizationId = organization_id
+		req.FiscalYear = int32(*id)
+		req.PeriodNumber = int32(*period)
 		req.Version = int32(*version)
-		resp, err := client.DeleteParty(mctx, &req)
+		req.PeriodStatus = period_status
+		resp, err := client.UpdateFiscalPeriodStatus(mctx, &req)
 		printResponse(resp, err)
-	case "get_party_by_id":
-		req := pb.GetPartyByIdRequest{}
-		req.PartyId = *id
-		resp, err := client.GetPartyById(mctx, &req)
+	case "revalue_foreign_balances":
+		req := pb.RevalueForeignBalancesRequest{}
+		req.OrganizationId = organization_id
+		req.RevaluationDate = as_of_date
+		req.TransactionTypeId = int32(*type_id)
+		req.RateSource = rate_source
+		req.ExchangeRates = exchange_rates
+		req.AutoReverse = *reverse
+		resp, err := client.RevalueForeignBalances(mctx, &req)
 		printResponse(resp, err)
-	case "get_parties_by_mservice":
-		req := pb.GetPartiesByMserviceRequest{}
-		resp, err := client.GetPartiesByMservice(mctx, &req)
+	case "close_fiscal_year":
+		req := pb.CloseFiscalYearRequest{}
+		req.OrganizationId = organization_id
+		req.FiscalYear = int32(*id)
+		req.Version = int32(*version)
+		req.TransactionTypeId = int32(*type_id)
+		resp, err := client.CloseFiscalYear(mctx, &req)
 		printResponse(resp, err)
-	case "create_account":
-		req := pb.CreateAccountRequest{}
+	case "reopen_fiscal_year":
+		req := pb.ReopenFiscalYearRequest{}
 		req.OrganizationId = organization_id
-		req.AccountName = *name
-		req.AccountDescription = *description
-		req.AccountTypeId = int32(*type_id)
-		resp, err := client.CreateAccount(mctx, &req)
+		req.FiscalYear = int32(*id)
+		req.Version = int32(*version)
+		resp, err := client.ReopenFiscalYear(mctx, &req)
 		printResponse(resp, err)
-	case "update_account":
-		req := pb.UpdateAccountRequest{}
+	case "create_recurring_entry":
+		req := pb.CreateRecurringEntryRequest{}
+		req.OrganizationId = organization_id
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		if *from_party > 0 {
+			req.FromPartyId = *from_party
+		}
+		if *to_party > 0 {
+			req.ToPartyId = *to_party
+		}
+		req.Frequency = recurrence_frequency
+		req.DayOfMonth = int32(*day)
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.GlTransactionDetails = details
+		resp, err := client.CreateRecurringEntry(mctx, &req)
+		printResponse(resp, err)
+	case "update_recurring_entry":
+		req := pb.UpdateRecurringEntryRequest{}
+		req.RecurringEntryId = *id
+		req.Version = int32(*version)
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		if *from_party > 0 {
+			req.FromPartyId = *from_party
+		}
+		if *to_party > 0 {
+			req.ToPartyId = *to_party
+		}
+		req.Frequency = recurrence_frequency
+		req.DayOfMonth = int32(*day)
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.GlTransactionDetails = details
+		resp, err := client.UpdateRecurringEntry(mctx, &req)
+		printResponse(resp, err)
+	case "delete_recurring_entry":
+		req := pb.DeleteRecurringEntryRequest{}
+		req.RecurringEntryId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteRecurringEntry(mctx, &req)
+		printResponse(resp, err)
+	case "get_recurring_entry_by_id":
+		req := pb.GetRecurringEntryByIdRequest{}
+		req.RecurringEntryId = *id
+		resp, err := client.GetRecurringEntryById(mctx, &req)
+		printResponse(resp, err)
+	case "get_recurring_entries_by_organization":
+		req := pb.GetRecurringEntriesByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetRecurringEntriesByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "get_recurring_instances":
+		req := pb.GetRecurringInstancesRequest{}
+		req.RecurringEntryId = *id
+		req.UpcomingCount = int32(*count)
+		resp, err := client.GetRecurringInstances(mctx, &req)
+		printResponse(resp, err)
+	case "create_intercompany_account":
+		req := pb.CreateIntercompanyAccountRequest{}
+		req.OrganizationId = organization_id
+		req.PartnerOrganizationId = partner_id
+		req.DueFromAccountId = due_from_id
+		req.DueToAccountId = due_to_id
+		resp, err := client.CreateIntercompanyAccount(mctx, &req)
+		printResponse(resp, err)
+	case "update_intercompany_account":
+		req := pb.UpdateIntercompanyAccountRequest{}
+		req.OrganizationId = organization_id
+		req.PartnerOrganizationId = partner_id
+		req.Version = int32(*version)
+		req.DueFromAccountId = due_from_id
+		req.DueToAccountId = due_to_id
+		resp, err := client.UpdateIntercompanyAccount(mctx, &req)
+		printResponse(resp, err)
+	case "delete_intercompany_account":
+		req := pb.DeleteIntercompanyAccountRequest{}
+		req.OrganizationId = organization_id
+		req.PartnerOrganizationId = partner_id
+		req.Version = int32(*version)
+		resp, err := client.DeleteIntercompanyAccount(mctx, &req)
+		printResponse(resp, err)
+	case "get_intercompany_accounts_by_organization":
+		req := pb.GetIntercompanyAccountsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetIntercompanyAccountsByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "post_intercompany_entry":
+		req := pb.PostIntercompanyEntryRequest{}
+		req.OrganizationId = organization_id
+		req.PartnerOrganizationId = partner_id
+		req.TransactionDate = transaction_date
+		req.TransactionDescription = *description
+		req.TransactionTypeId = int32(*type_id)
+		req.GlTransactionDetails = details
+		req.PartnerTransactionDetails = partner_details
+		resp, err := client.PostIntercompanyEntry(mctx, &req)
+		printResponse(resp, err)
+	case "create_organization_group":
+		req := pb.CreateOrganizationGroupRequest{}
+		req.GroupName = *group
+		req.Description = *description
+		resp, err := client.CreateOrganizationGroup(mctx, &req)
+		printResponse(resp, err)
+	case "update_organization_group":
+		req := pb.UpdateOrganizationGroupRequest{}
+		req.GroupName = *group
+		req.Version = int32(*version)
+		req.Description = *description
+		resp, err := client.UpdateOrganizationGroup(mctx, &req)
+		printResponse(resp, err)
+	case "delete_organization_group":
+		req := pb.DeleteOrganizationGroupRequest{}
+		req.GroupName = *group
+		req.Version = int32(*version)
+		resp, err := client.DeleteOrganizationGroup(mctx, &req)
+		printResponse(resp, err)
+	case "get_organization_groups":
+		req := pb.GetOrganizationGroupsRequest{}
+		resp, err := client.GetOrganizationGroups(mctx, &req)
+		printResponse(resp, err)
+	case "create_organization_group_member":
+		req := pb.CreateOrganizationGroupMemberRequest{}
+		req.GroupName = *group
+		req.OrganizationId = organization_id
+		resp, err := client.CreateOrganizationGroupMember(mctx, &req)
+		printResponse(resp, err)
+	case "delete_organization_group_member":
+		req := pb.DeleteOrganizationGroupMemberRequest{}
+		req.GroupName = *group
+		req.OrganizationId = organization_id
+		req.Version = int32(*version)
+		resp, err := client.DeleteOrganizationGroupMember(mctx, &req)
+		printResponse(resp, err)
+	case "get_organization_group_members":
+		req := pb.GetOrganizationGroupMembersRequest{}
+		req.GroupName = *group
+		resp, err := client.GetOrganizationGroupMembers(mctx, &req)
+		printResponse(resp, err)
+	case "create_group_account_map":
+		req := pb.CreateGroupAccountMapRequest{}
+		req.GroupName = *group
+		req.GlAccountId = account_id
+		req.GroupAccountCode = *code
+		req.GroupAccountName = *name
+		resp, err := client.CreateGroupAccountMap(mctx, &req)
+		printResponse(resp, err)
+	case "update_group_account_map":
+		req := pb.UpdateGroupAccountMapRequest{}
+		req.GroupName = *group
 		req.GlAccountId = account_id
 		req.Version = int32(*version)
-		req.AccountName = *name
-		req.AccountDescription = *description
-		req.AccountTypeId = int32(*type_id)
-		resp, err := client.UpdateAccount(mctx, &req)
+		req.GroupAccountCode = *code
+		req.GroupAccountName = *name
+		resp, err := client.UpdateGroupAccountMap(mctx, &req)
 		printResponse(resp, err)
-	case "delete_account":
-		req := pb.DeleteAccountRequest{}
+	case "delete_group_account_map":
+		req := pb.DeleteGroupAccountMapRequest{}
+		req.GroupName = *group
+		req.GlAccountId = account_id
+		req.Version = int32(*version)
+		resp, err := client.DeleteGroupAccountMap(mctx, &req)
+		printResponse(resp, err)
+	case "get_group_account_maps":
+		req := pb.GetGroupAccountMapsRequest{}
+		req.GroupName = *group
+		resp, err := client.GetGroupAccountMaps(mctx, &req)
+		printResponse(resp, err)
+	case "create_elimination_rule":
+		req := pb.CreateEliminationRuleRequest{}
+		req.GroupName = *group
+		req.Description = *description
+		req.GlAccountId = account_id
+		req.PartnerAccountId = partner_account_id
+		resp, err := client.CreateEliminationRule(mctx, &req)
+		printResponse(resp, err)
+	case "update_elimination_rule":
+		req := pb.UpdateEliminationRuleRequest{}
+		req.EliminationRuleId = *id
+		req.Version = int32(*version)
+		req.Description = *description
+		req.GlAccountId = account_id
+		req.PartnerAccountId = partner_account_id
+		resp, err := client.UpdateEliminationRule(mctx, &req)
+		printResponse(resp, err)
+	case "delete_elimination_rule":
+		req := pb.DeleteEliminationRuleRequest{}
+		req.EliminationRuleId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteEliminationRule(mctx, &req)
+		printResponse(resp, err)
+	case "get_elimination_rules":
+		req := pb.GetEliminationRulesRequest{}
+		req.GroupName = *group
+		resp, err := client.GetEliminationRules(mctx, &req)
+		printResponse(resp, err)
+	case "get_consolidated_trial_balance":
+		req := pb.GetConsolidatedTrialBalanceRequest{}
+		req.GroupName = *group
+		req.AsOfDate = as_of_date
+		req.TransactionStatus = transaction_status
+		resp, err := client.GetConsolidatedTrialBalance(mctx, &req)
+		printResponse(resp, err)
+	case "get_consolidated_balance_sheet":
+		req := pb.GetConsolidatedBalanceSheetRequest{}
+		req.GroupName = *group
+		req.AsOfDate = as_of_date
+		req.TransactionStatus = transaction_status
+		resp, err := client.GetConsolidatedBalanceSheet(mctx, &req)
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			printResponse(resp, err)
+		} else {
+			printBalanceSheet(&pb.GetBalanceSheetResponse{
+				AssetLines:                resp.GetAssetLines(),
+				LiabilityLines:            resp.GetLiabilityLines(),
+				EquityLines:               resp.GetEquityLines(),
+				TotalAssets:               resp.GetTotalAssets(),
+				TotalLiabilities:          resp.GetTotalLiabilities(),
+				CurrentNetIncome:          resp.GetCurrentNetIncome(),
+				TotalEquity:               resp.GetTotalEquity(),
+				TotalLiabilitiesAndEquity: resp.GetTotalLiabilitiesAndEquity(),
+				IsBalanced:                resp.GetIsBalanced(),
+			}, nil)
+			printEliminations(resp.GetGlConsolidationEliminations())
+		}
+	case "get_consolidated_income_statement":
+		req := pb.GetConsolidatedIncomeStatementRequest{}
+		req.GroupName = *group
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.TransactionStatus = transaction_status
+		resp, err := client.GetConsolidatedIncomeStatement(mctx, &req)
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			printResponse(resp, err)
+		} else {
+			printIncomeStatement(&pb.GetIncomeStatementResponse{
+				RevenueLines:  resp.GetRevenueLines(),
+				ExpenseLines:  resp.GetExpenseLines(),
+				TotalRevenue:  resp.GetTotalRevenue(),
+				TotalExpenses: resp.GetTotalExpenses(),
+				NetIncome:     resp.GetNetIncome(),
+			}, nil)
+			printEliminations(resp.GetGlConsolidationEliminations())
+		}
+	case "import_bank_statement":
+		req := pb.ImportBankStatementRequest{}
+		req.GlAccountId = account_id
+		req.StatementFormat = statement_format
+		req.StatementData = string(statement_data)
+		req.DateWindowDays = int32(*window)
+		resp, err := client.ImportBankStatement(mctx, &req)
+		printResponse(resp, err)
+	case "auto_match_bank_statement":
+		req := pb.AutoMatchBankStatementRequest{}
+		req.GlAccountId = account_id
+		req.DateWindowDays = int32(*window)
+		resp, err := client.AutoMatchBankStatement(mctx, &req)
+		printResponse(resp, err)
+	case "confirm_bank_match":
+		req := pb.ConfirmBankMatchRequest{}
+		req.StatementLineId = *id
+		req.Version = int32(*version)
+		req.GlTransactionId = *tran_id
+		req.SequenceNumber = int32(*seq)
+		resp, err := client.ConfirmBankMatch(mctx, &req)
+		printResponse(resp, err)
+	case "unmatch_bank_statement_line":
+		req := pb.UnmatchBankStatementLineRequest{}
+		req.StatementLineId = *id
+		req.Version = int32(*version)
+		resp, err := client.UnmatchBankStatementLine(mctx, &req)
+		printResponse(resp, err)
+	case "delete_bank_statement_line":
+		req := pb.DeleteBankStatementLineRequest{}
+		req.StatementLineId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteBankStatementLine(mctx, &req)
+		printResponse(resp, err)
+	case "get_bank_statement_lines":
+		req := pb.GetBankStatementLinesRequest{}
+		req.GlAccountId = account_id
+		req.StartDate = start_date
+		req.EndDate = end_date
+		req.MatchStatus = line_match_status
+		resp, err := client.GetBankStatementLines(mctx, &req)
+		printResponse(resp, err)
+	case "get_bank_reconciliation":
+		req := pb.GetBankReconciliationRequest{}
+		req.GlAccountId = account_id
+		req.StartDate = start_date
+		req.EndDate = end_date
+		resp, err := client.GetBankReconciliation(mctx, &req)
+		printResponse(resp, err)
+	case "create_control_account":
+		req := pb.CreateControlAccountRequest{}
+		req.GlAccountId = account_id
+		req.SubledgerType = subledger_type
+		resp, err := client.CreateControlAccount(mctx, &req)
+		printResponse(resp, err)
+	case "update_control_account":
+		req := pb.UpdateControlAccountRequest{}
+		req.GlAccountId = account_id
+		req.Version = int32(*version)
+		req.SubledgerType = subledger_type
+		resp, err := client.UpdateControlAccount(mctx, &req)
+		printResponse(resp, err)
+	case "delete_control_account":
+		req := pb.DeleteControlAccountRequest{}
 		req.GlAccountId = account_id
 		req.Version = int32(*version)
-		resp, err := client.DeleteAccount(mctx, &req)
+		resp, err := client.DeleteControlAccount(mctx, &req)
+		printResponse(resp, err)
+	case "get_control_accounts_by_organization":
+		req := pb.GetControlAccountsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetControlAccountsByOrganization(mctx, &req)
+		printResponse(resp, err)
+	case "get_open_items":
+		req := pb.GetOpenItemsRequest{}
+		req.OrganizationId = organization_id
+		req.SubledgerType = subledger_type
+		req.PartyId = *party
+		req.AsOfDate = as_of_date
+		req.IncludeClosed = *include_closed
+		resp, err := client.GetOpenItems(mctx, &req)
+		printResponse(resp, err)
+	case "apply_open_item":
+		req := pb.ApplyOpenItemRequest{}
+		req.GlTransactionId = *id
+		req.SequenceNumber = int32(*seq)
+		req.ChargeTransactionId = *charge_id
+		req.ChargeSequenceNumber = int32(*charge_seq)
+		if *amt != "" {
+			req.Amount, _ = dml.DecimalFromString(*amt)
+		}
+		resp, err := client.ApplyOpenItem(mctx, &req)
+		printResponse(resp, err)
+	case "delete_open_item_application":
+		req := pb.DeleteOpenItemApplicationRequest{}
+		req.ApplicationId = *id
+		req.Version = int32(*version)
+		resp, err := client.DeleteOpenItemApplication(mctx, &req)
+		printResponse(resp, err)
+	case "get_open_item_applications":
+		req := pb.GetOpenItemApplicationsRequest{}
+		req.GlTransactionId = *id
+		resp, err := client.GetOpenItemApplications(mctx, &req)
+		printResponse(resp, err)
+	case "get_party_balances":
+		req := pb.GetPartyBalancesRequest{}
+		req.OrganizationId = organization_id
+		req.SubledgerType = subledger_type
+		req.PartyId = *party
+		req.AsOfDate = as_of_date
+		resp, err := client.GetPartyBalances(mctx, &req)
+		printResponse(resp, err)
+	case "get_party_aging":
+		req := pb.GetPartyAgingRequest{}
+		req.OrganizationId = organization_id
+		req.SubledgerType = subledger_type
+		req.PartyId = *party
+		req.AsOfDate = as_of_date
+		req.ByTransactionDate = *by_tdate
+		resp, err := client.GetPartyAging(mctx, &req)
+		if (err != nil) || (resp.GetErrorCode() != 0) {
+			printResponse(resp, err)
+		} else {
+			printPartyAging(resp)
+		}
+	case "create_tax_code":
+		req := pb.CreateTaxCodeRequest{}
+		req.OrganizationId = organization_id
+		req.TaxCode = *code
+		req.Description = *description
+		req.TaxRate, _ = dml.DecimalFromString(*rate)
+		req.TaxAccountId = account_id
+		req.TaxType = tax_type_value
+		resp, err := client.CreateTaxCode(mctx, &req)
+		printResponse(resp, err)
+	case "update_tax_code":
+		req := pb.UpdateTaxCodeRequest{}
+		req.OrganizationId = organization_id
+		req.TaxCode = *code
+		req.Version = int32(*version)
+		req.Description = *description
+		req.TaxRate, _ = dml.DecimalFromString(*rate)
+		req.TaxAccountId = account_id
+		req.TaxType = tax_type_value
+		resp, err := client.UpdateTaxCode(mctx, &req)
+		printResponse(resp, err)
+	case "delete_tax_code":
+		req := pb.DeleteTaxCodeRequest{}
+		req.OrganizationId = organization_id
+		req.TaxCode = *code
+		req.Version = int32(*version)
+		resp, err := client.DeleteTaxCode(mctx, &req)
 		printResponse(resp, err)
-	case "get_account_by_id":
-		req := pb.GetAccountByIdRequest{}
-		req.GlAccountId = account_id
-		resp, err := client.GetAccountById(mctx, &req)
+	case "get_tax_codes_by_organization":
+		req := pb.GetTaxCodesByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		resp, err := client.GetTaxCodesByOrganization(mctx, &req)
 		printResponse(resp, err)
-	case "get_accounts_by_organization":
-		req := pb.GetAccountsByOrganizationRequest{}
+	case "get_tax_report":
+		req := pb.GetTaxReportRequest{}
 		req.OrganizationId = organization_id
-		resp, err := client.GetAccountsByOrganization(mctx, &req)
+		req.StartDate = start_date
+		req.EndDate = end_date
+		resp, err := client.GetTaxReport(mctx, &req)
 		printResponse(resp, err)
-	case "create_transaction":
-		req := pb.CreateTransactionRequest{}
+	case "create_fixed_asset":
+		req := pb.CreateFixedAssetRequest{}
 		req.OrganizationId = organization_id
-		req.TransactionDate = transaction_date
-		req.TransactionDescription = *description
-		req.TransactionTypeId = int32(*type_id)
-		if *from_party > 0 {
-			req.FromPartyId = *from_party
-		}
-		if *to_party > 0 {
-			req.ToPartyId = *to_party
-		}
-		req.PostedViaKey = *via_key
-
-		if *via_date != "" {
-			req.PostedViaDate = v_date
-		}
-		resp, err := client.CreateTransaction(mctx, &req)
+		req.AssetName = *name
+		req.Description = *description
+		req.AcquisitionDate = acquisition_date
+		req.AcquisitionCost, _ = dml.DecimalFromString(*cost)
+		if *salvage != "" {
+			req.SalvageValue, _ = dml.DecimalFromString(*salvage)
+		}
+		req.UsefulLifeMonths = int32(*life)
+		req.DepreciationMethod = depreciation_method
+		if *factor != "" {
+			req.DecliningFactor, _ = dml.DecimalFromString(*factor)
+		}
+		req.AssetAccountId = asset_account_id
+		req.AccumulatedAccountId = accum_account_id
+		req.ExpenseAccountId = expense_account_id
+		resp, err := client.CreateFixedAsset(mctx, &req)
 		printResponse(resp, err)
-	case "update_transaction":
-		req := pb.UpdateTransactionRequest{}
-		req.GlTransactionId = *id
+	case "update_fixed_asset":
+		req := pb.UpdateFixedAssetRequest{}
+		req.FixedAssetId = *id
 		req.Version = int32(*version)
-		req.TransactionDate = transaction_date
-		req.TransactionDescription = *description
-		req.TransactionTypeId = int32(*type_id)
-		if *from_party > 0 {
-			req.FromPartyId = *from_party
-		}
-		if *to_party > 0 {
-			req.ToPartyId = *to_party
-		}
-		req.PostedViaKey = *via_key
-
-		if *via_date != "" {
-			req.PostedViaDate = v_date
-		}
-		resp, err := client.UpdateTransaction(mctx, &req)
+		req.AssetName = *name
+		req.Description = *description
+		req.AcquisitionDate = acquisition_date
+		req.AcquisitionCost, _ = dml.DecimalFromString(*cost)
+		if *salvage != "" {
+			req.SalvageValue, _ = dml.DecimalFromString(*salvage)
+		}
+		req.UsefulLifeMonths = int32(*life)
+		req.DepreciationMethod = depreciation_method
+		if *factor != "" {
+			req.DecliningFactor, _ = dml.DecimalFromString(*factor)
+		}
+		req.AssetAccountId = asset_account_id
+		req.AccumulatedAccountId = accum_account_id
+		req.ExpenseAccountId = expense_account_id
+		resp, err := client.UpdateFixedAsset(mctx, &req)
 		printResponse(resp, err)
-	case "delete_transaction":
-		req := pb.DeleteTransactionRequest{}
-		req.GlTransactionId = *id
+	case "delete_fixed_asset":
+		req := pb.DeleteFixedAssetRequest{}
+		req.FixedAssetId = *id
 		req.Version = int32(*version)
-		resp, err := client.DeleteTransaction(mctx, &req)
+		resp, err := client.DeleteFixedAsset(mctx, &req)
 		printResponse(resp, err)
-	case "get_transaction_by_id":
-		req := pb.GetTransactionByIdRequest{}
-		req.GlTransactionId = *id
-		resp, err := client.GetTransactionById(mctx, &req)
+	case "get_fixed_asset_by_id":
+		req := pb.GetFixedAssetByIdRequest{}
+		req.FixedAssetId = *id
+		resp, err := client.GetFixedAssetById(mctx, &req)
 		printResponse(resp, err)
-	case "get_transaction_wrapper_by_id":
-		req := pb.GetTransactionWrapperByIdRequest{}
-		req.GlTransactionId = *id
-		resp, err := client.GetTransactionWrapperById(mctx, &req)
+	case "get_fixed_assets_by_organization":
+		req := pb.GetFixedAssetsByOrganizationRequest{}
+		req.OrganizationId = organization_id
+		req.AssetStatus = asset_status_value
+		resp, err := client.GetFixedAssetsByOrganization(mctx, &req)
 		printResponse(resp, err)
-	case "get_transaction_wrappers_by_date":
-		req := pb.GetTransactionWrappersByDateRequest{}
+	case "run_depreciation":
+		req := pb.RunDepreciationRequest{}
 		req.OrganizationId = organization_id
-		req.StartDate = start_date
-		req.EndDate = end_date
-		resp, err := client.GetTransactionWrappersByDate(mctx, &req)
+		req.FiscalYear = int32(*id)
+		req.PeriodNumber = int32(*period)
+		req.TransactionTypeId = int32(*type_id)
+		req.TransactionDescription = *description
+		resp, err := client.RunDepreciation(mctx, &req)
 		printResponse(resp, err)
-	case "add_transaction_details":
-		req := pb.AddTransactionDetailsRequest{}
-		req.GlTransactionId = *id
-		req.GlTransactionDetails = details
-		resp, err := client.AddTransactionDetails(mctx, &req)
+	case "dispose_fixed_asset":
+		req := pb.DisposeFixedAssetRequest{}
+		req.FixedAssetId = *id
+		req.Version = int32(*version)
+		req.DisposalDate = transaction_date
+		if *amt != "" {
+			req.DisposalProceeds, _ = dml.DecimalFromString(*amt)
+		}
+		req.ProceedsAccountId = proceeds_account_id
+		req.GainLossAccountId = gain_loss_id
+		req.TransactionTypeId = int32(*type_id)
+		resp, err := client.DisposeFixedAsset(mctx, &req)
+		printResponse(resp, err)
+	case "get_depreciation_entries":
+		req := pb.GetDepreciationEntriesRequest{}
+		req.FixedAssetId = *id
+		resp, err := client.GetDepreciationEntries(mctx, &req)
 		printResponse(resp, err)
 	case "get_server_version":
 		req := pb.GetServerVersionRequest{}
@@ -840,10 +3423,408 @@ func printResponse(resp interface{}, err error) {
 	}
 }
 
+// Helper to print balance sheet response as a report.
+func printBalanceSheet(resp *pb.GetBalanceSheetResponse, err error) {
+	if (err != nil) || (resp.GetErrorCode() != 0) {
+		printResponse(resp, err)
+		return
+	}
+
+	fmt.Println("ASSETS")
+	printReportLines(resp.GetAssetLines())
+	printReportTotal("Total Assets", resp.GetTotalAssets())
+	fmt.Println()
+	fmt.Println("LIABILITIES")
+	printReportLines(resp.GetLiabilityLines())
+	printReportTotal("Total Liabilities", resp.GetTotalLiabilities())
+	fmt.Println()
+	fmt.Println("EQUITY")
+	printReportLines(resp.GetEquityLines())
+	printReportAmount("Current Net Income", resp.GetCurrentNetIncome())
+	printReportTotal("Total Equity", resp.GetTotalEquity())
+	fmt.Println()
+	printReportTotal("Total Liabilities and Equity", resp.GetTotalLiabilitiesAndEquity())
+	if !resp.GetIsBalanced() {
+		fmt.Println("*** balance sheet does not balance ***")
+	}
+}
+
+// Helper to print income statement response as a report.
+func printIncomeStatement(resp *pb.GetIncomeStatementResponse, err error) {
+	if (err != nil) || (resp.GetErrorCode() != 0) {
+		printResponse(resp, err)
+		return
+	}
+
+	fmt.Println("REVENUE")
+	printReportLines(resp.GetRevenueLines())
+	printReportTotal("Total Revenue", resp.GetTotalRevenue())
+	fmt.Println()
+	fmt.Println("EXPENSES")
+	printReportLines(resp.GetExpenseLines())
+	printReportTotal("Total Expenses", resp.GetTotalExpenses())
+	fmt.Println()
+	printReportTotal("Net Income", resp.GetNetIncome())
+}
+
+// Helper to print the intercompany balances eliminated from a consolidated report.
+func printEliminations(eliminations []*pb.GLConsolidationElimination) {
+	if len(eliminations) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Printf("%-44s %18s %18s\n", "ELIMINATIONS", "Debits", "Credits")
+	for _, elim := range eliminations {
+		label := elim.GetAccountName()
+		if elim.GetEliminationRuleId() != 0 {
+			label = fmt.Sprintf("%s (rule %d)", label, elim.GetEliminationRuleId())
+		}
+		fmt.Printf("    %-40s %18s %18s\n", label, elim.GetTotalDebits().StringFromDecimal(), elim.GetTotalCredits().StringFromDecimal())
+	}
+}
+
+// Helper to print party aging response as a report.
+func printPartyAging(resp *pb.GetPartyAgingResponse) {
+	fmt.Printf("%-32s %14s %14s %14s %14s %14s %14s\n", "PARTY", "Current", "1-30", "31-60", "61-90", "Over 90", "Total")
+	account := ""
+	for _, aging := range resp.GetGlPartyAgings() {
+		if aging.GetAccountName() != account {
+			account = aging.GetAccountName()
+			fmt.Println(account)
+		}
+		printAgingLine("    "+aging.GetPartyName(), aging)
+	}
+	printAgingLine("TOTAL", resp.GetTotalAging())
+}
+
+func printAgingLine(label string, aging *pb.GLPartyAging) {
+	fmt.Printf("%-32s %14s %14s %14s %14s %14s %14s\n", label, aging.GetCurrent().StringFromDecimal(), aging.GetDays_1_30().StringFromDecimal(),
+		aging.GetDays_31_60().StringFromDecimal(), aging.GetDays_61_90().StringFromDecimal(), aging.GetDaysOver_90().StringFromDecimal(),
+		aging.GetTotal().StringFromDecimal())
+}
+
+func printReportLines(lines []*pb.GLReportLine) {
+	for _, line := range lines {
+		printReportAmount(line.GetAccountName(), line.GetAmount())
+	}
+}
+
+func printReportAmount(label string, amount *dml.Decimal) {
+	fmt.Printf("    %-40s %18s\n", label, amount.StringFromDecimal())
+}
+
+func printReportTotal(label string, amount *dml.Decimal) {
+	fmt.Printf("%-44s %18s\n", label, amount.StringFromDecimal())
+}
+
+// Parse account category name, empty string for unspecified.
+func ParseAccountCategory(s string) (pb.AccountCategory, error) {
+	if s == "" {
+		return pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.AccountCategory_value["ACCOUNT_CATEGORY_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.AccountCategory(val), nil
+}
+
+// Parse normal balance side, empty string for unspecified.
+func ParseNormalBalance(s string) (pb.NormalBalance, error) {
+	if s == "" {
+		return pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.NormalBalance_value["NORMAL_BALANCE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.NormalBalance(val), nil
+}
+
+// Parse fiscal calendar type name, empty string for unspecified.
+func ParseFiscalCalendarType(s string) (pb.FiscalCalendarType, error) {
+	if s == "" {
+		return pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.FiscalCalendarType_value["FISCAL_CALENDAR_TYPE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.FiscalCalendarType(val), nil
+}
+
+// Parse fiscal period status name, empty string for unspecified.
+func ParsePeriodStatus(s string) (pb.PeriodStatus, error) {
+	if s == "" {
+		return pb.PeriodStatus_PERIOD_STATUS_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.PeriodStatus_value["PERIOD_STATUS_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.PeriodStatus_PERIOD_STATUS_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.PeriodStatus(val), nil
+}
+
+// Parse transaction status name, empty string for unspecified.
+func ParseTransactionStatus(s string) (pb.TransactionStatus, error) {
+	if s == "" {
+		return pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.TransactionStatus_value["TRANSACTION_STATUS_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.TransactionStatus(val), nil
+}
+
+func ParseRateSource(s string) (pb.RateSource, error) {
+	if s == "" {
+		return pb.RateSource_RATE_SOURCE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.RateSource_value["RATE_SOURCE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.RateSource_RATE_SOURCE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.RateSource(val), nil
+}
+
+func ParseRecurrenceFrequency(s string) (pb.RecurrenceFrequency, error) {
+	if s == "" {
+		return pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.RecurrenceFrequency_value["RECURRENCE_FREQUENCY_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.RecurrenceFrequency(val), nil
+}
+
+func ParseAutoReverseMode(s string) (pb.AutoReverseMode, error) {
+	if s == "" {
+		return pb.AutoReverseMode_AUTO_REVERSE_MODE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.AutoReverseMode_value["AUTO_REVERSE_MODE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.AutoReverseMode_AUTO_REVERSE_MODE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.AutoReverseMode(val), nil
+}
+
+func ParseStatementFormat(s string) (pb.StatementFormat, error) {
+	if s == "" {
+		return pb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.StatementFormat_value["STATEMENT_FORMAT_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.StatementFormat(val), nil
+}
+
+func ParseMatchStatus(s string) (pb.MatchStatus, error) {
+	if s == "" {
+		return pb.MatchStatus_MATCH_STATUS_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.MatchStatus_value["MATCH_STATUS_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.MatchStatus_MATCH_STATUS_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.MatchStatus(val), nil
+}
+
+func ParseSubledgerType(s string) (pb.SubledgerType, error) {
+	if s == "" {
+		return pb.SubledgerType_SUBLEDGER_TYPE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.SubledgerType_value["SUBLEDGER_TYPE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.SubledgerType_SUBLEDGER_TYPE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.SubledgerType(val), nil
+}
+
+func ParseTaxType(s string) (pb.TaxType, error) {
+	if s == "" {
+		return pb.TaxType_TAX_TYPE_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.TaxType_value["TAX_TYPE_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.TaxType_TAX_TYPE_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.TaxType(val), nil
+}
+
+func ParseDepreciationMethod(s string) (pb.DepreciationMethod, error) {
+	if s == "" {
+		return pb.DepreciationMethod_DEPRECIATION_METHOD_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.DepreciationMethod_value["DEPRECIATION_METHOD_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.DepreciationMethod_DEPRECIATION_METHOD_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.DepreciationMethod(val), nil
+}
+
+func ParseAssetStatus(s string) (pb.AssetStatus, error) {
+	if s == "" {
+		return pb.AssetStatus_ASSET_STATUS_UNSPECIFIED, nil
+	}
+
+	val, ok := pb.AssetStatus_value["ASSET_STATUS_"+strings.ToUpper(s)]
+	if !ok {
+		return pb.AssetStatus_ASSET_STATUS_UNSPECIFIED, InvalidParameter
+	}
+
+	return pb.AssetStatus(val), nil
+}
+
+func ParseDimensionFilter(s string) (*pb.GLDetailDimension, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if (len(parts) != 2) || (parts[0] == "") {
+		return nil, InvalidParameter
+	}
+
+	return &pb.GLDetailDimension{DimensionName: parts[0], DimensionValue: parts[1]}, nil
+}
+
+type ExchangeRate struct {
+	Cur  string `json:"cur"`
+	Rate string `json:"rate"`
+}
+
+func TransformRates(inJson string) ([]*pb.GLExchangeRate, error) {
+	var result []*pb.GLExchangeRate
+
+	var list []ExchangeRate
+
+	err := json.Unmarshal([]byte(inJson), &list)
+	if err != nil {
+		fmt.Printf("Unmarshal err: %s\n", err)
+		return nil, err
+	}
+
+	for _, r := range list {
+		if !validDecimal.MatchString(r.Rate) {
+			fmt.Printf("not a valid decimal: %s\n", r.Rate)
+			return nil, InvalidParameter
+		}
+
+		var exchangeRate pb.GLExchangeRate
+		exchangeRate.CurrencyCode = r.Cur
+		exchangeRate.Rate, _ = dml.DecimalFromString(r.Rate)
+		result = append(result, &exchangeRate)
+	}
+
+	return result, nil
+}
+
+type BudgetAmount struct {
+	Aid    string `json:"aid"`
+	Period int32  `json:"period"`
+	Amt    string `json:"amt"`
+}
+
+func TransformBudgetAmounts(inJson string) ([]*pb.GLBudgetAmount, error) {
+	var result []*pb.GLBudgetAmount
+
+	var list []BudgetAmount
+
+	err := json.Unmarshal([]byte(inJson), &list)
+	if err != nil {
+		fmt.Printf("Unmarshal err: %s\n", err)
+		return nil, err
+	}
+
+	for _, b := range list {
+		account_id, err := dml.GuidFromString(b.Aid)
+		if err != nil {
+			fmt.Printf("not a valid guid: %s\n", b.Aid)
+			return nil, InvalidParameter
+		}
+
+		if !validDecimal.MatchString(b.Amt) {
+			fmt.Printf("not a valid decimal: %s\n", b.Amt)
+			return nil, InvalidParameter
+		}
+
+		var budgetAmount pb.GLBudgetAmount
+		budgetAmount.GlAccountId = account_id
+		budgetAmount.PeriodNumber = b.Period
+		budgetAmount.Amount, _ = dml.DecimalFromString(b.Amt)
+		result = append(result, &budgetAmount)
+	}
+
+	return result, nil
+}
+
+type FiscalPeriod struct {
+	Name  string `json:"name"`
+	Sdate string `json:"sdate"`
+	Edate string `json:"edate"`
+}
+
+func TransformPeriods(inJson string) ([]*pb.GLFiscalPeriod, error) {
+	var result []*pb.GLFiscalPeriod
+
+	var list []FiscalPeriod
+
+	err := json.Unmarshal([]byte(inJson), &list)
+	if err != nil {
+		fmt.Printf("Unmarshal err: %s\n", err)
+		return nil, err
+	}
+
+	for _, p := range list {
+		if !dateValidator.MatchString(p.Sdate) || !dateValidator.MatchString(p.Edate) {
+			fmt.Printf("period %s dates not in yyyy-mm-dd format\n", p.Name)
+			return nil, InvalidParameter
+		}
+
+		var fiscalPeriod pb.GLFiscalPeriod
+		fiscalPeriod.PeriodName = p.Name
+		fiscalPeriod.StartDate = dml.DateTimeFromString(p.Sdate)
+		fiscalPeriod.EndDate = dml.DateTimeFromString(p.Edate)
+		result = append(result, &fiscalPeriod)
+	}
+
+	return result, nil
+}
+
 type TranDetail struct {
-	Aid   string `json:"aid"`
-	Amt   string `json:"amt"`
-	Debit bool   `json:"debit,omitempty"`
+	Aid   string            `json:"aid"`
+	Amt   string            `json:"amt"`
+	Debit bool              `json:"debit,omitempty"`
+	Cur   string            `json:"cur,omitempty"`
+	Famt  string            `json:"famt,omitempty"`
+	Dims  map[string]string `json:"dims,omitempty"`
+	Tax   string            `json:"tax,omitempty"`
 }
 
 func TransformDetails(tranId int64, inJson string) ([]*pb.GLTransactionDetail, error) {
@@ -860,27 +3841,53 @@ func TransformDetails(tranId int64, inJson string) ([]*pb.GLTransactionDetail, e
 
 	var sequence int32
 	for _, detail := range list {
-		fmt.Printf("Aid: %s, Amt: %s, Debit: %b\n", detail.Aid, detail.Amt, detail.Debit)
+		fmt.Printf("Aid: %s, Amt: %s, Debit: %t\n", detail.Aid, detail.Amt, detail.Debit)
 		account_id, err := dml.GuidFromString(detail.Aid)
 		if err != nil {
 			fmt.Printf("not a valid guid: %s\n", detail.Aid)
 			return nil, InvalidParameter
 		}
 
-		if !validDecimal.MatchString(detail.Amt) {
+		// the amount may be left to the server when converting from a foreign currency
+		if ((detail.Amt != "") || (detail.Cur == "")) && !validDecimal.MatchString(detail.Amt) {
 			fmt.Printf("not a valid decimal: %s\n", detail.Amt)
 			return nil, InvalidParameter
 		}
 
+		if (detail.Cur != "") && !validDecimal.MatchString(detail.Famt) {
+			fmt.Printf("not a valid decimal: %s\n", detail.Famt)
+			return nil, InvalidParameter
+		}
+
 		var tranDetail pb.GLTransactionDetail
 		tranDetail.GlTransactionId = tranId
 		sequence++
 		tranDetail.SequenceNumber = sequence
 
 		tranDetail.GlAccountId = account_id
-		amount, _ := dml.DecimalFromString(detail.Amt)
-		tranDetail.Amount = amount
+		if detail.Amt != "" {
+			tranDetail.Amount, _ = dml.DecimalFromString(detail.Amt)
+		}
 		tranDetail.IsDebit = detail.Debit
+		if detail.Cur != "" {
+			tranDetail.CurrencyCode = detail.Cur
+			tranDetail.ForeignAmount, _ = dml.DecimalFromString(detail.Famt)
+		}
+		tranDetail.TaxCode = detail.Tax
+
+		// keep dimensions in a stable order
+		var dimNames []string
+		for dimName := range detail.Dims {
+			dimNames = append(dimNames, dimName)
+		}
+		sort.Strings(dimNames)
+
+		for _, dimName := range dimNames {
+			tranDetail.Dimensions = append(tranDetail.Dimensions, &pb.GLDetailDimension{
+				DimensionName:  dimName,
+				DimensionValue: detail.Dims[dimName],
+			})
+		}
 		result = append(result, &tranDetail)
 	}
 
@@ -15,11 +15,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
@@ -65,18 +67,19 @@ type cli struct {
 }
 
 type cfg struct {
-	ProjConf    string
-	LogFile     string
-	CertFile    string
-	KeyFile     string
-	Tls         bool
-	Port        int
-	RestPort    int
-	DbUser      string
-	DbPwd       string
-	DbTransport string
-	JwtPubFile  string
-	CorsOrigin  string
+	ProjConf          string
+	LogFile           string
+	CertFile          string
+	KeyFile           string
+	Tls               bool
+	Port              int
+	RestPort          int
+	DbUser            string
+	DbPwd             string
+	DbTransport       string
+	JwtPubFile        string
+	CorsOrigin        string
+	SchedulerInterval int
 }
 
 func setupFlags(cmd *cobra.Command) error {
@@ -92,6 +95,7 @@ func setupFlags(cmd *cobra.Command) error {
 	cmd.Flags().String("db_pwd", "", "Database user password.")
 	cmd.Flags().String("db_transport", "", "Database transport string.")
 	cmd.Flags().String("jwt_pub_file", "", "Path to JWT public certificate.")
+	cmd.Flags().Int("scheduler_interval", 60, "Minutes between scheduler runs for recurring entries and automatic reversals, 0 to disable.")
 
 	return viper.BindPFlags(cmd.Flags())
 }
@@ -124,6 +128,7 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 	c.cfg.DbPwd = viper.GetString("db_pwd")
 	c.cfg.DbTransport = viper.GetString("db_transport")
 	c.cfg.JwtPubFile = viper.GetString("jwt_pub_file")
+	c.cfg.SchedulerInterval = viper.GetInt("scheduler_interval")
 
 	return nil
 }
@@ -140,6 +145,7 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	db_pwd := c.cfg.DbPwd
 	db_transport := c.cfg.DbTransport
 	jwt_pub_file := c.cfg.JwtPubFile
+	scheduler_interval := c.cfg.SchedulerInterval
 
 	var logWriter io.Writer
 
@@ -161,6 +167,7 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	level.Info(logger).Log("db_user", db_user)
 	level.Info(logger).Log("db_transport", db_transport)
 	level.Info(logger).Log("jwt_pub_file", jwt_pub_file)
+	level.Info(logger).Log("scheduler_interval", scheduler_interval)
 
 	listen_port := ":" + strconv.Itoa(int(port))
 	// fmt.Println(listen_port)
@@ -194,6 +201,14 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	glService.SetLogger(logger)
 	glService.SetDatabaseConnection(sqlDb)
 
+	// the scheduler stops when the server does
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+
+	if scheduler_interval > 0 {
+		go glService.RunScheduler(schedulerCtx, time.Duration(scheduler_interval)*time.Minute)
+	}
+
 	// wire up the authorization middleware
 
 	glAuth := glauth.NewLedgerAuth(glService)
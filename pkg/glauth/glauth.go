@@ -28,6 +28,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/golang-jwt/jwt"
 
+	"github.com/gaterace/mledger/pkg/glservice"
 	pb "github.com/gaterace/mledger/pkg/mserviceledger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -217,6 +218,12 @@ func (s *GlAuth) HasReadOnlyAccess(ctx context.Context) (bool, int64) {
 	return false, 0
 }
 
+// Get a context for the delegated method recording whether the caller has admin access.
+func (s *GlAuth) withAdminAccess(ctx context.Context) context.Context {
+	admin, _ := s.HasAdminAccess(ctx)
+	return glservice.WithAdminAccess(ctx, admin)
+}
+
 // create a new general ledger organization
 func (s *GlAuth) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
 	start := time.Now().UnixNano()
@@ -879,7 +886,7 @@ func (s *GlAuth) CreateTransaction(ctx context.Context, req *pb.CreateTransactio
 	ok, aid := s.HasReadWriteAccess(ctx)
 	if ok {
 		req.MserviceId = aid
-		resp, err = s.glService.CreateTransaction(ctx, req)
+		resp, err = s.glService.CreateTransaction(s.withAdminAccess(ctx), req)
 	} else if s.IsTokenExpired(ctx) {
 		resp.ErrorCode = 498
 		resp.ErrorMessage = tokenExpiredMessage
@@ -905,7 +912,7 @@ func (s *GlAuth) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactio
 	ok, aid := s.HasReadWriteAccess(ctx)
 	if ok {
 		req.MserviceId = aid
-		resp, err = s.glService.UpdateTransaction(ctx, req)
+		resp, err = s.glService.UpdateTransaction(s.withAdminAccess(ctx), req)
 	} else if s.IsTokenExpired(ctx) {
 		resp.ErrorCode = 498
 		resp.ErrorMessage = tokenExpiredMessage
@@ -931,7 +938,7 @@ func (s *GlAuth) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactio
 	ok, aid := s.HasReadWriteAccess(ctx)
 	if ok {
 		req.MserviceId = aid
-		resp, err = s.glService.DeleteTransaction(ctx, req)
+		resp, err = s.glService.DeleteTransaction(s.withAdminAccess(ctx), req)
 	} else if s.IsTokenExpired(ctx) {
 		resp.ErrorCode = 498
 		resp.ErrorMessage = tokenExpiredMessage
@@ -1035,7 +1042,7 @@ func (s *GlAuth) AddTransactionDetails(ctx context.Context, req *pb.AddTransacti
 	ok, aid := s.HasReadWriteAccess(ctx)
 	if ok {
 		req.MserviceId = aid
-		resp, err = s.glService.AddTransactionDetails(ctx, req)
+		resp, err = s.glService.AddTransactionDetails(s.withAdminAccess(ctx), req)
 	} else if s.IsTokenExpired(ctx) {
 		resp.ErrorCode = 498
 		resp.ErrorMessage = tokenExpiredMessage
@@ -1049,6 +1056,2710 @@ func (s *GlAuth) AddTransactionDetails(ctx context.Context, req *pb.AddTransacti
 	return resp, err
 }
 
+// get general ledger trial balance for organization as of date
+func (s *GlAuth) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetTrialBalanceResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetTrialBalance(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetTrialBalance",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger account balance as of date
+func (s *GlAuth) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetAccountBalanceResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetAccountBalance(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetAccountBalance",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger account statement with running balance between dates
+func (s *GlAuth) GetAccountLedger(ctx context.Context, req *pb.GetAccountLedgerRequest) (*pb.GetAccountLedgerResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetAccountLedgerResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetAccountLedger(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetAccountLedger",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger balance sheet for organization as of date
+func (s *GlAuth) GetBalanceSheet(ctx context.Context, req *pb.GetBalanceSheetRequest) (*pb.GetBalanceSheetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBalanceSheetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBalanceSheet(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBalanceSheet",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger income statement for organization between dates
+func (s *GlAuth) GetIncomeStatement(ctx context.Context, req *pb.GetIncomeStatementRequest) (*pb.GetIncomeStatementResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetIncomeStatementResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetIncomeStatement(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetIncomeStatement",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create a general ledger fiscal year with its periods
+func (s *GlAuth) CreateFiscalYear(ctx context.Context, req *pb.CreateFiscalYearRequest) (*pb.CreateFiscalYearResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateFiscalYearResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateFiscalYear(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateFiscalYear",
+		"fiscalyear", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete a general ledger fiscal year with its periods
+func (s *GlAuth) DeleteFiscalYear(ctx context.Context, req *pb.DeleteFiscalYearRequest) (*pb.DeleteFiscalYearResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteFiscalYearResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteFiscalYear(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteFiscalYear",
+		"fiscalyear", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger fiscal years by organization
+func (s *GlAuth) GetFiscalYearsByOrganization(ctx context.Context, req *pb.GetFiscalYearsByOrganizationRequest) (*pb.GetFiscalYearsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetFiscalYearsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetFiscalYearsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetFiscalYearsByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger fiscal periods by fiscal year
+func (s *GlAuth) GetFiscalPeriodsByYear(ctx context.Context, req *pb.GetFiscalPeriodsByYearRequest) (*pb.GetFiscalPeriodsByYearResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetFiscalPeriodsByYearResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetFiscalPeriodsByYear(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetFiscalPeriodsByYear",
+		"fiscalyear", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update status of a general ledger fiscal period
+func (s *GlAuth) UpdateFiscalPeriodStatus(ctx context.Context, req *pb.UpdateFiscalPeriodStatusRequest) (*pb.UpdateFiscalPeriodStatusResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateFiscalPeriodStatusResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateFiscalPeriodStatus(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateFiscalPeriodStatus",
+		"periodnumber", req.GetPeriodNumber(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// close a general ledger fiscal year to retained earnings
+func (s *GlAuth) CloseFiscalYear(ctx context.Context, req *pb.CloseFiscalYearRequest) (*pb.CloseFiscalYearResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CloseFiscalYearResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CloseFiscalYear(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CloseFiscalYear",
+		"fiscalyear", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// reopen a closed general ledger fiscal year
+func (s *GlAuth) ReopenFiscalYear(ctx context.Context, req *pb.ReopenFiscalYearRequest) (*pb.ReopenFiscalYearResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ReopenFiscalYearResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ReopenFiscalYear(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ReopenFiscalYear",
+		"fiscalyear", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// reverse a general ledger transaction with a mirror image transaction
+func (s *GlAuth) ReverseTransaction(ctx context.Context, req *pb.ReverseTransactionRequest) (*pb.ReverseTransactionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ReverseTransactionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ReverseTransaction(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ReverseTransaction",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// void a general ledger transaction
+func (s *GlAuth) VoidTransaction(ctx context.Context, req *pb.VoidTransactionRequest) (*pb.VoidTransactionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.VoidTransactionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.VoidTransaction(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "VoidTransaction",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// post a draft general ledger transaction
+func (s *GlAuth) PostTransaction(ctx context.Context, req *pb.PostTransactionRequest) (*pb.PostTransactionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.PostTransactionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.PostTransaction(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "PostTransaction",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create and post general ledger transaction with its details in one step
+func (s *GlAuth) PostJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*pb.PostJournalEntryResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.PostJournalEntryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.PostJournalEntry(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "PostJournalEntry",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// replace all details of a draft general ledger transaction
+func (s *GlAuth) ReplaceTransactionDetails(ctx context.Context, req *pb.ReplaceTransactionDetailsRequest) (*pb.ReplaceTransactionDetailsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ReplaceTransactionDetailsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ReplaceTransactionDetails(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ReplaceTransactionDetails",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger accounts of organization as a tree
+func (s *GlAuth) GetAccountTree(ctx context.Context, req *pb.GetAccountTreeRequest) (*pb.GetAccountTreeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetAccountTreeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetAccountTree(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetAccountTree",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger account code range for an account category
+func (s *GlAuth) CreateAccountCodeRange(ctx context.Context, req *pb.CreateAccountCodeRangeRequest) (*pb.CreateAccountCodeRangeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateAccountCodeRangeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateAccountCodeRange(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateAccountCodeRange",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger account code range
+func (s *GlAuth) UpdateAccountCodeRange(ctx context.Context, req *pb.UpdateAccountCodeRangeRequest) (*pb.UpdateAccountCodeRangeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateAccountCodeRangeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateAccountCodeRange(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateAccountCodeRange",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger account code range
+func (s *GlAuth) DeleteAccountCodeRange(ctx context.Context, req *pb.DeleteAccountCodeRangeRequest) (*pb.DeleteAccountCodeRangeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteAccountCodeRangeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteAccountCodeRange(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteAccountCodeRange",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger account code ranges by organization
+func (s *GlAuth) GetAccountCodeRangesByOrganization(ctx context.Context, req *pb.GetAccountCodeRangesByOrganizationRequest) (*pb.GetAccountCodeRangesByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetAccountCodeRangesByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetAccountCodeRangesByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetAccountCodeRangesByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger exchange rate for a foreign currency
+func (s *GlAuth) CreateExchangeRate(ctx context.Context, req *pb.CreateExchangeRateRequest) (*pb.CreateExchangeRateResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateExchangeRateResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateExchangeRate(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateExchangeRate",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger exchange rate
+func (s *GlAuth) UpdateExchangeRate(ctx context.Context, req *pb.UpdateExchangeRateRequest) (*pb.UpdateExchangeRateResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateExchangeRateResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateExchangeRate(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateExchangeRate",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger exchange rate
+func (s *GlAuth) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteExchangeRateResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteExchangeRate(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteExchangeRate",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger exchange rates by organization
+func (s *GlAuth) GetExchangeRatesByOrganization(ctx context.Context, req *pb.GetExchangeRatesByOrganizationRequest) (*pb.GetExchangeRatesByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetExchangeRatesByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetExchangeRatesByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetExchangeRatesByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// revalue foreign currency balances of organization, posting unrealized exchange gains and losses
+func (s *GlAuth) RevalueForeignBalances(ctx context.Context, req *pb.RevalueForeignBalancesRequest) (*pb.RevalueForeignBalancesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.RevalueForeignBalancesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.RevalueForeignBalances(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "RevalueForeignBalances",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger analytical dimension
+func (s *GlAuth) CreateDimension(ctx context.Context, req *pb.CreateDimensionRequest) (*pb.CreateDimensionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateDimensionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateDimension(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateDimension",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger analytical dimension
+func (s *GlAuth) UpdateDimension(ctx context.Context, req *pb.UpdateDimensionRequest) (*pb.UpdateDimensionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateDimensionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateDimension(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateDimension",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger analytical dimension
+func (s *GlAuth) DeleteDimension(ctx context.Context, req *pb.DeleteDimensionRequest) (*pb.DeleteDimensionResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteDimensionResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteDimension(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteDimension",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger analytical dimensions with their values by organization
+func (s *GlAuth) GetDimensionsByOrganization(ctx context.Context, req *pb.GetDimensionsByOrganizationRequest) (*pb.GetDimensionsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetDimensionsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetDimensionsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetDimensionsByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create allowed value of general ledger analytical dimension
+func (s *GlAuth) CreateDimensionValue(ctx context.Context, req *pb.CreateDimensionValueRequest) (*pb.CreateDimensionValueResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateDimensionValueResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateDimensionValue(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateDimensionValue",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update allowed value of general ledger analytical dimension
+func (s *GlAuth) UpdateDimensionValue(ctx context.Context, req *pb.UpdateDimensionValueRequest) (*pb.UpdateDimensionValueResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateDimensionValueResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateDimensionValue(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateDimensionValue",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete allowed value of general ledger analytical dimension
+func (s *GlAuth) DeleteDimensionValue(ctx context.Context, req *pb.DeleteDimensionValueRequest) (*pb.DeleteDimensionValueResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteDimensionValueResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteDimensionValue(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteDimensionValue",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger account balances grouped by value of an analytical dimension
+func (s *GlAuth) GetDimensionBalances(ctx context.Context, req *pb.GetDimensionBalancesRequest) (*pb.GetDimensionBalancesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetDimensionBalancesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetDimensionBalances(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetDimensionBalances",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger budget for a fiscal year
+func (s *GlAuth) CreateBudget(ctx context.Context, req *pb.CreateBudgetRequest) (*pb.CreateBudgetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateBudgetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateBudget(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateBudget",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger budget
+func (s *GlAuth) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.UpdateBudgetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateBudgetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateBudget(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateBudget",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger budget with its amounts
+func (s *GlAuth) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteBudgetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteBudget(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteBudget",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger budgets by organization
+func (s *GlAuth) GetBudgetsByOrganization(ctx context.Context, req *pb.GetBudgetsByOrganizationRequest) (*pb.GetBudgetsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBudgetsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBudgetsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBudgetsByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger budget amount for account and fiscal period
+func (s *GlAuth) CreateBudgetAmount(ctx context.Context, req *pb.CreateBudgetAmountRequest) (*pb.CreateBudgetAmountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateBudgetAmountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateBudgetAmount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateBudgetAmount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger budget amount
+func (s *GlAuth) UpdateBudgetAmount(ctx context.Context, req *pb.UpdateBudgetAmountRequest) (*pb.UpdateBudgetAmountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateBudgetAmountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateBudgetAmount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateBudgetAmount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger budget amount
+func (s *GlAuth) DeleteBudgetAmount(ctx context.Context, req *pb.DeleteBudgetAmountRequest) (*pb.DeleteBudgetAmountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteBudgetAmountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteBudgetAmount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteBudgetAmount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger budget amounts of budget
+func (s *GlAuth) GetBudgetAmounts(ctx context.Context, req *pb.GetBudgetAmountsRequest) (*pb.GetBudgetAmountsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBudgetAmountsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBudgetAmounts(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBudgetAmounts",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create or update general ledger budget amounts in bulk
+func (s *GlAuth) LoadBudgetAmounts(ctx context.Context, req *pb.LoadBudgetAmountsRequest) (*pb.LoadBudgetAmountsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.LoadBudgetAmountsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.LoadBudgetAmounts(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "LoadBudgetAmounts",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger budget versus actual amounts with variances
+func (s *GlAuth) GetBudgetVsActual(ctx context.Context, req *pb.GetBudgetVsActualRequest) (*pb.GetBudgetVsActualResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBudgetVsActualResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBudgetVsActual(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBudgetVsActual",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger recurring journal entry
+func (s *GlAuth) CreateRecurringEntry(ctx context.Context, req *pb.CreateRecurringEntryRequest) (*pb.CreateRecurringEntryResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateRecurringEntryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateRecurringEntry(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateRecurringEntry",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger recurring journal entry
+func (s *GlAuth) UpdateRecurringEntry(ctx context.Context, req *pb.UpdateRecurringEntryRequest) (*pb.UpdateRecurringEntryResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateRecurringEntryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateRecurringEntry(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateRecurringEntry",
+		"recurringentryid", req.GetRecurringEntryId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger recurring journal entry
+func (s *GlAuth) DeleteRecurringEntry(ctx context.Context, req *pb.DeleteRecurringEntryRequest) (*pb.DeleteRecurringEntryResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteRecurringEntryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteRecurringEntry(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteRecurringEntry",
+		"recurringentryid", req.GetRecurringEntryId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger recurring journal entry by id
+func (s *GlAuth) GetRecurringEntryById(ctx context.Context, req *pb.GetRecurringEntryByIdRequest) (*pb.GetRecurringEntryByIdResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetRecurringEntryByIdResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetRecurringEntryById(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetRecurringEntryById",
+		"recurringentryid", req.GetRecurringEntryId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger recurring journal entries by organization
+func (s *GlAuth) GetRecurringEntriesByOrganization(ctx context.Context, req *pb.GetRecurringEntriesByOrganizationRequest) (*pb.GetRecurringEntriesByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetRecurringEntriesByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetRecurringEntriesByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetRecurringEntriesByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get generated and upcoming occurrences of general ledger recurring journal entry
+func (s *GlAuth) GetRecurringInstances(ctx context.Context, req *pb.GetRecurringInstancesRequest) (*pb.GetRecurringInstancesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetRecurringInstancesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetRecurringInstances(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetRecurringInstances",
+		"recurringentryid", req.GetRecurringEntryId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger intercompany due to and due from accounts for partner organization
+func (s *GlAuth) CreateIntercompanyAccount(ctx context.Context, req *pb.CreateIntercompanyAccountRequest) (*pb.CreateIntercompanyAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateIntercompanyAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateIntercompanyAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateIntercompanyAccount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger intercompany due to and due from accounts
+func (s *GlAuth) UpdateIntercompanyAccount(ctx context.Context, req *pb.UpdateIntercompanyAccountRequest) (*pb.UpdateIntercompanyAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateIntercompanyAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateIntercompanyAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateIntercompanyAccount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger intercompany due to and due from accounts
+func (s *GlAuth) DeleteIntercompanyAccount(ctx context.Context, req *pb.DeleteIntercompanyAccountRequest) (*pb.DeleteIntercompanyAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteIntercompanyAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteIntercompanyAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteIntercompanyAccount",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger intercompany accounts by organization
+func (s *GlAuth) GetIntercompanyAccountsByOrganization(ctx context.Context, req *pb.GetIntercompanyAccountsByOrganizationRequest) (*pb.GetIntercompanyAccountsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetIntercompanyAccountsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetIntercompanyAccountsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetIntercompanyAccountsByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// post general ledger transactions for one business event in two organizations
+func (s *GlAuth) PostIntercompanyEntry(ctx context.Context, req *pb.PostIntercompanyEntryRequest) (*pb.PostIntercompanyEntryResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.PostIntercompanyEntryResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.PostIntercompanyEntry(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "PostIntercompanyEntry",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger organization group for consolidation
+func (s *GlAuth) CreateOrganizationGroup(ctx context.Context, req *pb.CreateOrganizationGroupRequest) (*pb.CreateOrganizationGroupResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateOrganizationGroupResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateOrganizationGroup(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateOrganizationGroup",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger organization group
+func (s *GlAuth) UpdateOrganizationGroup(ctx context.Context, req *pb.UpdateOrganizationGroupRequest) (*pb.UpdateOrganizationGroupResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateOrganizationGroupResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateOrganizationGroup(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateOrganizationGroup",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger organization group with its members, account mappings and elimination rules
+func (s *GlAuth) DeleteOrganizationGroup(ctx context.Context, req *pb.DeleteOrganizationGroupRequest) (*pb.DeleteOrganizationGroupResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteOrganizationGroupResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteOrganizationGroup(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteOrganizationGroup",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger organization groups
+func (s *GlAuth) GetOrganizationGroups(ctx context.Context, req *pb.GetOrganizationGroupsRequest) (*pb.GetOrganizationGroupsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetOrganizationGroupsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetOrganizationGroups(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetOrganizationGroups",
+		"mserviceid", req.GetMserviceId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// add organization to general ledger organization group
+func (s *GlAuth) CreateOrganizationGroupMember(ctx context.Context, req *pb.CreateOrganizationGroupMemberRequest) (*pb.CreateOrganizationGroupMemberResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateOrganizationGroupMemberResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateOrganizationGroupMember(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateOrganizationGroupMember",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// remove organization from general ledger organization group
+func (s *GlAuth) DeleteOrganizationGroupMember(ctx context.Context, req *pb.DeleteOrganizationGroupMemberRequest) (*pb.DeleteOrganizationGroupMemberResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteOrganizationGroupMemberResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteOrganizationGroupMember(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteOrganizationGroupMember",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get members of general ledger organization group
+func (s *GlAuth) GetOrganizationGroupMembers(ctx context.Context, req *pb.GetOrganizationGroupMembersRequest) (*pb.GetOrganizationGroupMembersResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetOrganizationGroupMembersResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetOrganizationGroupMembers(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetOrganizationGroupMembers",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// map general ledger account onto the group chart of accounts
+func (s *GlAuth) CreateGroupAccountMap(ctx context.Context, req *pb.CreateGroupAccountMapRequest) (*pb.CreateGroupAccountMapResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateGroupAccountMapResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateGroupAccountMap(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateGroupAccountMap",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger account mapping onto the group chart of accounts
+func (s *GlAuth) UpdateGroupAccountMap(ctx context.Context, req *pb.UpdateGroupAccountMapRequest) (*pb.UpdateGroupAccountMapResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateGroupAccountMapResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateGroupAccountMap(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateGroupAccountMap",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger account mapping onto the group chart of accounts
+func (s *GlAuth) DeleteGroupAccountMap(ctx context.Context, req *pb.DeleteGroupAccountMapRequest) (*pb.DeleteGroupAccountMapResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteGroupAccountMapResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteGroupAccountMap(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteGroupAccountMap",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger account mappings of organization group
+func (s *GlAuth) GetGroupAccountMaps(ctx context.Context, req *pb.GetGroupAccountMapsRequest) (*pb.GetGroupAccountMapsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetGroupAccountMapsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetGroupAccountMaps(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetGroupAccountMaps",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger consolidation elimination rule for a pair of intercompany accounts
+func (s *GlAuth) CreateEliminationRule(ctx context.Context, req *pb.CreateEliminationRuleRequest) (*pb.CreateEliminationRuleResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateEliminationRuleResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateEliminationRule(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateEliminationRule",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger consolidation elimination rule
+func (s *GlAuth) UpdateEliminationRule(ctx context.Context, req *pb.UpdateEliminationRuleRequest) (*pb.UpdateEliminationRuleResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateEliminationRuleResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateEliminationRule(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateEliminationRule",
+		"eliminationruleid", req.GetEliminationRuleId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger consolidation elimination rule
+func (s *GlAuth) DeleteEliminationRule(ctx context.Context, req *pb.DeleteEliminationRuleRequest) (*pb.DeleteEliminationRuleResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteEliminationRuleResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteEliminationRule(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteEliminationRule",
+		"eliminationruleid", req.GetEliminationRuleId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger consolidation elimination rules of organization group
+func (s *GlAuth) GetEliminationRules(ctx context.Context, req *pb.GetEliminationRulesRequest) (*pb.GetEliminationRulesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetEliminationRulesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetEliminationRules(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetEliminationRules",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger trial balance consolidated over organization group as of date
+func (s *GlAuth) GetConsolidatedTrialBalance(ctx context.Context, req *pb.GetConsolidatedTrialBalanceRequest) (*pb.GetConsolidatedTrialBalanceResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetConsolidatedTrialBalanceResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetConsolidatedTrialBalance(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetConsolidatedTrialBalance",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger balance sheet consolidated over organization group as of date
+func (s *GlAuth) GetConsolidatedBalanceSheet(ctx context.Context, req *pb.GetConsolidatedBalanceSheetRequest) (*pb.GetConsolidatedBalanceSheetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetConsolidatedBalanceSheetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetConsolidatedBalanceSheet(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetConsolidatedBalanceSheet",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger income statement consolidated over organization group between dates
+func (s *GlAuth) GetConsolidatedIncomeStatement(ctx context.Context, req *pb.GetConsolidatedIncomeStatementRequest) (*pb.GetConsolidatedIncomeStatementResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetConsolidatedIncomeStatementResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetConsolidatedIncomeStatement(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetConsolidatedIncomeStatement",
+		"groupname", req.GetGroupName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// import bank statement lines for a general ledger cash account and match them to transaction details
+func (s *GlAuth) ImportBankStatement(ctx context.Context, req *pb.ImportBankStatementRequest) (*pb.ImportBankStatementResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ImportBankStatementResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ImportBankStatement(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ImportBankStatement",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// match unmatched bank statement lines of a general ledger account to transaction details
+func (s *GlAuth) AutoMatchBankStatement(ctx context.Context, req *pb.AutoMatchBankStatementRequest) (*pb.AutoMatchBankStatementResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.AutoMatchBankStatementResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.AutoMatchBankStatement(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "AutoMatchBankStatement",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// confirm the match of a bank statement line, or match it to a given transaction detail
+func (s *GlAuth) ConfirmBankMatch(ctx context.Context, req *pb.ConfirmBankMatchRequest) (*pb.ConfirmBankMatchResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ConfirmBankMatchResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ConfirmBankMatch(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ConfirmBankMatch",
+		"statementlineid", req.GetStatementLineId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// remove the match of a bank statement line
+func (s *GlAuth) UnmatchBankStatementLine(ctx context.Context, req *pb.UnmatchBankStatementLineRequest) (*pb.UnmatchBankStatementLineResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UnmatchBankStatementLineResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UnmatchBankStatementLine(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UnmatchBankStatementLine",
+		"statementlineid", req.GetStatementLineId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete an unreconciled bank statement line
+func (s *GlAuth) DeleteBankStatementLine(ctx context.Context, req *pb.DeleteBankStatementLineRequest) (*pb.DeleteBankStatementLineResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteBankStatementLineResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteBankStatementLine(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteBankStatementLine",
+		"statementlineid", req.GetStatementLineId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get bank statement lines of a general ledger account between dates
+func (s *GlAuth) GetBankStatementLines(ctx context.Context, req *pb.GetBankStatementLinesRequest) (*pb.GetBankStatementLinesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBankStatementLinesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBankStatementLines(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBankStatementLines",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get bank reconciliation of a general ledger account between dates
+func (s *GlAuth) GetBankReconciliation(ctx context.Context, req *pb.GetBankReconciliationRequest) (*pb.GetBankReconciliationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetBankReconciliationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetBankReconciliation(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetBankReconciliation",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger control account for receivable or payable open items
+func (s *GlAuth) CreateControlAccount(ctx context.Context, req *pb.CreateControlAccountRequest) (*pb.CreateControlAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateControlAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateControlAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateControlAccount",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger control account
+func (s *GlAuth) UpdateControlAccount(ctx context.Context, req *pb.UpdateControlAccountRequest) (*pb.UpdateControlAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateControlAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateControlAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateControlAccount",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger control account
+func (s *GlAuth) DeleteControlAccount(ctx context.Context, req *pb.DeleteControlAccountRequest) (*pb.DeleteControlAccountResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteControlAccountResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteControlAccount(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteControlAccount",
+		"accountid", req.GetGlAccountId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger control accounts for organization
+func (s *GlAuth) GetControlAccountsByOrganization(ctx context.Context, req *pb.GetControlAccountsByOrganizationRequest) (*pb.GetControlAccountsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetControlAccountsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetControlAccountsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetControlAccountsByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get receivable or payable open items of an organization
+func (s *GlAuth) GetOpenItems(ctx context.Context, req *pb.GetOpenItemsRequest) (*pb.GetOpenItemsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetOpenItemsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetOpenItems(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetOpenItems",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// apply a credit open item against a charge open item, or against the oldest open charges of its party
+func (s *GlAuth) ApplyOpenItem(ctx context.Context, req *pb.ApplyOpenItemRequest) (*pb.ApplyOpenItemResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.ApplyOpenItemResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.ApplyOpenItem(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "ApplyOpenItem",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete application of a credit open item against a charge open item
+func (s *GlAuth) DeleteOpenItemApplication(ctx context.Context, req *pb.DeleteOpenItemApplicationRequest) (*pb.DeleteOpenItemApplicationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteOpenItemApplicationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteOpenItemApplication(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteOpenItemApplication",
+		"applicationid", req.GetApplicationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get open item applications of a transaction
+func (s *GlAuth) GetOpenItemApplications(ctx context.Context, req *pb.GetOpenItemApplicationsRequest) (*pb.GetOpenItemApplicationsResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetOpenItemApplicationsResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetOpenItemApplications(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetOpenItemApplications",
+		"transactionid", req.GetGlTransactionId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get open receivable or payable balances of parties
+func (s *GlAuth) GetPartyBalances(ctx context.Context, req *pb.GetPartyBalancesRequest) (*pb.GetPartyBalancesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetPartyBalancesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetPartyBalances(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetPartyBalances",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get open receivable or payable balances of parties aged by days past due
+func (s *GlAuth) GetPartyAging(ctx context.Context, req *pb.GetPartyAgingRequest) (*pb.GetPartyAgingResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetPartyAgingResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetPartyAging(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetPartyAging",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger tax code
+func (s *GlAuth) CreateTaxCode(ctx context.Context, req *pb.CreateTaxCodeRequest) (*pb.CreateTaxCodeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateTaxCodeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateTaxCode(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateTaxCode",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger tax code
+func (s *GlAuth) UpdateTaxCode(ctx context.Context, req *pb.UpdateTaxCodeRequest) (*pb.UpdateTaxCodeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateTaxCodeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateTaxCode(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateTaxCode",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger tax code
+func (s *GlAuth) DeleteTaxCode(ctx context.Context, req *pb.DeleteTaxCodeRequest) (*pb.DeleteTaxCodeResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteTaxCodeResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteTaxCode(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteTaxCode",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger tax codes for organization
+func (s *GlAuth) GetTaxCodesByOrganization(ctx context.Context, req *pb.GetTaxCodesByOrganizationRequest) (*pb.GetTaxCodesByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetTaxCodesByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetTaxCodesByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetTaxCodesByOrganization",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get taxable amounts and tax of an organization per tax code for a period
+func (s *GlAuth) GetTaxReport(ctx context.Context, req *pb.GetTaxReportRequest) (*pb.GetTaxReportResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetTaxReportResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetTaxReport(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetTaxReport",
+		"organizationid", req.GetOrganizationId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// create general ledger fixed asset
+func (s *GlAuth) CreateFixedAsset(ctx context.Context, req *pb.CreateFixedAssetRequest) (*pb.CreateFixedAssetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.CreateFixedAssetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.CreateFixedAsset(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "CreateFixedAsset",
+		"asset_name", req.GetAssetName(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// update general ledger fixed asset
+func (s *GlAuth) UpdateFixedAsset(ctx context.Context, req *pb.UpdateFixedAssetRequest) (*pb.UpdateFixedAssetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.UpdateFixedAssetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.UpdateFixedAsset(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "UpdateFixedAsset",
+		"fixed_asset_id", req.GetFixedAssetId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// delete general ledger fixed asset
+func (s *GlAuth) DeleteFixedAsset(ctx context.Context, req *pb.DeleteFixedAssetRequest) (*pb.DeleteFixedAssetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DeleteFixedAssetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasAdminAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DeleteFixedAsset(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DeleteFixedAsset",
+		"fixed_asset_id", req.GetFixedAssetId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger fixed asset by id
+func (s *GlAuth) GetFixedAssetById(ctx context.Context, req *pb.GetFixedAssetByIdRequest) (*pb.GetFixedAssetByIdResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetFixedAssetByIdResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetFixedAssetById(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetFixedAssetById",
+		"fixed_asset_id", req.GetFixedAssetId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get general ledger fixed assets for organization
+func (s *GlAuth) GetFixedAssetsByOrganization(ctx context.Context, req *pb.GetFixedAssetsByOrganizationRequest) (*pb.GetFixedAssetsByOrganizationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetFixedAssetsByOrganizationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetFixedAssetsByOrganization(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetFixedAssetsByOrganization",
+		"asset_status", req.GetAssetStatus(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// post the depreciation of the fixed assets of an organization for a fiscal period
+func (s *GlAuth) RunDepreciation(ctx context.Context, req *pb.RunDepreciationRequest) (*pb.RunDepreciationResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.RunDepreciationResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.RunDepreciation(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "RunDepreciation",
+		"fiscal_year", req.GetFiscalYear(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// dispose of a general ledger fixed asset
+func (s *GlAuth) DisposeFixedAsset(ctx context.Context, req *pb.DisposeFixedAssetRequest) (*pb.DisposeFixedAssetResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.DisposeFixedAssetResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadWriteAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.DisposeFixedAsset(s.withAdminAccess(ctx), req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "DisposeFixedAsset",
+		"fixed_asset_id", req.GetFixedAssetId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
+// get depreciation posted for a general ledger fixed asset
+func (s *GlAuth) GetDepreciationEntries(ctx context.Context, req *pb.GetDepreciationEntriesRequest) (*pb.GetDepreciationEntriesResponse, error) {
+	start := time.Now().UnixNano()
+	var err error
+
+	resp := &pb.GetDepreciationEntriesResponse{}
+	resp.ErrorCode = 401
+	resp.ErrorMessage = "not authorized"
+
+	ok, aid := s.HasReadOnlyAccess(ctx)
+	if ok {
+		req.MserviceId = aid
+		resp, err = s.glService.GetDepreciationEntries(ctx, req)
+	} else if s.IsTokenExpired(ctx) {
+		resp.ErrorCode = 498
+		resp.ErrorMessage = tokenExpiredMessage
+	}
+
+	duration := time.Now().UnixNano() - start
+	level.Info(s.logger).Log("endpoint", "GetDepreciationEntries",
+		"fixed_asset_id", req.GetFixedAssetId(),
+		"errcode", resp.GetErrorCode(), "duration", duration)
+
+	return resp, err
+}
+
 // get current server version and uptime - health check
 func (s *GlAuth) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
 	return s.glService.GetServerVersion(ctx, req)
@@ -38,6 +38,20 @@ var nameValidator = regexp.MustCompile("^[a-z0-9_\\-]{1,32}$")
 
 var emptyDateString = "0000-00-00 00:00:00"
 
+// Normal balance side for each account category. Contra accounts carry the side opposite to the accounts they offset.
+var categoryNormalBalance = map[pb.AccountCategory]pb.NormalBalance{
+	pb.AccountCategory_ACCOUNT_CATEGORY_ASSET:            pb.NormalBalance_NORMAL_BALANCE_DEBIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY:        pb.NormalBalance_NORMAL_BALANCE_CREDIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_EQUITY:           pb.NormalBalance_NORMAL_BALANCE_CREDIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE:          pb.NormalBalance_NORMAL_BALANCE_CREDIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE:          pb.NormalBalance_NORMAL_BALANCE_DEBIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_ASSET:     pb.NormalBalance_NORMAL_BALANCE_CREDIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_LIABILITY: pb.NormalBalance_NORMAL_BALANCE_DEBIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_EQUITY:    pb.NormalBalance_NORMAL_BALANCE_DEBIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_REVENUE:   pb.NormalBalance_NORMAL_BALANCE_DEBIT,
+	pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_EXPENSE:   pb.NormalBalance_NORMAL_BALANCE_CREDIT,
+}
+
 type glService struct {
 	pb.UnimplementedMServiceLedgerServer
 	logger    log.Logger
@@ -45,6 +59,22 @@ type glService struct {
 	startSecs int64
 }
 
+// key type for values the caller of a glService method places in its context
+type contextKey int
+
+const adminAccessKey contextKey = 0
+
+// Get a context recording whether the caller has admin access, which allows writing to soft closed fiscal periods.
+func WithAdminAccess(ctx context.Context, admin bool) context.Context {
+	return context.WithValue(ctx, adminAccessKey, admin)
+}
+
+// Check whether the caller recorded admin access in the context.
+func hasAdminAccess(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminAccessKey).(bool)
+	return admin
+}
+
 // Get a new projService instance.
 func NewGlService() *glService {
 	svc := glService{}
@@ -80,9 +110,15 @@ func (s *glService) CreateOrganization(ctx context.Context, req *pb.CreateOrgani
 		return resp, nil
 	}
 
+	if (req.GetBaseCurrency() != "") && !currencyValidator.MatchString(req.GetBaseCurrency()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "base_currency invalid format"
+		return resp, nil
+	}
+
 	sqlstring := `INSERT INTO tb_GLOrganization 
 	(uidOrganizationId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
-		chvOrganizationName, dtmFromDate, dtmToDate) VALUES(?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?)`
+		chvOrganizationName, dtmFromDate, dtmToDate, chvBaseCurrency) VALUES(?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?)`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -107,7 +143,14 @@ func (s *glService) CreateOrganization(ctx context.Context, req *pb.CreateOrgani
 		to_date.Valid = true
 	}
 
-	res, err := stmt.Exec(glId.GetGuid(), req.GetMserviceId(), req.GetOrganizationName(), from_date, to_date)
+	var base_currency sql.NullString
+
+	if req.GetBaseCurrency() != "" {
+		base_currency.String = req.GetBaseCurrency()
+		base_currency.Valid = true
+	}
+
+	res, err := stmt.Exec(glId.GetGuid(), req.GetMserviceId(), req.GetOrganizationName(), from_date, to_date, base_currency)
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
@@ -136,7 +179,65 @@ func (s *glService) UpdateOrganization(ctx context.Context, req *pb.UpdateOrgani
 		return resp, nil
 	}
 
-	sqlstring := `UPDATE tb_GLOrganization SET dtmModified = NOW(), intVersion = ?, chvOrganizationName = ?, dtmFromDate = ?, dtmToDate =  ? WHERE uidOrganizationId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
+	if (req.GetBaseCurrency() != "") && !currencyValidator.MatchString(req.GetBaseCurrency()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "base_currency invalid format"
+		return resp, nil
+	}
+
+	// an omitted base currency or account keeps its current value, so a client updating the name does not clear them
+	gResp := &genericResponse{}
+	if req.GetBaseCurrency() != "" {
+		gResp = s.checkBaseCurrencyChange(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBaseCurrency())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var re_account []byte
+
+	if len(req.GetRetainedEarningsAccountId().GetGuid()) > 0 {
+		gResp = s.checkRetainedEarningsAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetRetainedEarningsAccountId().GetGuid())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		re_account = req.GetRetainedEarningsAccountId().GetGuid()
+	}
+
+	var gain_account []byte
+	var loss_account []byte
+
+	if len(req.GetFxGainAccountId().GetGuid()) > 0 {
+		gResp = s.checkFxAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetFxGainAccountId().GetGuid())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		gain_account = req.GetFxGainAccountId().GetGuid()
+	}
+
+	if len(req.GetFxLossAccountId().GetGuid()) > 0 {
+		gResp = s.checkFxAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetFxLossAccountId().GetGuid())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		loss_account = req.GetFxLossAccountId().GetGuid()
+	}
+
+	sqlstring := `UPDATE tb_GLOrganization SET dtmModified = NOW(), intVersion = ?, chvOrganizationName = ?, dtmFromDate = ?, dtmToDate =  ?,
+	uidRetainedEarningsAccountId = COALESCE(?, uidRetainedEarningsAccountId), chvBaseCurrency = COALESCE(?, chvBaseCurrency),
+	uidFxGainAccountId = COALESCE(?, uidFxGainAccountId), uidFxLossAccountId = COALESCE(?, uidFxLossAccountId) WHERE uidOrganizationId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -157,9 +258,17 @@ func (s *glService) UpdateOrganization(ctx context.Context, req *pb.UpdateOrgani
 		to_date.Valid = true
 	}
 
+	var base_currency sql.NullString
+
+	if req.GetBaseCurrency() != "" {
+		base_currency.String = req.GetBaseCurrency()
+		base_currency.Valid = true
+	}
+
 	guid := req.GetOrganizationId()
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationName(), from_date, to_date, guid.Guid, req.GetMserviceId(), req.GetVersion())
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationName(), from_date, to_date, re_account, base_currency, gain_account, loss_account, guid.Guid,
+		req.GetMserviceId(), req.GetVersion())
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
@@ -219,7 +328,8 @@ func (s *glService) DeleteOrganization(ctx context.Context, req *pb.DeleteOrgani
 func (s *glService) GetOrganizationById(ctx context.Context, req *pb.GetOrganizationByIdRequest) (*pb.GetOrganizationByIdResponse, error) {
 	resp := &pb.GetOrganizationByIdResponse{}
 
-	sqlstring := `SELECT uidOrganizationId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvOrganizationName, dtmFromDate, dtmToDate FROM tb_GLOrganization WHERE 
+	sqlstring := `SELECT uidOrganizationId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvOrganizationName, dtmFromDate, dtmToDate,
+	uidRetainedEarningsAccountId, chvBaseCurrency, uidFxGainAccountId, uidFxLossAccountId FROM tb_GLOrganization WHERE 
 	uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
@@ -239,9 +349,14 @@ func (s *glService) GetOrganizationById(ctx context.Context, req *pb.GetOrganiza
 	var modified time.Time
 	var start_date time.Time
 	var end_date sql.NullTime
+	var re_account []byte
+	var base_currency sql.NullString
+	var gain_account []byte
+	var loss_account []byte
 	var org pb.GLOrganization
 
-	err = stmt.QueryRow(guid.Guid, req.GetMserviceId()).Scan(&gid, &created, &modified, &org.Version, &org.MserviceId, &org.OrganizationName, &start_date, &end_date)
+	err = stmt.QueryRow(guid.Guid, req.GetMserviceId()).Scan(&gid, &created, &modified, &org.Version, &org.MserviceId, &org.OrganizationName, &start_date, &end_date,
+		&re_account, &base_currency, &gain_account, &loss_account)
 	if err == nil {
 		org.OrganizationId, _ = dml.GuidFromBytes(gid)
 		org.Created = dml.DateTimeFromTime(created)
@@ -251,6 +366,18 @@ func (s *glService) GetOrganizationById(ctx context.Context, req *pb.GetOrganiza
 			org.ToDate = dml.DateTimeFromTime(end_date.Time)
 			level.Debug(s.logger).Log("end_date", end_date, "millis", org.ToDate.Milliseconds)
 		}
+		if re_account != nil {
+			org.RetainedEarningsAccountId, _ = dml.GuidFromBytes(re_account)
+		}
+		if base_currency.Valid {
+			org.BaseCurrency = base_currency.String
+		}
+		if gain_account != nil {
+			org.FxGainAccountId, _ = dml.GuidFromBytes(gain_account)
+		}
+		if loss_account != nil {
+			org.FxLossAccountId, _ = dml.GuidFromBytes(loss_account)
+		}
 
 		resp.GlOrganization = &org
 		resp.ErrorCode = 0
@@ -272,7 +399,8 @@ func (s *glService) GetOrganizationById(ctx context.Context, req *pb.GetOrganiza
 func (s *glService) GetOrganizationsByMservice(ctx context.Context, req *pb.GetOrganizationsByMserviceRequest) (*pb.GetOrganizationsByMserviceResponse, error) {
 	resp := &pb.GetOrganizationsByMserviceResponse{}
 
-	sqlstring := `SELECT uidOrganizationId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvOrganizationName, dtmFromDate, dtmToDate FROM tb_GLOrganization WHERE 
+	sqlstring := `SELECT uidOrganizationId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvOrganizationName, dtmFromDate, dtmToDate,
+	uidRetainedEarningsAccountId, chvBaseCurrency, uidFxGainAccountId, uidFxLossAccountId FROM tb_GLOrganization WHERE 
 	inbMserviceId = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
@@ -300,10 +428,15 @@ func (s *glService) GetOrganizationsByMservice(ctx context.Context, req *pb.GetO
 		var modified time.Time
 		var start_date time.Time
 		var end_date sql.NullTime
+		var re_account []byte
+		var base_currency sql.NullString
+		var gain_account []byte
+		var loss_account []byte
 
 		var org pb.GLOrganization
 
-		err := rows.Scan(&gid, &created, &modified, &org.Version, &org.MserviceId, &org.OrganizationName, &start_date, &end_date)
+		err := rows.Scan(&gid, &created, &modified, &org.Version, &org.MserviceId, &org.OrganizationName, &start_date, &end_date, &re_account,
+			&base_currency, &gain_account, &loss_account)
 
 		if err != nil {
 			level.Error(s.logger).Log("what", "Scan", "error", err)
@@ -319,6 +452,18 @@ func (s *glService) GetOrganizationsByMservice(ctx context.Context, req *pb.GetO
 		if end_date.Valid {
 			org.ToDate = dml.DateTimeFromTime(end_date.Time)
 		}
+		if re_account != nil {
+			org.RetainedEarningsAccountId, _ = dml.GuidFromBytes(re_account)
+		}
+		if base_currency.Valid {
+			org.BaseCurrency = base_currency.String
+		}
+		if gain_account != nil {
+			org.FxGainAccountId, _ = dml.GuidFromBytes(gain_account)
+		}
+		if loss_account != nil {
+			org.FxLossAccountId, _ = dml.GuidFromBytes(loss_account)
+		}
 
 		resp.GlOrganizations = append(resp.GlOrganizations, &org)
 	}
@@ -329,10 +474,16 @@ func (s *glService) GetOrganizationsByMservice(ctx context.Context, req *pb.GetO
 // create general ledger account type
 func (s *glService) CreateAccountType(ctx context.Context, req *pb.CreateAccountTypeRequest) (*pb.CreateAccountTypeResponse, error) {
 	resp := &pb.CreateAccountTypeResponse{}
+	normalBalance, vResp := validateAccountClassification(req.GetAccountCategory(), req.GetNormalBalance())
+	if vResp.ErrorCode != 0 {
+		resp.ErrorCode = vResp.ErrorCode
+		resp.ErrorMessage = vResp.ErrorMessage
+		return resp, nil
+	}
 
 	sqlstring := `INSERT INTO tb_GLAccountType (inbMserviceId, intAccountTypeId, dtmCreated, 
-		dtmModified, dtmDeleted, bitIsDeleted, intVersion, chvAccountType) 
-		VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1,?)`
+		dtmModified, dtmDeleted, bitIsDeleted, intVersion, chvAccountType, intAccountCategory, intNormalBalance) 
+		VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -344,7 +495,7 @@ func (s *glService) CreateAccountType(ctx context.Context, req *pb.CreateAccount
 
 	defer stmt.Close()
 
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetAccountTypeId(), req.GetAccountType())
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetAccountTypeId(), req.GetAccountType(), req.GetAccountCategory(), normalBalance)
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
@@ -367,9 +518,15 @@ func (s *glService) CreateAccountType(ctx context.Context, req *pb.CreateAccount
 // update general ledger account type
 func (s *glService) UpdateAccountType(ctx context.Context, req *pb.UpdateAccountTypeRequest) (*pb.UpdateAccountTypeResponse, error) {
 	resp := &pb.UpdateAccountTypeResponse{}
+	normalBalance, vResp := validateAccountClassification(req.GetAccountCategory(), req.GetNormalBalance())
+	if vResp.ErrorCode != 0 {
+		resp.ErrorCode = vResp.ErrorCode
+		resp.ErrorMessage = vResp.ErrorMessage
+		return resp, nil
+	}
 
-	sqlstring := `UPDATE tb_GLAccountType SET dtmModified = NOW(), intVersion = ?, chvAccountType = ? 
-	WHERE inbMserviceId = ? AND intAccountTypeId = ? AND intVersion = ? AND bitIsDeleted = 0`
+	sqlstring := `UPDATE tb_GLAccountType SET dtmModified = NOW(), intVersion = ?, chvAccountType = ?, intAccountCategory = ?,
+	intNormalBalance = ? WHERE inbMserviceId = ? AND intAccountTypeId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -381,7 +538,8 @@ func (s *glService) UpdateAccountType(ctx context.Context, req *pb.UpdateAccount
 
 	defer stmt.Close()
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetAccountType(), req.GetMserviceId(), req.GetAccountTypeId(), req.GetVersion())
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetAccountType(), req.GetAccountCategory(), normalBalance, req.GetMserviceId(),
+		req.GetAccountTypeId(), req.GetVersion())
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
 		if rowsAffected == 1 {
@@ -441,8 +599,8 @@ func (s *glService) DeleteAccountType(ctx context.Context, req *pb.DeleteAccount
 func (s *glService) GetAccountTypeById(ctx context.Context, req *pb.GetAccountTypeByIdRequest) (*pb.GetAccountTypeByIdResponse, error) {
 	resp := &pb.GetAccountTypeByIdResponse{}
 
-	sqlstring := `SELECT inbMserviceId, intAccountTypeId, dtmCreated, dtmModified, intVersion, chvAccountType
-	FROM tb_GLAccountType WHERE inbMserviceId = ? AND intAccountTypeId = ? AND bitIsDeleted = 0`
+	sqlstring := `SELECT inbMserviceId, intAccountTypeId, dtmCreated, dtmModified, intVersion, chvAccountType, intAccountCategory,
+	intNormalBalance FROM tb_GLAccountType WHERE inbMserviceId = ? AND intAccountTypeId = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -456,11 +614,15 @@ func (s *glService) GetAccountTypeById(ctx context.Context, req *pb.GetAccountTy
 
 	var created time.Time
 	var modified time.Time
+	var category int32
+	var normalBalance int32
 	var acctType pb.GLAccountType
 
 	err = stmt.QueryRow(req.GetMserviceId(), req.GetAccountTypeId()).Scan(&acctType.MserviceId, &acctType.AccountTypeId, &created,
-		&modified, &acctType.Version, &acctType.AccountType)
+		&modified, &acctType.Version, &acctType.AccountType, &category, &normalBalance)
 	if err == nil {
+		acctType.AccountCategory = pb.AccountCategory(category)
+		acctType.NormalBalance = pb.NormalBalance(normalBalance)
 		acctType.Created = dml.DateTimeFromTime(created)
 		acctType.Modified = dml.DateTimeFromTime(modified)
 		resp.GlAccountType = &acctType
@@ -482,8 +644,8 @@ func (s *glService) GetAccountTypeById(ctx context.Context, req *pb.GetAccountTy
 func (s *glService) GetAccountTypesByMservice(ctx context.Context, req *pb.GetAccountTypesByMserviceRequest) (*pb.GetAccountTypesByMserviceResponse, error) {
 	resp := &pb.GetAccountTypesByMserviceResponse{}
 
-	sqlstring := `SELECT inbMserviceId, intAccountTypeId, dtmCreated, dtmModified, intVersion, chvAccountType
-	FROM tb_GLAccountType WHERE inbMserviceId = ? AND bitIsDeleted = 0`
+	sqlstring := `SELECT inbMserviceId, intAccountTypeId, dtmCreated, dtmModified, intVersion, chvAccountType, intAccountCategory,
+	intNormalBalance FROM tb_GLAccountType WHERE inbMserviceId = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -507,9 +669,11 @@ func (s *glService) GetAccountTypesByMservice(ctx context.Context, req *pb.GetAc
 	for rows.Next() {
 		var created time.Time
 		var modified time.Time
+		var category int32
+		var normalBalance int32
 		var acctType pb.GLAccountType
 		err := rows.Scan(&acctType.MserviceId, &acctType.AccountTypeId, &created,
-			&modified, &acctType.Version, &acctType.AccountType)
+			&modified, &acctType.Version, &acctType.AccountType, &category, &normalBalance)
 		if err != nil {
 			level.Error(s.logger).Log("what", "Scan", "error", err)
 			resp.ErrorCode = 500
@@ -517,6 +681,9 @@ func (s *glService) GetAccountTypesByMservice(ctx context.Context, req *pb.GetAc
 			return resp, nil
 		}
 
+		acctType.AccountCategory = pb.AccountCategory(category)
+		acctType.NormalBalance = pb.NormalBalance(normalBalance)
+
 		acctType.Created = dml.DateTimeFromTime(created)
 		acctType.Modified = dml.DateTimeFromTime(modified)
 
@@ -525,3 +692,31 @@ func (s *glService) GetAccountTypesByMservice(ctx context.Context, req *pb.GetAc
 
 	return resp, nil
 }
+
+// Validate the account category and normal balance of an account type.
+// An unspecified normal balance defaults to the normal side of the category.
+func validateAccountClassification(category pb.AccountCategory, normalBalance pb.NormalBalance) (pb.NormalBalance, *genericResponse) {
+	resp := &genericResponse{}
+
+	categoryBalance, ok := categoryNormalBalance[category]
+	if !ok {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "account_category missing or invalid"
+		return normalBalance, resp
+	}
+
+	switch normalBalance {
+	case pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED:
+		normalBalance = categoryBalance
+	case pb.NormalBalance_NORMAL_BALANCE_DEBIT, pb.NormalBalance_NORMAL_BALANCE_CREDIT:
+		if normalBalance != categoryBalance {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "normal_balance does not match account_category"
+		}
+	default:
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "normal_balance invalid"
+	}
+
+	return normalBalance, resp
+}
@@ -0,0 +1,926 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// months between occurrences of each recurrence frequency
+var recurrenceMonths = map[pb.RecurrenceFrequency]int{
+	pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:   1,
+	pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_QUARTERLY: 3,
+	pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_ANNUALLY:  12,
+}
+
+// columns of tb_GLRecurringEntry in the order scanned by scanRecurringEntry
+var recurringEntryColumns = `inbRecurringEntryId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, chvTransactionDescription, intTransactionTypeId, inbFromPartyId, inbToPartyId, intFrequency, intDayOfMonth,
+	dtmStartDate, dtmEndDate, dtmNextDate, chvLastError`
+
+// create general ledger recurring journal entry
+func (s *glService) CreateRecurringEntry(ctx context.Context, req *pb.CreateRecurringEntryRequest) (*pb.CreateRecurringEntryResponse, error) {
+	resp := &pb.CreateRecurringEntryResponse{}
+
+	entry := pb.GLRecurringEntry{
+		MserviceId:             req.GetMserviceId(),
+		OrganizationId:         req.GetOrganizationId(),
+		TransactionDescription: req.GetTransactionDescription(),
+		TransactionTypeId:      req.GetTransactionTypeId(),
+		FromPartyId:            req.GetFromPartyId(),
+		ToPartyId:              req.GetToPartyId(),
+		Frequency:              req.GetFrequency(),
+		DayOfMonth:             req.GetDayOfMonth(),
+		StartDate:              req.GetStartDate(),
+		EndDate:                req.GetEndDate(),
+		GlTransactionDetails:   req.GetGlTransactionDetails(),
+	}
+
+	gResp := s.checkRecurringEntry(&entry)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	nextDate := scheduleNextDate(&entry, entry.GetStartDate().TimeFromDateTime())
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring := `INSERT INTO tb_GLRecurringEntry (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, chvTransactionDescription, intTransactionTypeId, inbFromPartyId, inbToPartyId, intFrequency, intDayOfMonth,
+	dtmStartDate, dtmEndDate, dtmNextDate) VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	from_party, to_party, end_date := recurringEntryColumnValues(&entry)
+
+	res, err := tx.Exec(sqlstring, req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTransactionDescription(),
+		req.GetTransactionTypeId(), from_party, to_party, int32(req.GetFrequency()), req.GetDayOfMonth(),
+		req.GetStartDate().TimeFromDateTime(), end_date, nextDate)
+
+	var entryId int64
+	if err == nil {
+		entryId, err = res.LastInsertId()
+	}
+
+	if err == nil {
+		err = insertRecurringEntryDetails(tx, entryId, req.GetGlTransactionDetails())
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.RecurringEntryId = entryId
+	resp.Version = 1
+
+	return resp, nil
+}
+
+// update general ledger recurring journal entry
+func (s *glService) UpdateRecurringEntry(ctx context.Context, req *pb.UpdateRecurringEntryRequest) (*pb.UpdateRecurringEntryResponse, error) {
+	resp := &pb.UpdateRecurringEntryResponse{}
+
+	gResp, entry := s.GetRecurringEntryHelper(req.GetRecurringEntryId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	entry.TransactionDescription = req.GetTransactionDescription()
+	entry.TransactionTypeId = req.GetTransactionTypeId()
+	entry.FromPartyId = req.GetFromPartyId()
+	entry.ToPartyId = req.GetToPartyId()
+	entry.Frequency = req.GetFrequency()
+	entry.DayOfMonth = req.GetDayOfMonth()
+	entry.StartDate = req.GetStartDate()
+	entry.EndDate = req.GetEndDate()
+	entry.GlTransactionDetails = req.GetGlTransactionDetails()
+
+	gResp = s.checkRecurringEntry(entry)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// occurrences already generated are not generated again under the new schedule
+	notBefore := entry.GetStartDate().TimeFromDateTime()
+
+	var lastGenerated sql.NullTime
+	err := s.db.QueryRow(`SELECT MAX(dtmScheduledDate) FROM tb_GLRecurringInstance WHERE inbRecurringEntryId = ?`,
+		req.GetRecurringEntryId()).Scan(&lastGenerated)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if lastGenerated.Valid && !lastGenerated.Time.Before(notBefore) {
+		notBefore = startOfDay(lastGenerated.Time).AddDate(0, 0, 1)
+	}
+
+	nextDate := scheduleNextDate(entry, notBefore)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring := `UPDATE tb_GLRecurringEntry SET dtmModified = NOW(), intVersion = ?, chvTransactionDescription = ?,
+	intTransactionTypeId = ?, inbFromPartyId = ?, inbToPartyId = ?, intFrequency = ?, intDayOfMonth = ?, dtmStartDate = ?,
+	dtmEndDate = ?, dtmNextDate = ?, chvLastError = NULL
+	WHERE inbRecurringEntryId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	from_party, to_party, end_date := recurringEntryColumnValues(entry)
+
+	res, err := tx.Exec(sqlstring, req.GetVersion()+1, req.GetTransactionDescription(), req.GetTransactionTypeId(), from_party,
+		to_party, int32(req.GetFrequency()), req.GetDayOfMonth(), req.GetStartDate().TimeFromDateTime(), end_date, nextDate,
+		req.GetRecurringEntryId(), req.GetVersion(), req.GetMserviceId())
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	_, err = tx.Exec(`DELETE FROM tb_GLRecurringEntryDetailDimension WHERE inbRecurringEntryId = ?`, req.GetRecurringEntryId())
+	if err == nil {
+		_, err = tx.Exec(`DELETE FROM tb_GLRecurringEntryDetail WHERE inbRecurringEntryId = ?`, req.GetRecurringEntryId())
+	}
+
+	if err == nil {
+		err = insertRecurringEntryDetails(tx, req.GetRecurringEntryId(), req.GetGlTransactionDetails())
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+
+	return resp, nil
+}
+
+// delete general ledger recurring journal entry
+func (s *glService) DeleteRecurringEntry(ctx context.Context, req *pb.DeleteRecurringEntryRequest) (*pb.DeleteRecurringEntryResponse, error) {
+	resp := &pb.DeleteRecurringEntryResponse{}
+
+	// generated transactions stay, so the entry is only marked deleted
+	sqlstring := `UPDATE tb_GLRecurringEntry SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ?, dtmNextDate = NULL
+	WHERE inbRecurringEntryId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetRecurringEntryId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger recurring journal entry by id
+func (s *glService) GetRecurringEntryById(ctx context.Context, req *pb.GetRecurringEntryByIdRequest) (*pb.GetRecurringEntryByIdResponse, error) {
+	resp := &pb.GetRecurringEntryByIdResponse{}
+
+	gResp, entry := s.GetRecurringEntryHelper(req.GetRecurringEntryId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlRecurringEntry = entry
+
+	return resp, nil
+}
+
+// get general ledger recurring journal entries by organization
+func (s *glService) GetRecurringEntriesByOrganization(ctx context.Context, req *pb.GetRecurringEntriesByOrganizationRequest) (*pb.GetRecurringEntriesByOrganizationResponse, error) {
+	resp := &pb.GetRecurringEntriesByOrganizationResponse{}
+
+	sqlstring := `SELECT ` + recurringEntryColumns + `
+	FROM tb_GLRecurringEntry WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY inbRecurringEntryId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	entryMap := make(map[int64]*pb.GLRecurringEntry)
+
+	for rows.Next() {
+		entry, err := scanRecurringEntry(rows)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		entryMap[entry.GetRecurringEntryId()] = entry
+		resp.GlRecurringEntries = append(resp.GlRecurringEntries, entry)
+	}
+
+	// now get the template details
+	gResp := s.loadRecurringEntryDetails(entryMap, `e.uidOrganizationId = ? AND e.inbMserviceId = ? AND e.bitIsDeleted = 0`,
+		req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get generated and upcoming occurrences of general ledger recurring journal entry
+func (s *glService) GetRecurringInstances(ctx context.Context, req *pb.GetRecurringInstancesRequest) (*pb.GetRecurringInstancesResponse, error) {
+	resp := &pb.GetRecurringInstancesResponse{}
+
+	gResp, entry := s.GetRecurringEntryHelper(req.GetRecurringEntryId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `SELECT inbRecurringEntryId, dtmScheduledDate, dtmCreated, inbGlTransactionId
+	FROM tb_GLRecurringInstance WHERE inbRecurringEntryId = ?
+	ORDER BY dtmScheduledDate`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetRecurringEntryId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var instance pb.GLRecurringInstance
+		var scheduled time.Time
+		var generated time.Time
+
+		err := rows.Scan(&instance.RecurringEntryId, &scheduled, &generated, &instance.GlTransactionId)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		instance.ScheduledDate = dml.DateTimeFromTime(scheduled)
+		instance.Generated = dml.DateTimeFromTime(generated)
+		instance.IsGenerated = true
+
+		resp.GlRecurringInstances = append(resp.GlRecurringInstances, &instance)
+	}
+
+	upcoming := req.GetUpcomingCount()
+	if upcoming <= 0 {
+		upcoming = 12
+	}
+
+	for _, date := range upcomingOccurrences(entry, upcoming) {
+		instance := pb.GLRecurringInstance{
+			RecurringEntryId: entry.GetRecurringEntryId(),
+			ScheduledDate:    dml.DateTimeFromTime(date),
+		}
+
+		resp.GlRecurringInstances = append(resp.GlRecurringInstances, &instance)
+	}
+
+	return resp, nil
+}
+
+// Generate transactions for recurring journal entries and automatic reversals as they fall due, checking at the
+// given interval. Runs until the context is cancelled.
+func (s *glService) RunScheduler(ctx context.Context, interval time.Duration) {
+	level.Info(s.logger).Log("msg", "starting scheduler", "interval", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.GenerateRecurringEntries(time.Now())
+		s.GenerateAutoReversals(time.Now())
+
+		select {
+		case <-ctx.Done():
+			level.Info(s.logger).Log("msg", "stopping scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Generate the transactions of every recurring journal entry occurrence due on or before the given time,
+// returning the number generated. An occurrence that cannot be posted, for example because its fiscal period is
+// closed, is recorded as the last error of its entry and retried on the next run.
+func (s *glService) GenerateRecurringEntries(asOf time.Time) int {
+	sqlstring := `SELECT inbRecurringEntryId, inbMserviceId FROM tb_GLRecurringEntry
+	WHERE bitIsDeleted = 0 AND dtmNextDate IS NOT NULL AND dtmNextDate <= ?
+	ORDER BY inbRecurringEntryId`
+
+	rows, err := s.db.Query(sqlstring, asOf)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		return 0
+	}
+
+	type dueEntry struct {
+		entryId    int64
+		mserviceId int64
+	}
+
+	var due []dueEntry
+
+	for rows.Next() {
+		var d dueEntry
+		err := rows.Scan(&d.entryId, &d.mserviceId)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			rows.Close()
+			return 0
+		}
+
+		due = append(due, d)
+	}
+
+	rows.Close()
+
+	count := 0
+	for _, d := range due {
+		count += s.generateRecurringEntry(d.entryId, d.mserviceId, asOf)
+	}
+
+	if count > 0 {
+		level.Info(s.logger).Log("msg", "generated recurring entries", "count", count)
+	}
+
+	return count
+}
+
+// Generate the due occurrences of one recurring journal entry, oldest first, returning the number generated.
+func (s *glService) generateRecurringEntry(entryId int64, mserviceId int64, asOf time.Time) int {
+	count := 0
+
+	for {
+		gResp, entry := s.GetRecurringEntryHelper(entryId, mserviceId)
+		if gResp.ErrorCode != 0 {
+			return count
+		}
+
+		if (entry.GetNextDate() == nil) || entry.GetNextDate().TimeFromDateTime().After(asOf) {
+			return count
+		}
+
+		scheduled := entry.GetNextDate().TimeFromDateTime()
+
+		req := pb.PostJournalEntryRequest{
+			MserviceId:             entry.GetMserviceId(),
+			OrganizationId:         entry.GetOrganizationId(),
+			TransactionDate:        entry.GetNextDate(),
+			TransactionDescription: entry.GetTransactionDescription(),
+			TransactionTypeId:      entry.GetTransactionTypeId(),
+			FromPartyId:            entry.GetFromPartyId(),
+			ToPartyId:              entry.GetToPartyId(),
+		}
+
+		for _, detail := range entry.GetGlTransactionDetails() {
+			req.GlTransactionDetails = append(req.GlTransactionDetails, proto.Clone(detail).(*pb.GLTransactionDetail))
+		}
+
+		// the scheduler has no admin rights, so soft closed periods hold generation back
+		orgId := entry.GetOrganizationId().GetGuid()
+		gResp = s.checkPeriodOpen(context.Background(), mserviceId, orgId, scheduled)
+		if gResp.ErrorCode == 0 {
+			gResp, req.GlTransactionDetails = s.prepareTransactionDetails(mserviceId, orgId, scheduled, req.GetGlTransactionDetails())
+		}
+
+		if gResp.ErrorCode == 0 {
+			gResp = s.commitRecurringInstance(entry, scheduleNextDate(entry, scheduled.AddDate(0, 0, 1)), &req)
+		}
+
+		if gResp.ErrorCode != 0 {
+			level.Warn(s.logger).Log("what", "generateRecurringEntry", "recurringentryid", entryId,
+				"errcode", gResp.ErrorCode, "error", gResp.ErrorMessage)
+
+			_, err := s.db.Exec(`UPDATE tb_GLRecurringEntry SET chvLastError = ? WHERE inbRecurringEntryId = ?`,
+				truncateString(gResp.ErrorMessage, 255), entryId)
+			if err != nil {
+				level.Error(s.logger).Log("what", "Exec", "error", err)
+			}
+
+			return count
+		}
+
+		count++
+	}
+}
+
+// Post the transaction of a recurring journal entry occurrence, record the occurrence and move the entry on
+// to its next date, in a single database transaction. The version check keeps two servers from generating the
+// same occurrence.
+func (s *glService) commitRecurringInstance(entry *pb.GLRecurringEntry, nextDate sql.NullTime, req *pb.PostJournalEntryRequest) *genericResponse {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	res, err := tx.Exec(`UPDATE tb_GLRecurringEntry SET dtmModified = NOW(), intVersion = ?, dtmNextDate = ?, chvLastError = NULL
+	WHERE inbRecurringEntryId = ? AND intVersion = ? AND bitIsDeleted = 0`,
+		entry.GetVersion()+1, nextDate, entry.GetRecurringEntryId(), entry.GetVersion())
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp
+	}
+
+	transactionId, err := insertJournalEntry(tx, req)
+	if err == nil {
+		_, err = tx.Exec(`INSERT INTO tb_GLRecurringInstance (inbRecurringEntryId, dtmScheduledDate, dtmCreated, inbGlTransactionId)
+		VALUES (?, ?, NOW(), ?)`, entry.GetRecurringEntryId(), req.GetTransactionDate().TimeFromDateTime(), transactionId)
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
+
+// Get a recurring journal entry that has not been deleted, together with its template details.
+func (s *glService) GetRecurringEntryHelper(entryId int64, mserviceId int64) (*genericResponse, *pb.GLRecurringEntry) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT ` + recurringEntryColumns + `
+	FROM tb_GLRecurringEntry WHERE inbRecurringEntryId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	entry, err := scanRecurringEntry(stmt.QueryRow(entryId, mserviceId))
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	entryMap := map[int64]*pb.GLRecurringEntry{entryId: entry}
+
+	gResp := s.loadRecurringEntryDetails(entryMap, `e.inbRecurringEntryId = ?`, entryId)
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	return resp, entry
+}
+
+// Scan a row of recurringEntryColumns from either *sql.Row or *sql.Rows.
+func scanRecurringEntry(row interface {
+	Scan(dest ...interface{}) error
+}) (*pb.GLRecurringEntry, error) {
+	var entry pb.GLRecurringEntry
+	var created time.Time
+	var modified time.Time
+	var deleted time.Time
+	var orgId []byte
+	var from_party sql.NullInt64
+	var to_party sql.NullInt64
+	var frequency int32
+	var start_date time.Time
+	var end_date sql.NullTime
+	var next_date sql.NullTime
+	var last_error sql.NullString
+
+	err := row.Scan(&entry.RecurringEntryId, &created, &modified, &deleted, &entry.IsDeleted, &entry.Version, &entry.MserviceId,
+		&orgId, &entry.TransactionDescription, &entry.TransactionTypeId, &from_party, &to_party, &frequency, &entry.DayOfMonth,
+		&start_date, &end_date, &next_date, &last_error)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Created = dml.DateTimeFromTime(created)
+	entry.Modified = dml.DateTimeFromTime(modified)
+	if entry.IsDeleted {
+		entry.Deleted = dml.DateTimeFromTime(deleted)
+	}
+	entry.OrganizationId, _ = dml.GuidFromBytes(orgId)
+	if from_party.Valid {
+		entry.FromPartyId = from_party.Int64
+	}
+	if to_party.Valid {
+		entry.ToPartyId = to_party.Int64
+	}
+	entry.Frequency = pb.RecurrenceFrequency(frequency)
+	entry.StartDate = dml.DateTimeFromTime(start_date)
+	if end_date.Valid {
+		entry.EndDate = dml.DateTimeFromTime(end_date.Time)
+	}
+	if next_date.Valid {
+		entry.NextDate = dml.DateTimeFromTime(next_date.Time)
+	}
+	if last_error.Valid {
+		entry.LastError = last_error.String
+	}
+
+	return &entry, nil
+}
+
+// Attach template details with their dimension values to recurring journal entries selected by a condition on
+// tb_GLRecurringEntry aliased e.
+func (s *glService) loadRecurringEntryDetails(entryMap map[int64]*pb.GLRecurringEntry, condition string, args ...interface{}) *genericResponse {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT d.inbRecurringEntryId, d.intSequenceNumber, d.uidGlAccountId, d.decAmount, d.bitIsDebit,
+	d.chvCurrencyCode, d.decForeignAmount, d.chvTaxCode
+	FROM tb_GLRecurringEntry AS e
+	JOIN tb_GLRecurringEntryDetail AS d
+	ON e.inbRecurringEntryId = d.inbRecurringEntryId
+	WHERE ` + condition + `
+	ORDER BY d.inbRecurringEntryId, d.intSequenceNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer rows.Close()
+
+	detailMap := make(map[string]*pb.GLTransactionDetail)
+
+	for rows.Next() {
+		var entryId int64
+		var gid []byte
+		var amount sql.NullString
+		var currency sql.NullString
+		var foreign sql.NullString
+		var taxCode sql.NullString
+		var detail pb.GLTransactionDetail
+
+		err := rows.Scan(&entryId, &detail.SequenceNumber, &gid, &amount, &detail.IsDebit, &currency, &foreign, &taxCode)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		entry, ok := entryMap[entryId]
+		if ok {
+			detail.GlAccountId, _ = dml.GuidFromBytes(gid)
+			if amount.Valid {
+				detail.Amount, _ = dml.DecimalFromString(amount.String)
+			}
+			setDetailForeignColumns(&detail, currency, foreign)
+			detail.TaxCode = taxCode.String
+
+			entry.GlTransactionDetails = append(entry.GlTransactionDetails, &detail)
+			detailMap[detailKey(entryId, detail.GetSequenceNumber())] = &detail
+		}
+	}
+
+	sqlstring2 := `SELECT m.inbRecurringEntryId, m.intSequenceNumber, m.chvDimensionName, m.chvDimensionValue
+	FROM tb_GLRecurringEntry AS e
+	JOIN tb_GLRecurringEntryDetailDimension AS m
+	ON e.inbRecurringEntryId = m.inbRecurringEntryId
+	WHERE ` + condition + `
+	ORDER BY m.inbRecurringEntryId, m.intSequenceNumber, m.chvDimensionName`
+
+	return s.loadDetailDimensions(detailMap, sqlstring2, args...)
+}
+
+// Insert the template details of a recurring journal entry as part of a database transaction.
+// Details are numbered in the order given; an amount left out is converted from the foreign amount when generated.
+// Tax lines are left out, they are generated from the tax codes of the details when the entry is posted.
+func insertRecurringEntryDetails(tx *sql.Tx, entryId int64, details []*pb.GLTransactionDetail) error {
+	sqlstring := `INSERT INTO tb_GLRecurringEntryDetail (inbRecurringEntryId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit,
+	chvCurrencyCode, decForeignAmount, chvTaxCode) VALUES(?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sequence := 0
+	for _, detail := range details {
+		if detail.GetIsTaxLine() {
+			continue
+		}
+
+		sequence++
+		var amount sql.NullString
+		if detail.GetAmount().GetPlaintext() != "" {
+			amount.String = detail.GetAmount().GetPlaintext()
+			amount.Valid = true
+		}
+
+		currency, foreign := detailForeignColumns(detail)
+		_, err := tx.Exec(sqlstring, entryId, sequence, detail.GetGlAccountId().GetGuid(), amount, detail.GetIsDebit(), currency, foreign,
+			detailTaxColumn(detail))
+		if err != nil {
+			return err
+		}
+
+		for _, dim := range detail.GetDimensions() {
+			_, err := tx.Exec(`INSERT INTO tb_GLRecurringEntryDetailDimension (inbRecurringEntryId, intSequenceNumber, chvDimensionName,
+			chvDimensionValue) VALUES (?, ?, ?, ?)`, entryId, sequence, dim.GetDimensionName(), dim.GetDimensionValue())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check the schedule and template of a recurring journal entry. The template must make a valid journal entry on
+// the start date, so accounts, exchange rates and dimension values are checked up front.
+func (s *glService) checkRecurringEntry(entry *pb.GLRecurringEntry) *genericResponse {
+	resp := &genericResponse{}
+
+	if len(entry.GetGlTransactionDetails()) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "transaction details required"
+		return resp
+	}
+
+	if recurrenceMonths[entry.GetFrequency()] == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "frequency missing or invalid"
+		return resp
+	}
+
+	if (entry.GetDayOfMonth() < 1) || (entry.GetDayOfMonth() > 31) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "day_of_month must be from 1 to 31"
+		return resp
+	}
+
+	if entry.GetStartDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "start_date missing"
+		return resp
+	}
+
+	startDate := entry.GetStartDate().TimeFromDateTime()
+
+	if (entry.GetEndDate() != nil) && entry.GetEndDate().TimeFromDateTime().Before(startDate) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "end_date before start_date"
+		return resp
+	}
+
+	// convert a copy, the template keeps amounts that are left to conversion empty
+	var details []*pb.GLTransactionDetail
+	for _, detail := range entry.GetGlTransactionDetails() {
+		details = append(details, proto.Clone(detail).(*pb.GLTransactionDetail))
+	}
+
+	mserviceId := entry.GetMserviceId()
+	orgId := entry.GetOrganizationId().GetGuid()
+
+	gResp, _ := s.prepareTransactionDetails(mserviceId, orgId, startDate, details)
+
+	return gResp
+}
+
+// Nullable column values of a recurring journal entry.
+func recurringEntryColumnValues(entry *pb.GLRecurringEntry) (sql.NullInt64, sql.NullInt64, sql.NullTime) {
+	var from_party sql.NullInt64
+	var to_party sql.NullInt64
+	var end_date sql.NullTime
+
+	if entry.GetFromPartyId() != 0 {
+		from_party.Int64 = entry.GetFromPartyId()
+		from_party.Valid = true
+	}
+
+	if entry.GetToPartyId() != 0 {
+		to_party.Int64 = entry.GetToPartyId()
+		to_party.Valid = true
+	}
+
+	if entry.GetEndDate() != nil {
+		end_date.Time = entry.GetEndDate().TimeFromDateTime()
+		end_date.Valid = true
+	}
+
+	return from_party, to_party, end_date
+}
+
+// Date of the first occurrence of a recurring journal entry on or after notBefore, null once past the end date.
+// Occurrences are counted in whole frequency steps from the month of the start date.
+func scheduleNextDate(entry *pb.GLRecurringEntry, notBefore time.Time) sql.NullTime {
+	var result sql.NullTime
+
+	start := entry.GetStartDate().TimeFromDateTime()
+	step := recurrenceMonths[entry.GetFrequency()]
+	notBefore = startOfDay(notBefore)
+
+	date := occurrenceInMonth(start.Year(), start.Month(), entry.GetDayOfMonth())
+	for k := 1; date.Before(startOfDay(start)) || date.Before(notBefore); k++ {
+		date = occurrenceInMonth(start.Year(), start.Month()+time.Month(k*step), entry.GetDayOfMonth())
+	}
+
+	if (entry.GetEndDate() != nil) && date.After(entry.GetEndDate().TimeFromDateTime()) {
+		return result
+	}
+
+	result.Time = date
+	result.Valid = true
+
+	return result
+}
+
+// Dates of up to count occurrences of a recurring journal entry from its next date, scheduled the same way as
+// generated instances so a day clamped to the end of a short month does not carry over to later months.
+func upcomingOccurrences(entry *pb.GLRecurringEntry, count int32) []time.Time {
+	var dates []time.Time
+
+	if entry.GetNextDate() == nil {
+		return dates
+	}
+
+	next := scheduleNextDate(entry, entry.GetNextDate().TimeFromDateTime())
+	for (int32(len(dates)) < count) && next.Valid {
+		dates = append(dates, next.Time)
+		next = scheduleNextDate(entry, next.Time.AddDate(0, 0, 1))
+	}
+
+	return dates
+}
+
+// The given day of a month, or the last day of the month when it is shorter. Months past December roll into
+// the following years.
+func occurrenceInMonth(year int, month time.Month, day int32) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	if int(day) > lastDay {
+		day = int32(lastDay)
+	}
+
+	return first.AddDate(0, 0, int(day)-1)
+}
+
+// Cut a string down to a column size.
+func truncateString(s string, size int) string {
+	if len(s) > size {
+		return s[:size]
+	}
+
+	return s
+}
@@ -0,0 +1,216 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package glservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// Pointer to a date, for dates that may be absent.
+func datePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestScheduleNextDate(t *testing.T) {
+	monthly := pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY
+	quarterly := pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_QUARTERLY
+	annually := pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_ANNUALLY
+
+	tests := []struct {
+		name      string
+		frequency pb.RecurrenceFrequency
+		day       int32
+		start     time.Time
+		end       *time.Time
+		notBefore time.Time
+		want      *time.Time
+	}{
+		{
+			name:      "first occurrence in the start month",
+			frequency: monthly,
+			day:       15,
+			start:     localDate(2021, time.January, 10),
+			notBefore: localDate(2021, time.January, 1),
+			want:      datePtr(localDate(2021, time.January, 15)),
+		},
+		{
+			name:      "day before the start date moves to the next month",
+			frequency: monthly,
+			day:       5,
+			start:     localDate(2021, time.January, 10),
+			notBefore: localDate(2021, time.January, 1),
+			want:      datePtr(localDate(2021, time.February, 5)),
+		},
+		{
+			name:      "occurrence on notBefore",
+			frequency: monthly,
+			day:       15,
+			start:     localDate(2021, time.January, 1),
+			notBefore: localDate(2021, time.March, 15),
+			want:      datePtr(localDate(2021, time.March, 15)),
+		},
+		{
+			name:      "time of day of notBefore is ignored",
+			frequency: monthly,
+			day:       15,
+			start:     localDate(2021, time.January, 1),
+			notBefore: localDate(2021, time.March, 15).Add(18 * time.Hour),
+			want:      datePtr(localDate(2021, time.March, 15)),
+		},
+		{
+			name:      "last day of a shorter month",
+			frequency: monthly,
+			day:       31,
+			start:     localDate(2021, time.January, 1),
+			notBefore: localDate(2021, time.February, 1),
+			want:      datePtr(localDate(2021, time.February, 28)),
+		},
+		{
+			name:      "last day of february in a leap year",
+			frequency: monthly,
+			day:       30,
+			start:     localDate(2024, time.January, 1),
+			notBefore: localDate(2024, time.February, 1),
+			want:      datePtr(localDate(2024, time.February, 29)),
+		},
+		{
+			name:      "quarterly steps from the start month",
+			frequency: quarterly,
+			day:       1,
+			start:     localDate(2021, time.February, 1),
+			notBefore: localDate(2021, time.June, 1),
+			want:      datePtr(localDate(2021, time.August, 1)),
+		},
+		{
+			name:      "annually into the next year",
+			frequency: annually,
+			day:       31,
+			start:     localDate(2021, time.December, 1),
+			notBefore: localDate(2022, time.January, 1),
+			want:      datePtr(localDate(2022, time.December, 31)),
+		},
+		{
+			name:      "occurrence on the end date",
+			frequency: monthly,
+			day:       15,
+			start:     localDate(2021, time.January, 1),
+			end:       datePtr(localDate(2021, time.June, 15)),
+			notBefore: localDate(2021, time.June, 1),
+			want:      datePtr(localDate(2021, time.June, 15)),
+		},
+		{
+			name:      "past the end date",
+			frequency: monthly,
+			day:       15,
+			start:     localDate(2021, time.January, 1),
+			end:       datePtr(localDate(2021, time.June, 14)),
+			notBefore: localDate(2021, time.June, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &pb.GLRecurringEntry{
+				Frequency:  tt.frequency,
+				DayOfMonth: tt.day,
+				StartDate:  dml.DateTimeFromTime(tt.start),
+			}
+
+			if tt.end != nil {
+				entry.EndDate = dml.DateTimeFromTime(*tt.end)
+			}
+
+			got := scheduleNextDate(entry, tt.notBefore)
+			if tt.want == nil {
+				if got.Valid {
+					t.Errorf("got %v, want none", got.Time)
+				}
+				return
+			}
+
+			if !got.Valid || !got.Time.Equal(*tt.want) {
+				t.Errorf("got %v (valid %t), want %v", got.Time, got.Valid, *tt.want)
+			}
+		})
+	}
+}
+
+func TestUpcomingOccurrences(t *testing.T) {
+	tests := []struct {
+		name  string
+		day   int32
+		start time.Time
+		next  *time.Time
+		end   *time.Time
+		count int32
+		want  []time.Time
+	}{
+		{
+			name:  "month end day after a clamped february",
+			day:   31,
+			start: localDate(2021, time.January, 1),
+			next:  datePtr(localDate(2021, time.February, 28)),
+			count: 3,
+			want:  []time.Time{localDate(2021, time.February, 28), localDate(2021, time.March, 31), localDate(2021, time.April, 30)},
+		},
+		{
+			name:  "stops at the end date",
+			day:   15,
+			start: localDate(2021, time.January, 1),
+			next:  datePtr(localDate(2021, time.May, 15)),
+			end:   datePtr(localDate(2021, time.June, 30)),
+			count: 12,
+			want:  []time.Time{localDate(2021, time.May, 15), localDate(2021, time.June, 15)},
+		},
+		{
+			name:  "no next date",
+			day:   15,
+			start: localDate(2021, time.January, 1),
+			count: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &pb.GLRecurringEntry{
+				Frequency:  pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY,
+				DayOfMonth: tt.day,
+				StartDate:  dml.DateTimeFromTime(tt.start),
+			}
+
+			if tt.next != nil {
+				entry.NextDate = dml.DateTimeFromTime(*tt.next)
+			}
+
+			if tt.end != nil {
+				entry.EndDate = dml.DateTimeFromTime(*tt.end)
+			}
+
+			got := upcomingOccurrences(entry, tt.count)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d dates %v, want %v", len(got), got, tt.want)
+			}
+
+			for i, date := range got {
+				if !date.Equal(tt.want[i]) {
+					t.Errorf("date %d is %v, want %v", i, date, tt.want[i])
+				}
+			}
+		})
+	}
+}
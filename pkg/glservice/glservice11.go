@@ -0,0 +1,549 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// create general ledger intercompany due to and due from accounts for partner organization
+func (s *glService) CreateIntercompanyAccount(ctx context.Context, req *pb.CreateIntercompanyAccountRequest) (*pb.CreateIntercompanyAccountResponse, error) {
+	resp := &pb.CreateIntercompanyAccountResponse{}
+
+	gResp := s.checkIntercompanyAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetPartnerOrganizationId().GetGuid(),
+		req.GetDueFromAccountId().GetGuid(), req.GetDueToAccountId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLIntercompanyAccount (uidOrganizationId, uidPartnerOrganizationId, dtmCreated, dtmModified, dtmDeleted,
+	bitIsDeleted, intVersion, inbMserviceId, uidDueFromAccountId, uidDueToAccountId) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetPartnerOrganizationId().GetGuid(), req.GetMserviceId(),
+		req.GetDueFromAccountId().GetGuid(), req.GetDueToAccountId().GetGuid())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger intercompany due to and due from accounts
+func (s *glService) UpdateIntercompanyAccount(ctx context.Context, req *pb.UpdateIntercompanyAccountRequest) (*pb.UpdateIntercompanyAccountResponse, error) {
+	resp := &pb.UpdateIntercompanyAccountResponse{}
+
+	gResp := s.checkIntercompanyAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetPartnerOrganizationId().GetGuid(),
+		req.GetDueFromAccountId().GetGuid(), req.GetDueToAccountId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLIntercompanyAccount SET dtmModified = NOW(), intVersion = ?, uidDueFromAccountId = ?, uidDueToAccountId = ?
+	WHERE uidOrganizationId = ? AND uidPartnerOrganizationId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDueFromAccountId().GetGuid(), req.GetDueToAccountId().GetGuid(),
+		req.GetOrganizationId().GetGuid(), req.GetPartnerOrganizationId().GetGuid(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger intercompany due to and due from accounts
+func (s *glService) DeleteIntercompanyAccount(ctx context.Context, req *pb.DeleteIntercompanyAccountRequest) (*pb.DeleteIntercompanyAccountResponse, error) {
+	resp := &pb.DeleteIntercompanyAccountResponse{}
+
+	sqlstring := `UPDATE tb_GLIntercompanyAccount SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND uidPartnerOrganizationId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetPartnerOrganizationId().GetGuid(), req.GetVersion(),
+		req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger intercompany accounts by organization
+func (s *glService) GetIntercompanyAccountsByOrganization(ctx context.Context, req *pb.GetIntercompanyAccountsByOrganizationRequest) (*pb.GetIntercompanyAccountsByOrganizationResponse, error) {
+	resp := &pb.GetIntercompanyAccountsByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, uidPartnerOrganizationId, dtmCreated, dtmModified, intVersion, inbMserviceId,
+	uidDueFromAccountId, uidDueToAccountId
+	FROM tb_GLIntercompanyAccount WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var icAccount pb.GLIntercompanyAccount
+		var orgId []byte
+		var partnerId []byte
+		var created time.Time
+		var modified time.Time
+		var dueFrom []byte
+		var dueTo []byte
+
+		err := rows.Scan(&orgId, &partnerId, &created, &modified, &icAccount.Version, &icAccount.MserviceId, &dueFrom, &dueTo)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		icAccount.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		icAccount.PartnerOrganizationId, _ = dml.GuidFromBytes(partnerId)
+		icAccount.Created = dml.DateTimeFromTime(created)
+		icAccount.Modified = dml.DateTimeFromTime(modified)
+		icAccount.DueFromAccountId, _ = dml.GuidFromBytes(dueFrom)
+		icAccount.DueToAccountId, _ = dml.GuidFromBytes(dueTo)
+
+		resp.GlIntercompanyAccounts = append(resp.GlIntercompanyAccounts, &icAccount)
+	}
+
+	return resp, nil
+}
+
+// post general ledger transactions for one business event in two organizations
+func (s *glService) PostIntercompanyEntry(ctx context.Context, req *pb.PostIntercompanyEntryRequest) (*pb.PostIntercompanyEntryResponse, error) {
+	resp := &pb.PostIntercompanyEntryResponse{}
+
+	orgId := req.GetOrganizationId().GetGuid()
+	partnerId := req.GetPartnerOrganizationId().GetGuid()
+	tranDate := req.GetTransactionDate().TimeFromDateTime()
+
+	if bytes.Equal(orgId, partnerId) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "partner_organization_id must differ from organization_id"
+		return resp, nil
+	}
+
+	if (len(req.GetGlTransactionDetails()) == 0) || (len(req.GetPartnerTransactionDetails()) == 0) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "transaction details required for both organizations"
+		return resp, nil
+	}
+
+	// amounts are compared across the two ledgers, so both must keep their books in the same currency
+	gResp, baseCurrency := s.getBaseCurrency(req.GetMserviceId(), orgId)
+	if gResp.ErrorCode == 0 {
+		var partnerCurrency string
+		gResp, partnerCurrency = s.getBaseCurrency(req.GetMserviceId(), partnerId)
+		if (gResp.ErrorCode == 0) && (partnerCurrency != baseCurrency) {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = "organizations have different base currencies"
+		}
+	}
+
+	var icAccount *pb.GLIntercompanyAccount
+	var partnerAccount *pb.GLIntercompanyAccount
+
+	if gResp.ErrorCode == 0 {
+		gResp, icAccount = s.getIntercompanyAccount(req.GetMserviceId(), orgId, partnerId)
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, partnerAccount = s.getIntercompanyAccount(req.GetMserviceId(), partnerId, orgId)
+	}
+
+	// make sure the transaction date is in an open fiscal period of both organizations
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, tranDate)
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), partnerId, tranDate)
+	}
+
+	// the intercompany lines balancing each side go in between completing the details and checking them
+	if gResp.ErrorCode == 0 {
+		gResp, req.GlTransactionDetails = s.completeTransactionDetails(req.GetMserviceId(), orgId, tranDate, req.GetGlTransactionDetails())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, req.PartnerTransactionDetails = s.completeTransactionDetails(req.GetMserviceId(), partnerId, tranDate,
+			req.GetPartnerTransactionDetails())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// whatever one organization is out of balance by, the other must be out of balance by the opposite amount
+	net := detailsNetDebit(req.GetGlTransactionDetails())
+	partnerNet := detailsNetDebit(req.GetPartnerTransactionDetails())
+	if !net.Add(partnerNet).IsZero() {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "intercompany entry does not balance across the two organizations"
+		return resp, nil
+	}
+
+	details := append(req.GetGlTransactionDetails(), newIntercompanyDetail(icAccount, net)...)
+	partnerDetails := append(req.GetPartnerTransactionDetails(), newIntercompanyDetail(partnerAccount, partnerNet)...)
+
+	gResp = s.checkTransactionDetails(req.GetMserviceId(), orgId, details)
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkTransactionDetails(req.GetMserviceId(), partnerId, partnerDetails)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	entry := pb.PostJournalEntryRequest{
+		MserviceId:             req.GetMserviceId(),
+		OrganizationId:         req.GetOrganizationId(),
+		TransactionDate:        req.GetTransactionDate(),
+		TransactionDescription: req.GetTransactionDescription(),
+		TransactionTypeId:      req.GetTransactionTypeId(),
+		GlTransactionDetails:   details,
+	}
+
+	partnerEntry := pb.PostJournalEntryRequest{
+		MserviceId:             req.GetMserviceId(),
+		OrganizationId:         req.GetPartnerOrganizationId(),
+		TransactionDate:        req.GetTransactionDate(),
+		TransactionDescription: req.GetTransactionDescription(),
+		TransactionTypeId:      req.GetTransactionTypeId(),
+		GlTransactionDetails:   partnerDetails,
+	}
+
+	gResp, transactionId, partnerTransactionId := s.commitIntercompanyEntry(&entry, &partnerEntry)
+	if gResp.ErrorCode == 0 {
+		gResp, resp.GlTransactionWrapper = s.GetTransactionWrapperHelper(transactionId, req.GetMserviceId())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, resp.PartnerTransactionWrapper = s.GetTransactionWrapperHelper(partnerTransactionId, req.GetMserviceId())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// Post the two transactions of an intercompany entry and link them to each other, in a single database transaction.
+func (s *glService) commitIntercompanyEntry(entry *pb.PostJournalEntryRequest, partnerEntry *pb.PostJournalEntryRequest) (*genericResponse, int64, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	var partnerTransactionId int64
+
+	transactionId, err := insertJournalEntry(tx, entry)
+	if err == nil {
+		partnerTransactionId, err = insertJournalEntry(tx, partnerEntry)
+	}
+
+	sqlstring := `UPDATE tb_GLTransaction SET inbIntercompanyTransactionId = ? WHERE inbGlTransactionId = ?`
+
+	if err == nil {
+		_, err = tx.Exec(sqlstring, partnerTransactionId, transactionId)
+	}
+
+	if err == nil {
+		_, err = tx.Exec(sqlstring, transactionId, partnerTransactionId)
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0, 0
+	}
+
+	return resp, transactionId, partnerTransactionId
+}
+
+// Insert the reversing transactions of both sides of an intercompany entry and link them to the originals, in a single
+// database transaction, returning the id of the reversing transaction of the first. The partner transaction is
+// reversed at the version it was read at.
+func (s *glService) commitIntercompanyReversal(tran *pb.GLTransaction, version int32, partner *pb.GLTransaction, tranDate time.Time,
+	description string, partnerDescription string, details []*pb.GLTransactionDetail,
+	partnerDetails []*pb.GLTransactionDetail) (*genericResponse, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	reversingId, err := insertReversal(tx, tran, version, tranDate, description, details)
+	if err == nil {
+		_, err = insertReversal(tx, partner, partner.GetVersion(), tranDate, partnerDescription, partnerDetails)
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err), 0
+	}
+
+	return resp, reversingId
+}
+
+// Prefix the error of a check on the partner side of an intercompany entry with the partner transaction id.
+func partnerResponse(resp *genericResponse, partnerTransactionId int64) *genericResponse {
+	if resp.ErrorCode != 0 {
+		resp.ErrorMessage = fmt.Sprintf("intercompany transaction %d: %s", partnerTransactionId, resp.ErrorMessage)
+	}
+
+	return resp
+}
+
+// Get the intercompany accounts an organization uses with a partner organization.
+func (s *glService) getIntercompanyAccount(mserviceId int64, organizationId []byte, partnerId []byte) (*genericResponse, *pb.GLIntercompanyAccount) {
+	resp := &genericResponse{}
+
+	var dueFrom []byte
+	var dueTo []byte
+
+	err := s.db.QueryRow(`SELECT uidDueFromAccountId, uidDueToAccountId FROM tb_GLIntercompanyAccount
+	WHERE uidOrganizationId = ? AND uidPartnerOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		organizationId, partnerId, mserviceId).Scan(&dueFrom, &dueTo)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = fmt.Sprintf("intercompany accounts of organization %x for partner %x not found", organizationId, partnerId)
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	icAccount := pb.GLIntercompanyAccount{}
+	icAccount.OrganizationId, _ = dml.GuidFromBytes(organizationId)
+	icAccount.PartnerOrganizationId, _ = dml.GuidFromBytes(partnerId)
+	icAccount.DueFromAccountId, _ = dml.GuidFromBytes(dueFrom)
+	icAccount.DueToAccountId, _ = dml.GuidFromBytes(dueTo)
+
+	return resp, &icAccount
+}
+
+// Check the intercompany accounts of an organization for a partner: the partner must be another organization of the
+// MService account, and both accounts must belong to the organization.
+func (s *glService) checkIntercompanyAccount(mserviceId int64, organizationId []byte, partnerId []byte, dueFromId []byte,
+	dueToId []byte) *genericResponse {
+	resp := &genericResponse{}
+
+	if bytes.Equal(organizationId, partnerId) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "partner_organization_id must differ from organization_id"
+		return resp
+	}
+
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLOrganization WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		partnerId, mserviceId).Scan(&count)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if count == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "partner organization not found"
+		return resp
+	}
+
+	for _, accountId := range [][]byte{dueFromId, dueToId} {
+		err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLAccount WHERE uidGlAccountId = ? AND uidOrganizationId = ? AND inbMserviceId = ?
+		AND bitIsDeleted = 0`, accountId, organizationId, mserviceId).Scan(&count)
+		if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		if count == 0 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("account %x not found in organization", accountId)
+			return resp
+		}
+	}
+
+	return resp
+}
+
+// Debits less credits of transaction details.
+func detailsNetDebit(details []*pb.GLTransactionDetail) sdec.Decimal {
+	net := sdec.Zero
+
+	for _, detail := range details {
+		amt, _ := sdec.NewFromString(detail.GetAmount().GetPlaintext())
+		if detail.GetIsDebit() {
+			net = net.Add(amt)
+		} else {
+			net = net.Sub(amt)
+		}
+	}
+
+	return net
+}
+
+// Detail balancing the net debit of one side of an intercompany entry: a credit to the due to account for a net
+// debit, a debit to the due from account for a net credit, none when the side already balances.
+func newIntercompanyDetail(icAccount *pb.GLIntercompanyAccount, net sdec.Decimal) []*pb.GLTransactionDetail {
+	if net.IsZero() {
+		return nil
+	}
+
+	detail := pb.GLTransactionDetail{}
+	if net.IsPositive() {
+		detail.GlAccountId = icAccount.GetDueToAccountId()
+		detail.Amount = decimalFromAmount(net)
+	} else {
+		detail.GlAccountId = icAccount.GetDueFromAccountId()
+		detail.Amount = decimalFromAmount(net.Neg())
+		detail.IsDebit = true
+	}
+
+	return []*pb.GLTransactionDetail{&detail}
+}
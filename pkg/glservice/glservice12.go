@@ -0,0 +1,1297 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// Select the organizations of a group; takes the MService account id, then the group name.
+const groupMemberClause = `(SELECT g.uidOrganizationId FROM tb_GLOrganizationGroupMember AS g
+	WHERE g.inbMserviceId = ? AND g.chvGroupName = ? AND g.bitIsDeleted = 0)`
+
+// create general ledger organization group for consolidation
+func (s *glService) CreateOrganizationGroup(ctx context.Context, req *pb.CreateOrganizationGroupRequest) (*pb.CreateOrganizationGroupResponse, error) {
+	resp := &pb.CreateOrganizationGroupResponse{}
+
+	if !nameValidator.MatchString(req.GetGroupName()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "group_name invalid format"
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLOrganizationGroup (inbMserviceId, chvGroupName, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+	intVersion, chvDescription) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetGroupName(), req.GetDescription())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger organization group
+func (s *glService) UpdateOrganizationGroup(ctx context.Context, req *pb.UpdateOrganizationGroupRequest) (*pb.UpdateOrganizationGroupResponse, error) {
+	resp := &pb.UpdateOrganizationGroupResponse{}
+
+	sqlstring := `UPDATE tb_GLOrganizationGroup SET dtmModified = NOW(), intVersion = ?, chvDescription = ?
+	WHERE inbMserviceId = ? AND chvGroupName = ? AND intVersion = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), req.GetMserviceId(), req.GetGroupName(), req.GetVersion())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger organization group with its members, account mappings and elimination rules
+func (s *glService) DeleteOrganizationGroup(ctx context.Context, req *pb.DeleteOrganizationGroupRequest) (*pb.DeleteOrganizationGroupResponse, error) {
+	resp := &pb.DeleteOrganizationGroupResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	res, err := tx.Exec(`UPDATE tb_GLOrganizationGroup SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE inbMserviceId = ? AND chvGroupName = ? AND intVersion = ? AND bitIsDeleted = 0`,
+		req.GetVersion()+1, req.GetMserviceId(), req.GetGroupName(), req.GetVersion())
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	for _, table := range []string{"tb_GLOrganizationGroupMember", "tb_GLGroupAccountMap", "tb_GLEliminationRule"} {
+		_, err = tx.Exec(`UPDATE `+table+` SET dtmDeleted = NOW(), intVersion = intVersion + 1, bitIsDeleted = 1
+		WHERE inbMserviceId = ? AND chvGroupName = ? AND bitIsDeleted = 0`, req.GetMserviceId(), req.GetGroupName())
+		if err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+
+	return resp, nil
+}
+
+// get general ledger organization groups
+func (s *glService) GetOrganizationGroups(ctx context.Context, req *pb.GetOrganizationGroupsRequest) (*pb.GetOrganizationGroupsResponse, error) {
+	resp := &pb.GetOrganizationGroupsResponse{}
+
+	sqlstring := `SELECT inbMserviceId, chvGroupName, dtmCreated, dtmModified, intVersion, chvDescription
+	FROM tb_GLOrganizationGroup WHERE inbMserviceId = ? AND bitIsDeleted = 0 ORDER BY chvGroupName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var group pb.GLOrganizationGroup
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&group.MserviceId, &group.GroupName, &created, &modified, &group.Version, &group.Description)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		group.Created = dml.DateTimeFromTime(created)
+		group.Modified = dml.DateTimeFromTime(modified)
+
+		resp.GlOrganizationGroups = append(resp.GlOrganizationGroups, &group)
+	}
+
+	return resp, nil
+}
+
+// add organization to general ledger organization group
+func (s *glService) CreateOrganizationGroupMember(ctx context.Context, req *pb.CreateOrganizationGroupMemberRequest) (*pb.CreateOrganizationGroupMemberResponse, error) {
+	resp := &pb.CreateOrganizationGroupMemberResponse{}
+
+	gResp, _ := s.GetOrganizationGroupHelper(req.GetMserviceId(), req.GetGroupName())
+	if gResp.ErrorCode == 0 {
+		// the base currency is only needed to check that the organization exists
+		gResp, _ = s.getBaseCurrency(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLOrganizationGroupMember (inbMserviceId, chvGroupName, uidOrganizationId, dtmCreated, dtmModified,
+	dtmDeleted, bitIsDeleted, intVersion) VALUES (?, ?, ?, NOW(), NOW(), NOW(), 0, 1)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetGroupName(), req.GetOrganizationId().GetGuid())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// remove organization from general ledger organization group
+func (s *glService) DeleteOrganizationGroupMember(ctx context.Context, req *pb.DeleteOrganizationGroupMemberRequest) (*pb.DeleteOrganizationGroupMemberResponse, error) {
+	resp := &pb.DeleteOrganizationGroupMemberResponse{}
+
+	sqlstring := `UPDATE tb_GLOrganizationGroupMember SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE inbMserviceId = ? AND chvGroupName = ? AND uidOrganizationId = ? AND intVersion = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), req.GetGroupName(), req.GetOrganizationId().GetGuid(), req.GetVersion())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get members of general ledger organization group
+func (s *glService) GetOrganizationGroupMembers(ctx context.Context, req *pb.GetOrganizationGroupMembersRequest) (*pb.GetOrganizationGroupMembersResponse, error) {
+	resp := &pb.GetOrganizationGroupMembersResponse{}
+
+	gResp, members := s.getOrganizationGroupMembers(req.GetMserviceId(), req.GetGroupName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlOrganizationGroupMembers = members
+
+	return resp, nil
+}
+
+// map general ledger account onto the group chart of accounts
+func (s *glService) CreateGroupAccountMap(ctx context.Context, req *pb.CreateGroupAccountMapRequest) (*pb.CreateGroupAccountMapResponse, error) {
+	resp := &pb.CreateGroupAccountMapResponse{}
+
+	gResp := checkGroupAccount(req.GetGroupAccountCode(), req.GetGroupAccountName())
+
+	var orgId []byte
+	if gResp.ErrorCode == 0 {
+		gResp, orgId = s.getMemberAccountOrganization(req.GetMserviceId(), req.GetGroupName(), req.GetGlAccountId().GetGuid())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLGroupAccountMap (inbMserviceId, chvGroupName, uidGlAccountId, dtmCreated, dtmModified, dtmDeleted,
+	bitIsDeleted, intVersion, uidOrganizationId, chvGroupAccountCode, chvGroupAccountName) VALUES (?, ?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetGroupName(), req.GetGlAccountId().GetGuid(), orgId, req.GetGroupAccountCode(),
+		req.GetGroupAccountName())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger account mapping onto the group chart of accounts
+func (s *glService) UpdateGroupAccountMap(ctx context.Context, req *pb.UpdateGroupAccountMapRequest) (*pb.UpdateGroupAccountMapResponse, error) {
+	resp := &pb.UpdateGroupAccountMapResponse{}
+
+	gResp := checkGroupAccount(req.GetGroupAccountCode(), req.GetGroupAccountName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLGroupAccountMap SET dtmModified = NOW(), intVersion = ?, chvGroupAccountCode = ?, chvGroupAccountName = ?
+	WHERE inbMserviceId = ? AND chvGroupName = ? AND uidGlAccountId = ? AND intVersion = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetGroupAccountCode(), req.GetGroupAccountName(), req.GetMserviceId(), req.GetGroupName(),
+		req.GetGlAccountId().GetGuid(), req.GetVersion())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger account mapping onto the group chart of accounts
+func (s *glService) DeleteGroupAccountMap(ctx context.Context, req *pb.DeleteGroupAccountMapRequest) (*pb.DeleteGroupAccountMapResponse, error) {
+	resp := &pb.DeleteGroupAccountMapResponse{}
+
+	sqlstring := `UPDATE tb_GLGroupAccountMap SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE inbMserviceId = ? AND chvGroupName = ? AND uidGlAccountId = ? AND intVersion = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetMserviceId(), req.GetGroupName(), req.GetGlAccountId().GetGuid(), req.GetVersion())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger account mappings of organization group
+func (s *glService) GetGroupAccountMaps(ctx context.Context, req *pb.GetGroupAccountMapsRequest) (*pb.GetGroupAccountMapsResponse, error) {
+	resp := &pb.GetGroupAccountMapsResponse{}
+
+	gResp, accountMaps := s.getGroupAccountMaps(req.GetMserviceId(), req.GetGroupName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlGroupAccountMaps = accountMaps
+
+	return resp, nil
+}
+
+// create general ledger consolidation elimination rule for a pair of intercompany accounts
+func (s *glService) CreateEliminationRule(ctx context.Context, req *pb.CreateEliminationRuleRequest) (*pb.CreateEliminationRuleResponse, error) {
+	resp := &pb.CreateEliminationRuleResponse{}
+
+	gResp, orgId, partnerId := s.checkEliminationRule(req.GetMserviceId(), req.GetGroupName(), req.GetGlAccountId().GetGuid(),
+		req.GetPartnerAccountId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLEliminationRule (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	chvGroupName, chvDescription, uidOrganizationId, uidGlAccountId, uidPartnerOrganizationId, uidPartnerAccountId)
+	VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetGroupName(), req.GetDescription(), orgId, req.GetGlAccountId().GetGuid(), partnerId,
+		req.GetPartnerAccountId().GetGuid())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			ruleId, err := res.LastInsertId()
+			if err != nil {
+				level.Error(s.logger).Log("what", "LastInsertId", "error", err)
+			} else {
+				resp.EliminationRuleId = ruleId
+				resp.Version = 1
+			}
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger consolidation elimination rule
+func (s *glService) UpdateEliminationRule(ctx context.Context, req *pb.UpdateEliminationRuleRequest) (*pb.UpdateEliminationRuleResponse, error) {
+	resp := &pb.UpdateEliminationRuleResponse{}
+
+	var groupName string
+	err := s.db.QueryRow(`SELECT chvGroupName FROM tb_GLEliminationRule WHERE inbEliminationRuleId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`,
+		req.GetEliminationRuleId(), req.GetMserviceId()).Scan(&groupName)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	gResp, orgId, partnerId := s.checkEliminationRule(req.GetMserviceId(), groupName, req.GetGlAccountId().GetGuid(),
+		req.GetPartnerAccountId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLEliminationRule SET dtmModified = NOW(), intVersion = ?, chvDescription = ?, uidOrganizationId = ?,
+	uidGlAccountId = ?, uidPartnerOrganizationId = ?, uidPartnerAccountId = ?
+	WHERE inbEliminationRuleId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), orgId, req.GetGlAccountId().GetGuid(), partnerId,
+		req.GetPartnerAccountId().GetGuid(), req.GetEliminationRuleId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger consolidation elimination rule
+func (s *glService) DeleteEliminationRule(ctx context.Context, req *pb.DeleteEliminationRuleRequest) (*pb.DeleteEliminationRuleResponse, error) {
+	resp := &pb.DeleteEliminationRuleResponse{}
+
+	sqlstring := `UPDATE tb_GLEliminationRule SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE inbEliminationRuleId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetEliminationRuleId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger consolidation elimination rules of organization group
+func (s *glService) GetEliminationRules(ctx context.Context, req *pb.GetEliminationRulesRequest) (*pb.GetEliminationRulesResponse, error) {
+	resp := &pb.GetEliminationRulesResponse{}
+
+	gResp, rules := s.getEliminationRules(req.GetMserviceId(), req.GetGroupName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlEliminationRules = rules
+
+	return resp, nil
+}
+
+// get general ledger trial balance consolidated over organization group as of date
+func (s *glService) GetConsolidatedTrialBalance(ctx context.Context, req *pb.GetConsolidatedTrialBalanceRequest) (*pb.GetConsolidatedTrialBalanceResponse, error) {
+	resp := &pb.GetConsolidatedTrialBalanceResponse{}
+
+	filter := balanceFilter{
+		mserviceId: req.GetMserviceId(),
+		endDate:    req.GetAsOfDate().TimeFromDateTime(),
+		status:     reportStatus(req.GetTransactionStatus()),
+	}
+
+	gResp, balances, eliminations := s.getConsolidatedBalances(&filter, req.GetGroupName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	report := newTrialBalanceReport(balances)
+
+	resp.GlAccountTypeBalances = report.typeBalances
+	resp.TotalDebits = decimalFromAmount(report.totalDebits)
+	resp.TotalCredits = decimalFromAmount(report.totalCredits)
+	resp.IsBalanced = report.totalDebits.Equal(report.totalCredits)
+	resp.GlConsolidationEliminations = eliminations
+
+	return resp, nil
+}
+
+// get general ledger balance sheet consolidated over organization group as of date
+func (s *glService) GetConsolidatedBalanceSheet(ctx context.Context, req *pb.GetConsolidatedBalanceSheetRequest) (*pb.GetConsolidatedBalanceSheetResponse, error) {
+	resp := &pb.GetConsolidatedBalanceSheetResponse{}
+
+	filter := balanceFilter{
+		mserviceId: req.GetMserviceId(),
+		endDate:    req.GetAsOfDate().TimeFromDateTime(),
+		status:     reportStatus(req.GetTransactionStatus()),
+	}
+
+	gResp, balances, eliminations := s.getConsolidatedBalances(&filter, req.GetGroupName())
+	if gResp.ErrorCode == 0 {
+		gResp = checkReportCategories(balances)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	report := newBalanceSheetReport(balances)
+
+	resp.AssetLines = report.assetLines
+	resp.LiabilityLines = report.liabilityLines
+	resp.EquityLines = report.equityLines
+	resp.TotalAssets = decimalFromAmount(report.totalAssets)
+	resp.TotalLiabilities = decimalFromAmount(report.totalLiabilities)
+	resp.CurrentNetIncome = decimalFromAmount(report.netIncome)
+	resp.TotalEquity = decimalFromAmount(report.totalEquity)
+	resp.TotalLiabilitiesAndEquity = decimalFromAmount(report.totalLiabilities.Add(report.totalEquity))
+	resp.IsBalanced = report.totalAssets.Equal(report.totalLiabilities.Add(report.totalEquity))
+	resp.GlConsolidationEliminations = eliminations
+
+	return resp, nil
+}
+
+// get general ledger income statement consolidated over organization group between dates
+func (s *glService) GetConsolidatedIncomeStatement(ctx context.Context, req *pb.GetConsolidatedIncomeStatementRequest) (*pb.GetConsolidatedIncomeStatementResponse, error) {
+	resp := &pb.GetConsolidatedIncomeStatementResponse{}
+
+	filter := balanceFilter{
+		mserviceId:     req.GetMserviceId(),
+		endDate:        req.GetEndDate().TimeFromDateTime(),
+		excludeClosing: true,
+		status:         reportStatus(req.GetTransactionStatus()),
+	}
+
+	filter.startDate.Time = req.GetStartDate().TimeFromDateTime()
+	filter.startDate.Valid = true
+
+	gResp, balances, eliminations := s.getConsolidatedBalances(&filter, req.GetGroupName())
+	if gResp.ErrorCode == 0 {
+		gResp = checkReportCategories(balances)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	report := newIncomeStatementReport(balances)
+
+	resp.RevenueLines = report.revenueLines
+	resp.ExpenseLines = report.expenseLines
+	resp.TotalRevenue = decimalFromAmount(report.totalRevenue)
+	resp.TotalExpenses = decimalFromAmount(report.totalExpenses)
+	resp.NetIncome = decimalFromAmount(report.totalRevenue.Sub(report.totalExpenses))
+	resp.GlConsolidationEliminations = eliminations
+
+	return resp, nil
+}
+
+// Get a general ledger organization group by name.
+func (s *glService) GetOrganizationGroupHelper(mserviceId int64, groupName string) (*genericResponse, *pb.GLOrganizationGroup) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT inbMserviceId, chvGroupName, dtmCreated, dtmModified, intVersion, chvDescription
+	FROM tb_GLOrganizationGroup WHERE inbMserviceId = ? AND chvGroupName = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var group pb.GLOrganizationGroup
+	var created time.Time
+	var modified time.Time
+
+	err = stmt.QueryRow(mserviceId, groupName).Scan(&group.MserviceId, &group.GroupName, &created, &modified, &group.Version,
+		&group.Description)
+
+	if err == nil {
+		group.Created = dml.DateTimeFromTime(created)
+		group.Modified = dml.DateTimeFromTime(modified)
+	} else if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "organization group not found"
+	} else {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, &group
+}
+
+// Get the organizations of a group, ordered by name. Deleted organizations are left out.
+func (s *glService) getOrganizationGroupMembers(mserviceId int64, groupName string) (*genericResponse, []*pb.GLOrganizationGroupMember) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT m.inbMserviceId, m.chvGroupName, m.uidOrganizationId, m.dtmCreated, m.dtmModified, m.intVersion, o.chvOrganizationName
+	FROM tb_GLOrganizationGroupMember AS m
+	JOIN tb_GLOrganization AS o ON m.uidOrganizationId = o.uidOrganizationId
+	WHERE m.inbMserviceId = ? AND m.chvGroupName = ? AND m.bitIsDeleted = 0 AND o.bitIsDeleted = 0
+	ORDER BY o.chvOrganizationName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, groupName)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var members []*pb.GLOrganizationGroupMember
+
+	for rows.Next() {
+		var member pb.GLOrganizationGroupMember
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&member.MserviceId, &member.GroupName, &orgId, &created, &modified, &member.Version, &member.OrganizationName)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		member.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		member.Created = dml.DateTimeFromTime(created)
+		member.Modified = dml.DateTimeFromTime(modified)
+
+		members = append(members, &member)
+	}
+
+	return resp, members
+}
+
+// Get the group chart of accounts mappings of a group.
+func (s *glService) getGroupAccountMaps(mserviceId int64, groupName string) (*genericResponse, []*pb.GLGroupAccountMap) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT inbMserviceId, chvGroupName, uidGlAccountId, dtmCreated, dtmModified, intVersion, uidOrganizationId,
+	chvGroupAccountCode, chvGroupAccountName
+	FROM tb_GLGroupAccountMap WHERE inbMserviceId = ? AND chvGroupName = ? AND bitIsDeleted = 0
+	ORDER BY chvGroupAccountCode`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, groupName)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var accountMaps []*pb.GLGroupAccountMap
+
+	for rows.Next() {
+		var accountMap pb.GLGroupAccountMap
+		var accountId []byte
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&accountMap.MserviceId, &accountMap.GroupName, &accountId, &created, &modified, &accountMap.Version, &orgId,
+			&accountMap.GroupAccountCode, &accountMap.GroupAccountName)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		accountMap.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		accountMap.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		accountMap.Created = dml.DateTimeFromTime(created)
+		accountMap.Modified = dml.DateTimeFromTime(modified)
+
+		accountMaps = append(accountMaps, &accountMap)
+	}
+
+	return resp, accountMaps
+}
+
+// Get the elimination rules of a group.
+func (s *glService) getEliminationRules(mserviceId int64, groupName string) (*genericResponse, []*pb.GLEliminationRule) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT inbEliminationRuleId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvGroupName, chvDescription,
+	uidOrganizationId, uidGlAccountId, uidPartnerOrganizationId, uidPartnerAccountId
+	FROM tb_GLEliminationRule WHERE inbMserviceId = ? AND chvGroupName = ? AND bitIsDeleted = 0
+	ORDER BY inbEliminationRuleId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, groupName)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var rules []*pb.GLEliminationRule
+
+	for rows.Next() {
+		var rule pb.GLEliminationRule
+		var created time.Time
+		var modified time.Time
+		var orgId []byte
+		var accountId []byte
+		var partnerId []byte
+		var partnerAccountId []byte
+
+		err := rows.Scan(&rule.EliminationRuleId, &created, &modified, &rule.Version, &rule.MserviceId, &rule.GroupName, &rule.Description,
+			&orgId, &accountId, &partnerId, &partnerAccountId)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		rule.Created = dml.DateTimeFromTime(created)
+		rule.Modified = dml.DateTimeFromTime(modified)
+		rule.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		rule.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		rule.PartnerOrganizationId, _ = dml.GuidFromBytes(partnerId)
+		rule.PartnerAccountId, _ = dml.GuidFromBytes(partnerAccountId)
+
+		rules = append(rules, &rule)
+	}
+
+	return resp, rules
+}
+
+// Get the organization of an account, which must belong to a member of the group.
+func (s *glService) getMemberAccountOrganization(mserviceId int64, groupName string, accountId []byte) (*genericResponse, []byte) {
+	resp := &genericResponse{}
+
+	var orgId []byte
+	err := s.db.QueryRow(`SELECT a.uidOrganizationId FROM tb_GLAccount AS a
+	WHERE a.uidGlAccountId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0 AND a.uidOrganizationId IN `+groupMemberClause,
+		accountId, mserviceId, mserviceId, groupName).Scan(&orgId)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = fmt.Sprintf("account %x not found in a member organization of the group", accountId)
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	return resp, orgId
+}
+
+// Check the accounts of an elimination rule, which must belong to two different member organizations of the group.
+func (s *glService) checkEliminationRule(mserviceId int64, groupName string, accountId []byte, partnerAccountId []byte) (*genericResponse, []byte, []byte) {
+	var orgId []byte
+	var partnerId []byte
+
+	gResp, _ := s.GetOrganizationGroupHelper(mserviceId, groupName)
+	if gResp.ErrorCode == 0 {
+		gResp, orgId = s.getMemberAccountOrganization(mserviceId, groupName, accountId)
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, partnerId = s.getMemberAccountOrganization(mserviceId, groupName, partnerAccountId)
+	}
+
+	if (gResp.ErrorCode == 0) && (string(orgId) == string(partnerId)) {
+		gResp.ErrorCode = 510
+		gResp.ErrorMessage = "partner_account_id must belong to another organization"
+	}
+
+	return gResp, orgId, partnerId
+}
+
+// Check the group chart of accounts code and name of a mapping.
+func checkGroupAccount(groupAccountCode string, groupAccountName string) *genericResponse {
+	resp := &genericResponse{}
+
+	if (groupAccountCode == "") || (len(groupAccountCode) > 32) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "group_account_code missing or too long"
+	} else if (groupAccountName == "") || (len(groupAccountName) > 255) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "group_account_name missing or too long"
+	}
+
+	return resp
+}
+
+// Accumulate the account balances of every member of a group, eliminate intercompany balances, and merge the accounts
+// onto the group chart of accounts. Accounts merge by their group account code when mapped, otherwise by their own
+// account code; accounts with neither stay on their own line.
+func (s *glService) getConsolidatedBalances(filter *balanceFilter, groupName string) (*genericResponse, []*accountBalance, []*pb.GLConsolidationElimination) {
+	gResp, _ := s.GetOrganizationGroupHelper(filter.mserviceId, groupName)
+
+	var members []*pb.GLOrganizationGroupMember
+	if gResp.ErrorCode == 0 {
+		gResp, members = s.getOrganizationGroupMembers(filter.mserviceId, groupName)
+	}
+
+	// balances are added up as they are, so every member must keep its books in the same currency
+	var groupCurrency string
+	for i, member := range members {
+		if gResp.ErrorCode != 0 {
+			break
+		}
+
+		var currency string
+		gResp, currency = s.getBaseCurrency(filter.mserviceId, member.GetOrganizationId().GetGuid())
+		if i == 0 {
+			groupCurrency = currency
+		} else if (gResp.ErrorCode == 0) && (currency != groupCurrency) {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = "members of organization group have different base currencies"
+		}
+	}
+
+	var accountMaps []*pb.GLGroupAccountMap
+	if gResp.ErrorCode == 0 {
+		gResp, accountMaps = s.getGroupAccountMaps(filter.mserviceId, groupName)
+	}
+
+	var rules []*pb.GLEliminationRule
+	if gResp.ErrorCode == 0 {
+		gResp, rules = s.getEliminationRules(filter.mserviceId, groupName)
+	}
+
+	if gResp.ErrorCode != 0 {
+		return gResp, nil, nil
+	}
+
+	var memberBalances []*accountBalance
+	accountOrg := make(map[string][]byte)
+
+	for _, member := range members {
+		filter.organizationId = member.GetOrganizationId().GetGuid()
+
+		balances, err := s.getAccountBalances(filter)
+		if err != nil {
+			level.Error(s.logger).Log("what", "getAccountBalances", "error", err)
+			gResp.ErrorCode = 500
+			gResp.ErrorMessage = err.Error()
+			return gResp, nil, nil
+		}
+
+		for _, bal := range balances {
+			accountOrg[string(bal.accountId)] = filter.organizationId
+		}
+
+		memberBalances = append(memberBalances, balances...)
+	}
+
+	// details of linked intercompany entries between members, and of their reversals, on the intercompany accounts
+	intercompany, err := s.getIntercompanyEliminations(filter, groupName)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getIntercompanyEliminations", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = err.Error()
+		return gResp, nil, nil
+	}
+
+	return consolidateBalances(memberBalances, accountOrg, intercompany, rules, accountMaps)
+}
+
+// Eliminate intercompany details and the balances matched by elimination rules from the balances of group members,
+// then merge the accounts onto the group chart of accounts. accountOrg gives the member organization of each account.
+func consolidateBalances(memberBalances []*accountBalance, accountOrg map[string][]byte, intercompany []*accountBalance,
+	rules []*pb.GLEliminationRule, accountMaps []*pb.GLGroupAccountMap) (*genericResponse, []*accountBalance, []*pb.GLConsolidationElimination) {
+	gResp := &genericResponse{}
+
+	byAccount := make(map[string]*accountBalance)
+	for _, bal := range memberBalances {
+		byAccount[string(bal.accountId)] = bal
+	}
+
+	var eliminations []*pb.GLConsolidationElimination
+
+	// eliminate removes debits and credits from an account balance and records it
+	eliminate := func(bal *accountBalance, ruleId int64, debits sdec.Decimal, credits sdec.Decimal) {
+		bal.debits = bal.debits.Sub(debits)
+		bal.credits = bal.credits.Sub(credits)
+
+		elimination := pb.GLConsolidationElimination{}
+		elimination.OrganizationId, _ = dml.GuidFromBytes(accountOrg[string(bal.accountId)])
+		elimination.GlAccountId, _ = dml.GuidFromBytes(bal.accountId)
+		elimination.AccountName = bal.accountName
+		elimination.AccountCode = bal.accountCode.String
+		elimination.EliminationRuleId = ruleId
+		elimination.TotalDebits = decimalFromAmount(debits)
+		elimination.TotalCredits = decimalFromAmount(credits)
+
+		eliminations = append(eliminations, &elimination)
+	}
+
+	for _, elim := range intercompany {
+		if bal, ok := byAccount[string(elim.accountId)]; ok {
+			eliminate(bal, 0, elim.debits, elim.credits)
+		}
+	}
+
+	// each rule eliminates as much of the remaining balances of its two accounts as offsets, leaving any mismatch
+	for _, rule := range rules {
+		bal, ok := byAccount[string(rule.GetGlAccountId().GetGuid())]
+		partnerBal, partnerOk := byAccount[string(rule.GetPartnerAccountId().GetGuid())]
+		if !ok || !partnerOk || (bal.net().Sign()*partnerBal.net().Sign() >= 0) {
+			continue
+		}
+
+		amount := sdec.Min(bal.net().Abs(), partnerBal.net().Abs())
+		for _, side := range []*accountBalance{bal, partnerBal} {
+			if side.net().IsPositive() {
+				eliminate(side, rule.GetEliminationRuleId(), amount, sdec.Zero)
+			} else {
+				eliminate(side, rule.GetEliminationRuleId(), sdec.Zero, amount)
+			}
+		}
+	}
+
+	groupAccounts := make(map[string]*pb.GLGroupAccountMap)
+	for _, accountMap := range accountMaps {
+		groupAccounts[string(accountMap.GetGlAccountId().GetGuid())] = accountMap
+	}
+
+	var consolidated []*accountBalance
+	byKey := make(map[string]*accountBalance)
+
+	for _, bal := range memberBalances {
+		line := *bal
+		key := "id:" + string(bal.accountId)
+
+		if accountMap, ok := groupAccounts[string(bal.accountId)]; ok {
+			line.accountCode = sql.NullString{String: accountMap.GetGroupAccountCode(), Valid: true}
+			line.accountName = accountMap.GetGroupAccountName()
+			key = "code:" + line.accountCode.String
+		} else if bal.accountCode.Valid {
+			key = "code:" + bal.accountCode.String
+		}
+
+		total, ok := byKey[key]
+		if !ok {
+			byKey[key] = &line
+			consolidated = append(consolidated, &line)
+			continue
+		}
+
+		if baseCategory(total.category) != baseCategory(bal.category) {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = fmt.Sprintf("accounts with group account code %s have different account categories", total.accountCode.String)
+			return gResp, nil, nil
+		}
+
+		// a merged line stands for several accounts
+		total.accountId = nil
+		total.debits = total.debits.Add(bal.debits)
+		total.credits = total.credits.Add(bal.credits)
+	}
+
+	sort.SliceStable(consolidated, func(i, j int) bool {
+		return lessAccountBalance(consolidated[i], consolidated[j])
+	})
+
+	return gResp, consolidated, eliminations
+}
+
+// Accumulate debit and credit totals, by account, of the details to eliminate for linked intercompany entries between
+// members of a group. Only details on the intercompany accounts of the entry organization for its partner are
+// eliminated, and only while the partner side is still in the ledger; reversals of linked entries are eliminated with them.
+// Intercompany accounts deleted since the entries were posted still identify their details.
+func (s *glService) getIntercompanyEliminations(filter *balanceFilter, groupName string) ([]*accountBalance, error) {
+	sqlstring := `SELECT d.uidGlAccountId,
+	SUM(CASE WHEN d.bitIsDebit = 1 THEN d.decAmount ELSE 0 END),
+	SUM(CASE WHEN d.bitIsDebit = 0 THEN d.decAmount ELSE 0 END)
+	FROM tb_GLTransaction AS t
+	LEFT JOIN tb_GLTransaction AS r ON t.inbReversesTransactionId = r.inbGlTransactionId
+	JOIN tb_GLTransaction AS p ON p.inbGlTransactionId = COALESCE(t.inbIntercompanyTransactionId, r.inbIntercompanyTransactionId)
+	JOIN tb_GLIntercompanyAccount AS i ON t.uidOrganizationId = i.uidOrganizationId AND p.uidOrganizationId = i.uidPartnerOrganizationId
+	JOIN tb_GLTransactionDetail AS d ON t.inbGlTransactionId = d.inbGlTransactionId
+	AND (d.uidGlAccountId = i.uidDueFromAccountId OR d.uidGlAccountId = i.uidDueToAccountId)
+	WHERE t.inbMserviceId = ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ? AND (? = 0 OR t.bitIsClosingEntry = 0)
+	AND (? IS NULL OR t.dtmTransactionDate >= ?) AND t.dtmTransactionDate <= ?
+	AND p.bitIsDeleted = 0 AND p.intTransactionStatus = ?
+	AND t.uidOrganizationId IN ` + groupMemberClause + ` AND p.uidOrganizationId IN ` + groupMemberClause + `
+	GROUP BY d.uidGlAccountId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(filter.mserviceId, int32(filter.status), filter.excludeClosing, filter.startDate, filter.startDate, filter.endDate,
+		int32(filter.status), filter.mserviceId, groupName, filter.mserviceId, groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var result []*accountBalance
+
+	for rows.Next() {
+		var elim accountBalance
+		var debits string
+		var credits string
+
+		err := rows.Scan(&elim.accountId, &debits, &credits)
+		if err != nil {
+			return nil, err
+		}
+
+		elim.debits, err = sdec.NewFromString(debits)
+		if err != nil {
+			return nil, err
+		}
+
+		elim.credits, err = sdec.NewFromString(credits)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &elim)
+	}
+
+	return result, rows.Err()
+}
+
+// Order consolidated balances like getAccountBalances: by account type, then account code with its numeric prefix
+// compared as a number and accounts without a code last, then account name.
+func lessAccountBalance(a *accountBalance, b *accountBalance) bool {
+	if a.accountTypeId != b.accountTypeId {
+		return a.accountTypeId < b.accountTypeId
+	}
+
+	if a.accountCode.Valid != b.accountCode.Valid {
+		return a.accountCode.Valid
+	}
+
+	if a.accountCode.Valid {
+		aPrefix := accountCodePrefix(a.accountCode.String)
+		bPrefix := accountCodePrefix(b.accountCode.String)
+		if aPrefix != bPrefix {
+			return aPrefix < bPrefix
+		}
+
+		if a.accountCode.String != b.accountCode.String {
+			return a.accountCode.String < b.accountCode.String
+		}
+	}
+
+	return a.accountName < b.accountName
+}
+
+// Leading digits of the account code before the first '-', as a number.
+func accountCodePrefix(code string) uint64 {
+	prefix := strings.SplitN(code, "-", 2)[0]
+
+	end := 0
+	for (end < len(prefix)) && (prefix[end] >= '0') && (prefix[end] <= '9') {
+		end++
+	}
+
+	value, _ := strconv.ParseUint(prefix[:end], 10, 64)
+
+	return value
+}
@@ -0,0 +1,171 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// An account balance of a group member with an account code; asset accounts have type 1, liabilities type 2.
+func testMemberBalance(n byte, category pb.AccountCategory, code string, debits string, credits string) *accountBalance {
+	bal := testBalance(n, category, debits, credits)
+	bal.accountName = fmt.Sprintf("account %d", n)
+	bal.accountCode = sql.NullString{String: code, Valid: true}
+	bal.accountTypeId = 1
+	if category == pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY {
+		bal.accountTypeId = 2
+	}
+
+	return bal
+}
+
+// A guid ending in n.
+func testGuid(n byte) *dml.Guid {
+	guid, _ := dml.GuidFromBytes(testAccountId(n))
+	return guid
+}
+
+func TestConsolidateBalances(t *testing.T) {
+	asset := pb.AccountCategory_ACCOUNT_CATEGORY_ASSET
+	liability := pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY
+
+	orgA := testAccountId(100)
+	orgB := testAccountId(101)
+
+	members := []*accountBalance{
+		testMemberBalance(1, asset, "1200", "500", "0"),
+		testMemberBalance(2, liability, "2100", "0", "500"),
+		testMemberBalance(3, asset, "1300", "200", "0"),
+		testMemberBalance(4, liability, "2300", "0", "150"),
+		testMemberBalance(5, asset, "1000", "100", "0"),
+		testMemberBalance(6, asset, "1000", "40", "0"),
+		testMemberBalance(7, asset, "1010", "10", "0"),
+	}
+
+	accountOrg := map[string][]byte{}
+	for _, bal := range members {
+		accountOrg[string(bal.accountId)] = orgA
+		if bal.accountId[15]%2 == 0 {
+			accountOrg[string(bal.accountId)] = orgB
+		}
+	}
+
+	intercompany := []*accountBalance{
+		testBalance(1, asset, "300", "0"),
+		testBalance(2, liability, "0", "300"),
+		testBalance(9, asset, "25", "0"),
+	}
+
+	rules := []*pb.GLEliminationRule{
+		{EliminationRuleId: 11, GlAccountId: testGuid(3), PartnerAccountId: testGuid(4)},
+		// balances on the same side are left alone
+		{EliminationRuleId: 12, GlAccountId: testGuid(5), PartnerAccountId: testGuid(6)},
+	}
+
+	accountMaps := []*pb.GLGroupAccountMap{
+		{GlAccountId: testGuid(7), GroupAccountCode: "1000", GroupAccountName: "Cash"},
+	}
+
+	gResp, consolidated, eliminations := consolidateBalances(members, accountOrg, intercompany, rules, accountMaps)
+	if gResp.ErrorCode != 0 {
+		t.Fatalf("unexpected error %d %s", gResp.ErrorCode, gResp.ErrorMessage)
+	}
+
+	wantLines := []struct {
+		code    string
+		merged  bool
+		debits  string
+		credits string
+	}{
+		{"1000", true, "150", "0"},
+		{"1200", false, "200", "0"},
+		{"1300", false, "50", "0"},
+		{"2100", false, "0", "200"},
+		{"2300", false, "0", "0"},
+	}
+
+	if len(consolidated) != len(wantLines) {
+		t.Fatalf("got %d consolidated lines, want %d", len(consolidated), len(wantLines))
+	}
+
+	for i, line := range consolidated {
+		want := wantLines[i]
+		if line.accountCode.String != want.code {
+			t.Errorf("line %d code %s, want %s", i, line.accountCode.String, want.code)
+		}
+
+		if (line.accountId == nil) != want.merged {
+			t.Errorf("line %s merged %t, want %t", want.code, line.accountId == nil, want.merged)
+		}
+
+		if (line.debits.String() != want.debits) || (line.credits.String() != want.credits) {
+			t.Errorf("line %s debits %s credits %s, want %s and %s", want.code, line.debits, line.credits, want.debits, want.credits)
+		}
+	}
+
+	wantEliminations := []struct {
+		account byte
+		org     []byte
+		ruleId  int64
+		debits  string
+		credits string
+	}{
+		{1, orgA, 0, "300.00", "0.00"},
+		{2, orgB, 0, "0.00", "300.00"},
+		{3, orgA, 11, "150.00", "0.00"},
+		{4, orgB, 11, "0.00", "150.00"},
+	}
+
+	if len(eliminations) != len(wantEliminations) {
+		t.Fatalf("got %d eliminations, want %d", len(eliminations), len(wantEliminations))
+	}
+
+	for i, elim := range eliminations {
+		want := wantEliminations[i]
+		if elim.GetGlAccountId().GetGuid()[15] != want.account {
+			t.Errorf("elimination %d account %d, want %d", i, elim.GetGlAccountId().GetGuid()[15], want.account)
+		}
+
+		if string(elim.GetOrganizationId().GetGuid()) != string(want.org) {
+			t.Errorf("elimination %d organization %v, want %v", i, elim.GetOrganizationId().GetGuid(), want.org)
+		}
+
+		if elim.GetEliminationRuleId() != want.ruleId {
+			t.Errorf("elimination %d rule %d, want %d", i, elim.GetEliminationRuleId(), want.ruleId)
+		}
+
+		if (elim.GetTotalDebits().GetPlaintext() != want.debits) || (elim.GetTotalCredits().GetPlaintext() != want.credits) {
+			t.Errorf("elimination %d debits %s credits %s, want %s and %s", i, elim.GetTotalDebits().GetPlaintext(),
+				elim.GetTotalCredits().GetPlaintext(), want.debits, want.credits)
+		}
+	}
+}
+
+func TestConsolidateBalancesCategoryMismatch(t *testing.T) {
+	members := []*accountBalance{
+		testMemberBalance(1, pb.AccountCategory_ACCOUNT_CATEGORY_ASSET, "1500", "10", "0"),
+		testMemberBalance(2, pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY, "1500", "0", "10"),
+	}
+
+	gResp, _, _ := consolidateBalances(members, map[string][]byte{}, nil, nil, nil)
+	if gResp.ErrorCode != 501 {
+		t.Errorf("error code %d, want 501", gResp.ErrorCode)
+	}
+}
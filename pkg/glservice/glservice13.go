@@ -0,0 +1,1082 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// days a transaction date may differ from the statement date when matching, unless requested otherwise
+const defaultMatchWindowDays = 3
+
+// A transaction of an OFX statement, closed in both the SGML and XML forms of OFX.
+var ofxTransactionPattern = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+
+// A bank statement line parsed from a statement file.
+type statementLine struct {
+	date        time.Time
+	amount      sdec.Decimal
+	reference   string
+	description string
+}
+
+// A bank statement line with the fields used for matching.
+type matchLine struct {
+	lineId      int64
+	version     int32
+	date        time.Time
+	amount      sdec.Decimal
+	reference   string
+	description string
+}
+
+// A transaction detail of the cash account which may match a statement line.
+type matchCandidate struct {
+	transactionId  int64
+	sequenceNumber int32
+	date           time.Time
+	postedViaKey   string
+	// debits are positive, like money received on a statement line
+	amount sdec.Decimal
+	used   bool
+}
+
+// import bank statement lines for a general ledger cash account and match them to transaction details
+func (s *glService) ImportBankStatement(ctx context.Context, req *pb.ImportBankStatementRequest) (*pb.ImportBankStatementResponse, error) {
+	resp := &pb.ImportBankStatementResponse{}
+
+	var lines []*statementLine
+	var currency string
+	var err error
+
+	switch req.GetStatementFormat() {
+	case pb.StatementFormat_STATEMENT_FORMAT_OFX:
+		lines, currency, err = parseOFX(req.GetStatementData())
+	case pb.StatementFormat_STATEMENT_FORMAT_CAMT053:
+		lines, currency, err = parseCAMT053(req.GetStatementData())
+	default:
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "statement_format missing or invalid"
+		return resp, nil
+	}
+
+	if err != nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = fmt.Sprintf("statement_data could not be parsed: %s", err.Error())
+		return resp, nil
+	}
+
+	gResp, orgId := s.checkCashAccount(req.GetMserviceId(), req.GetGlAccountId().GetGuid())
+
+	// statement amounts are booked as they are, so they must be in the base currency of the organization
+	var baseCurrency string
+	if gResp.ErrorCode == 0 {
+		gResp, baseCurrency = s.getBaseCurrency(req.GetMserviceId(), orgId)
+		if (gResp.ErrorCode == 0) && (currency != "") && (baseCurrency != "") && (currency != baseCurrency) {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = fmt.Sprintf("statement currency %s differs from organization base currency %s", currency, baseCurrency)
+		}
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring := `INSERT INTO tb_GLBankStatementLine (dtmCreated, dtmModified, intVersion, inbMserviceId, uidGlAccountId, dtmStatementDate,
+	decAmount, chvReference, chvDescription, intMatchStatus) VALUES (NOW(), NOW(), 1, ?, ?, ?, ?, ?, ?)`
+
+	var lineIds []int64
+
+	for _, line := range lines {
+		// lines already imported from an overlapping statement are skipped
+		var count int
+		err = tx.QueryRow(`SELECT COUNT(*) FROM tb_GLBankStatementLine WHERE uidGlAccountId = ? AND chvReference = ?`,
+			req.GetGlAccountId().GetGuid(), line.reference).Scan(&count)
+		if err != nil {
+			break
+		}
+
+		if count > 0 {
+			resp.DuplicateCount++
+			continue
+		}
+
+		var res sql.Result
+		res, err = tx.Exec(sqlstring, req.GetMserviceId(), req.GetGlAccountId().GetGuid(), line.date, line.amount.StringFixed(2), line.reference,
+			line.description, int32(pb.MatchStatus_MATCH_STATUS_UNMATCHED))
+		if err != nil {
+			break
+		}
+
+		var lineId int64
+		lineId, err = res.LastInsertId()
+		if err != nil {
+			break
+		}
+
+		lineIds = append(lineIds, lineId)
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	resp.ImportedCount = int32(len(lineIds))
+
+	gResp, resp.MatchedCount = s.autoMatchBankStatement(req.GetMserviceId(), req.GetGlAccountId().GetGuid(), req.GetDateWindowDays())
+	if (gResp.ErrorCode == 0) && (len(lineIds) > 0) {
+		args := []interface{}{req.GetMserviceId()}
+		for _, lineId := range lineIds {
+			args = append(args, lineId)
+		}
+
+		gResp, resp.GlBankStatementLines = s.getBankStatementLines(`inbMserviceId = ? AND inbStatementLineId IN (?`+
+			strings.Repeat(", ?", len(lineIds)-1)+`)`, args...)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// match unmatched bank statement lines of a general ledger account to transaction details
+func (s *glService) AutoMatchBankStatement(ctx context.Context, req *pb.AutoMatchBankStatementRequest) (*pb.AutoMatchBankStatementResponse, error) {
+	resp := &pb.AutoMatchBankStatementResponse{}
+
+	gResp, _ := s.checkCashAccount(req.GetMserviceId(), req.GetGlAccountId().GetGuid())
+	if gResp.ErrorCode == 0 {
+		gResp, resp.MatchedCount = s.autoMatchBankStatement(req.GetMserviceId(), req.GetGlAccountId().GetGuid(), req.GetDateWindowDays())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// confirm the match of a bank statement line, or match it to a given transaction detail
+func (s *glService) ConfirmBankMatch(ctx context.Context, req *pb.ConfirmBankMatchRequest) (*pb.ConfirmBankMatchResponse, error) {
+	resp := &pb.ConfirmBankMatchResponse{}
+
+	gResp, line := s.GetBankStatementLineHelper(req.GetStatementLineId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	transactionId := req.GetGlTransactionId()
+	sequenceNumber := req.GetSequenceNumber()
+
+	if transactionId == 0 {
+		if line.GetMatchStatus() != pb.MatchStatus_MATCH_STATUS_SUGGESTED {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = "statement line has no suggested match"
+			return resp, nil
+		}
+
+		transactionId = line.GetGlTransactionId()
+		sequenceNumber = line.GetSequenceNumber()
+	}
+
+	// the suggested detail may have been voided or matched to another line since it was suggested
+	gResp = s.checkBankMatchDetail(req.GetMserviceId(), line, transactionId, sequenceNumber)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLBankStatementLine SET dtmModified = NOW(), intVersion = ?, intMatchStatus = ?, inbGlTransactionId = ?,
+	intSequenceNumber = ?, dtmReconciled = NOW()
+	WHERE inbStatementLineId = ? AND intVersion = ? AND inbMserviceId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, int32(pb.MatchStatus_MATCH_STATUS_CONFIRMED), transactionId, sequenceNumber,
+		req.GetStatementLineId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// remove the match of a bank statement line
+func (s *glService) UnmatchBankStatementLine(ctx context.Context, req *pb.UnmatchBankStatementLineRequest) (*pb.UnmatchBankStatementLineResponse, error) {
+	resp := &pb.UnmatchBankStatementLineResponse{}
+
+	sqlstring := `UPDATE tb_GLBankStatementLine SET dtmModified = NOW(), intVersion = ?, intMatchStatus = ?, inbGlTransactionId = NULL,
+	intSequenceNumber = NULL, dtmReconciled = NULL
+	WHERE inbStatementLineId = ? AND intVersion = ? AND inbMserviceId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, int32(pb.MatchStatus_MATCH_STATUS_UNMATCHED), req.GetStatementLineId(), req.GetVersion(),
+		req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete an unreconciled bank statement line
+func (s *glService) DeleteBankStatementLine(ctx context.Context, req *pb.DeleteBankStatementLineRequest) (*pb.DeleteBankStatementLineResponse, error) {
+	resp := &pb.DeleteBankStatementLineResponse{}
+
+	gResp, line := s.GetBankStatementLineHelper(req.GetStatementLineId(), req.GetMserviceId())
+	if (gResp.ErrorCode == 0) && (line.GetMatchStatus() == pb.MatchStatus_MATCH_STATUS_CONFIRMED) {
+		gResp.ErrorCode = 501
+		gResp.ErrorMessage = "reconciled statement line must be unmatched before it is deleted"
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// the line is removed outright, so that importing the statement again brings it back
+	sqlstring := `DELETE FROM tb_GLBankStatementLine WHERE inbStatementLineId = ? AND intVersion = ? AND inbMserviceId = ?
+	AND intMatchStatus <> ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetStatementLineId(), req.GetVersion(), req.GetMserviceId(), int32(pb.MatchStatus_MATCH_STATUS_CONFIRMED))
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get bank statement lines of a general ledger account between dates
+func (s *glService) GetBankStatementLines(ctx context.Context, req *pb.GetBankStatementLinesRequest) (*pb.GetBankStatementLinesResponse, error) {
+	resp := &pb.GetBankStatementLinesResponse{}
+
+	gResp, lines := s.getBankStatementLines(`uidGlAccountId = ? AND inbMserviceId = ? AND dtmStatementDate >= ? AND dtmStatementDate <= ?
+	AND (? = 0 OR intMatchStatus = ?)`, req.GetGlAccountId().GetGuid(), req.GetMserviceId(), req.GetStartDate().TimeFromDateTime(),
+		req.GetEndDate().TimeFromDateTime(), int32(req.GetMatchStatus()), int32(req.GetMatchStatus()))
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlBankStatementLines = lines
+
+	return resp, nil
+}
+
+// get bank reconciliation of a general ledger account between dates
+func (s *glService) GetBankReconciliation(ctx context.Context, req *pb.GetBankReconciliationRequest) (*pb.GetBankReconciliationResponse, error) {
+	resp := &pb.GetBankReconciliationResponse{}
+
+	startDate := req.GetStartDate().TimeFromDateTime()
+	endDate := req.GetEndDate().TimeFromDateTime()
+
+	filter := balanceFilter{
+		mserviceId: req.GetMserviceId(),
+		accountId:  req.GetGlAccountId().GetGuid(),
+		endDate:    endDate,
+		status:     pb.TransactionStatus_TRANSACTION_STATUS_POSTED,
+	}
+
+	book, gResp := s.getSingleAccountBalance(&filter)
+
+	if gResp.ErrorCode == 0 {
+		gResp, resp.ReconciledLines = s.getBankStatementLines(`uidGlAccountId = ? AND inbMserviceId = ? AND intMatchStatus = ?
+		AND dtmStatementDate >= ? AND dtmStatementDate <= ?`, req.GetGlAccountId().GetGuid(), req.GetMserviceId(),
+			int32(pb.MatchStatus_MATCH_STATUS_CONFIRMED), startDate, endDate)
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, resp.UnreconciledLines = s.getBankStatementLines(`uidGlAccountId = ? AND inbMserviceId = ? AND intMatchStatus <> ?
+		AND dtmStatementDate <= ?`, req.GetGlAccountId().GetGuid(), req.GetMserviceId(), int32(pb.MatchStatus_MATCH_STATUS_CONFIRMED),
+			endDate)
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, resp.UnreconciledEntries = s.getUnreconciledEntries(req.GetMserviceId(), req.GetGlAccountId().GetGuid(), endDate)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	linesTotal := sdec.Zero
+	for _, line := range resp.UnreconciledLines {
+		amount, _ := sdec.NewFromString(line.GetAmount().GetPlaintext())
+		linesTotal = linesTotal.Add(amount)
+	}
+
+	entriesTotal := sdec.Zero
+	for _, entry := range resp.UnreconciledEntries {
+		amount, _ := sdec.NewFromString(entry.GetAmount().GetPlaintext())
+		if entry.GetIsDebit() {
+			entriesTotal = entriesTotal.Add(amount)
+		} else {
+			entriesTotal = entriesTotal.Sub(amount)
+		}
+	}
+
+	resp.BookBalance = decimalFromAmount(book.net())
+	resp.UnreconciledLinesTotal = decimalFromAmount(linesTotal)
+	resp.UnreconciledEntriesTotal = decimalFromAmount(entriesTotal)
+	resp.StatementBalance = decimalFromAmount(book.net().Sub(entriesTotal).Add(linesTotal))
+
+	return resp, nil
+}
+
+// Get a bank statement line by id.
+func (s *glService) GetBankStatementLineHelper(lineId int64, mserviceId int64) (*genericResponse, *pb.GLBankStatementLine) {
+	gResp, lines := s.getBankStatementLines(`inbStatementLineId = ? AND inbMserviceId = ?`, lineId, mserviceId)
+	if (gResp.ErrorCode == 0) && (len(lines) == 0) {
+		gResp.ErrorCode = 404
+		gResp.ErrorMessage = "not found"
+	}
+
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	return gResp, lines[0]
+}
+
+// Get the bank statement lines selected by a where clause, ordered by statement date.
+func (s *glService) getBankStatementLines(where string, args ...interface{}) (*genericResponse, []*pb.GLBankStatementLine) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT inbStatementLineId, dtmCreated, dtmModified, intVersion, inbMserviceId, uidGlAccountId, dtmStatementDate, decAmount,
+	chvReference, chvDescription, intMatchStatus, inbGlTransactionId, intSequenceNumber, dtmReconciled
+	FROM tb_GLBankStatementLine WHERE ` + where + `
+	ORDER BY dtmStatementDate, inbStatementLineId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var lines []*pb.GLBankStatementLine
+
+	for rows.Next() {
+		var line pb.GLBankStatementLine
+		var created time.Time
+		var modified time.Time
+		var accountId []byte
+		var statementDate time.Time
+		var amount string
+		var matchStatus int32
+		var transactionId sql.NullInt64
+		var sequenceNumber sql.NullInt32
+		var reconciled sql.NullTime
+
+		err := rows.Scan(&line.StatementLineId, &created, &modified, &line.Version, &line.MserviceId, &accountId, &statementDate, &amount,
+			&line.Reference, &line.Description, &matchStatus, &transactionId, &sequenceNumber, &reconciled)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		line.Created = dml.DateTimeFromTime(created)
+		line.Modified = dml.DateTimeFromTime(modified)
+		line.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		line.StatementDate = dml.DateTimeFromTime(statementDate)
+		line.Amount = &dml.Decimal{Plaintext: amount}
+		line.MatchStatus = pb.MatchStatus(matchStatus)
+		line.GlTransactionId = transactionId.Int64
+		line.SequenceNumber = sequenceNumber.Int32
+		if reconciled.Valid {
+			line.Reconciled = dml.DateTimeFromTime(reconciled.Time)
+		}
+
+		lines = append(lines, &line)
+	}
+
+	return resp, lines
+}
+
+// Get the posted transaction details of an account up to a date which are not matched to a confirmed statement line.
+func (s *glService) getUnreconciledEntries(mserviceId int64, accountId []byte, endDate time.Time) (*genericResponse, []*pb.GLLedgerEntry) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT t.inbGlTransactionId, d.intSequenceNumber, t.dtmTransactionDate, t.chvTransactionDescription, t.intTransactionTypeId,
+	t.chvPostedViaKey, d.decAmount, d.bitIsDebit
+	FROM tb_GLTransactionDetail AS d
+	JOIN tb_GLTransaction AS t
+	ON d.inbGlTransactionId = t.inbGlTransactionId
+	WHERE d.uidGlAccountId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate <= ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ?
+	AND NOT EXISTS (SELECT b.inbStatementLineId FROM tb_GLBankStatementLine AS b
+		WHERE b.inbGlTransactionId = d.inbGlTransactionId AND b.intSequenceNumber = d.intSequenceNumber AND b.intMatchStatus = ?)
+	ORDER BY t.dtmTransactionDate, t.inbGlTransactionId, d.intSequenceNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(accountId, mserviceId, endDate, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED),
+		int32(pb.MatchStatus_MATCH_STATUS_CONFIRMED))
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var entries []*pb.GLLedgerEntry
+
+	for rows.Next() {
+		var entry pb.GLLedgerEntry
+		var trandate time.Time
+		var via_key sql.NullString
+		var amount string
+
+		err := rows.Scan(&entry.GlTransactionId, &entry.SequenceNumber, &trandate, &entry.TransactionDescription, &entry.TransactionTypeId,
+			&via_key, &amount, &entry.IsDebit)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		entry.TransactionDate = dml.DateTimeFromTime(trandate)
+		entry.PostedViaKey = via_key.String
+		entry.Amount = &dml.Decimal{Plaintext: amount}
+
+		entries = append(entries, &entry)
+	}
+
+	return resp, entries
+}
+
+// Check that an account can hold bank statement lines: it must exist and, when its account type is classified, be an
+// asset account. Returns the organization of the account.
+func (s *glService) checkCashAccount(mserviceId int64, accountId []byte) (*genericResponse, []byte) {
+	resp := &genericResponse{}
+
+	var orgId []byte
+	var category int32
+
+	err := s.db.QueryRow(`SELECT a.uidOrganizationId, y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidGlAccountId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0`, accountId, mserviceId).Scan(&orgId, &category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "account not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if (pb.AccountCategory(category) != pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED) &&
+		(pb.AccountCategory(category) != pb.AccountCategory_ACCOUNT_CATEGORY_ASSET) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "bank statements can only be imported for an asset account"
+		return resp, nil
+	}
+
+	return resp, orgId
+}
+
+// Check that a transaction detail can be matched to a statement line: a posted detail of the line account for the
+// same amount, not matched to another line.
+func (s *glService) checkBankMatchDetail(mserviceId int64, line *pb.GLBankStatementLine, transactionId int64, sequenceNumber int32) *genericResponse {
+	resp := &genericResponse{}
+
+	var amount string
+	var isDebit bool
+
+	err := s.db.QueryRow(`SELECT d.decAmount, d.bitIsDebit FROM tb_GLTransactionDetail AS d
+	JOIN tb_GLTransaction AS t ON d.inbGlTransactionId = t.inbGlTransactionId
+	WHERE d.inbGlTransactionId = ? AND d.intSequenceNumber = ? AND d.uidGlAccountId = ? AND t.inbMserviceId = ?
+	AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ?`, transactionId, sequenceNumber, line.GetGlAccountId().GetGuid(), mserviceId,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED)).Scan(&amount, &isDebit)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "posted transaction detail of the statement line account not found"
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	detailAmount, _ := sdec.NewFromString(amount)
+	if !isDebit {
+		detailAmount = detailAmount.Neg()
+	}
+
+	lineAmount, _ := sdec.NewFromString(line.GetAmount().GetPlaintext())
+	if !detailAmount.Equal(lineAmount) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "amount of transaction detail differs from statement line"
+		return resp
+	}
+
+	var otherId int64
+	err = s.db.QueryRow(`SELECT inbStatementLineId FROM tb_GLBankStatementLine WHERE inbGlTransactionId = ? AND intSequenceNumber = ?
+	AND inbStatementLineId <> ? LIMIT 1`, transactionId, sequenceNumber, line.GetStatementLineId()).Scan(&otherId)
+	if err == nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("transaction detail already matched to statement line %d", otherId)
+	} else if err != sql.ErrNoRows {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
+
+// Check that no bank statement line is matched to a transaction.
+func (s *glService) checkTransactionNotMatched(transactionId int64) *genericResponse {
+	resp := &genericResponse{}
+
+	var lineId int64
+	err := s.db.QueryRow(`SELECT inbStatementLineId FROM tb_GLBankStatementLine WHERE inbGlTransactionId = ? LIMIT 1`,
+		transactionId).Scan(&lineId)
+	if err == nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("transaction is matched to statement line %d", lineId)
+	} else if err != sql.ErrNoRows {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
+
+// Suggest a transaction detail for each unmatched statement line of an account. A detail matches when its amount
+// equals the line amount and its date is within the window; a detail whose posted_via_key appears in the line
+// reference or description is preferred, then the closest date. Returns the number of lines matched.
+func (s *glService) autoMatchBankStatement(mserviceId int64, accountId []byte, windowDays int32) (*genericResponse, int32) {
+	resp := &genericResponse{}
+
+	if windowDays <= 0 {
+		windowDays = defaultMatchWindowDays
+	}
+
+	lines, err := s.getMatchLines(mserviceId, accountId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getMatchLines", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	if len(lines) == 0 {
+		return resp, 0
+	}
+
+	window := time.Duration(windowDays) * 24 * time.Hour
+	candidates, err := s.getMatchCandidates(mserviceId, accountId, lines[0].date.Add(-window), lines[len(lines)-1].date.Add(window))
+	if err != nil {
+		level.Error(s.logger).Log("what", "getMatchCandidates", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	sqlstring := `UPDATE tb_GLBankStatementLine SET dtmModified = NOW(), intVersion = ?, intMatchStatus = ?, inbGlTransactionId = ?,
+	intSequenceNumber = ? WHERE inbStatementLineId = ? AND intVersion = ? AND intMatchStatus = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, 0
+	}
+
+	defer stmt.Close()
+
+	var matched int32
+
+	for _, line := range lines {
+		var best *matchCandidate
+		var bestKey bool
+		var bestDiff time.Duration
+
+		for _, candidate := range candidates {
+			if candidate.used || !candidate.amount.Equal(line.amount) {
+				continue
+			}
+
+			diff := startOfDay(candidate.date).Sub(startOfDay(line.date))
+			if diff < 0 {
+				diff = -diff
+			}
+
+			if diff > window {
+				continue
+			}
+
+			key := (candidate.postedViaKey != "") && ((candidate.postedViaKey == line.reference) ||
+				strings.Contains(line.description, candidate.postedViaKey))
+
+			if (best == nil) || (key && !bestKey) || ((key == bestKey) && (diff < bestDiff)) {
+				best = candidate
+				bestKey = key
+				bestDiff = diff
+			}
+		}
+
+		if best == nil {
+			continue
+		}
+
+		res, err := stmt.Exec(line.version+1, int32(pb.MatchStatus_MATCH_STATUS_SUGGESTED), best.transactionId, best.sequenceNumber,
+			line.lineId, line.version, int32(pb.MatchStatus_MATCH_STATUS_UNMATCHED))
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, matched
+		}
+
+		// a line changed since it was read keeps its state, and the detail stays available
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			best.used = true
+			matched++
+		}
+	}
+
+	return resp, matched
+}
+
+// Get the unmatched statement lines of an account, ordered by statement date.
+func (s *glService) getMatchLines(mserviceId int64, accountId []byte) ([]*matchLine, error) {
+	rows, err := s.db.Query(`SELECT inbStatementLineId, intVersion, dtmStatementDate, decAmount, chvReference, chvDescription
+	FROM tb_GLBankStatementLine WHERE uidGlAccountId = ? AND inbMserviceId = ? AND intMatchStatus = ?
+	ORDER BY dtmStatementDate, inbStatementLineId`, accountId, mserviceId, int32(pb.MatchStatus_MATCH_STATUS_UNMATCHED))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var lines []*matchLine
+
+	for rows.Next() {
+		var line matchLine
+		var amount string
+
+		err := rows.Scan(&line.lineId, &line.version, &line.date, &amount, &line.reference, &line.description)
+		if err != nil {
+			return nil, err
+		}
+
+		line.amount, err = sdec.NewFromString(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, &line)
+	}
+
+	return lines, rows.Err()
+}
+
+// Get the posted transaction details of an account between dates which are not matched to any statement line,
+// ordered by date.
+func (s *glService) getMatchCandidates(mserviceId int64, accountId []byte, startDate time.Time, endDate time.Time) ([]*matchCandidate, error) {
+	rows, err := s.db.Query(`SELECT t.inbGlTransactionId, d.intSequenceNumber, t.dtmTransactionDate, t.chvPostedViaKey, d.decAmount, d.bitIsDebit
+	FROM tb_GLTransactionDetail AS d
+	JOIN tb_GLTransaction AS t
+	ON d.inbGlTransactionId = t.inbGlTransactionId
+	WHERE d.uidGlAccountId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
+	AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ?
+	AND NOT EXISTS (SELECT b.inbStatementLineId FROM tb_GLBankStatementLine AS b
+		WHERE b.inbGlTransactionId = d.inbGlTransactionId AND b.intSequenceNumber = d.intSequenceNumber)
+	ORDER BY t.dtmTransactionDate, t.inbGlTransactionId, d.intSequenceNumber`, accountId, mserviceId, startOfDay(startDate), endDate,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var candidates []*matchCandidate
+
+	for rows.Next() {
+		var candidate matchCandidate
+		var via_key sql.NullString
+		var amount string
+		var isDebit bool
+
+		err := rows.Scan(&candidate.transactionId, &candidate.sequenceNumber, &candidate.date, &via_key, &amount, &isDebit)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate.postedViaKey = via_key.String
+		candidate.amount, err = sdec.NewFromString(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isDebit {
+			candidate.amount = candidate.amount.Neg()
+		}
+
+		candidates = append(candidates, &candidate)
+	}
+
+	return candidates, rows.Err()
+}
+
+// Parse the transactions of an OFX statement, in either the SGML (1.x) or XML (2.x) form.
+// Returns the lines and the statement currency.
+func parseOFX(data string) ([]*statementLine, string, error) {
+	if !strings.Contains(strings.ToUpper(data), "<OFX>") {
+		return nil, "", errors.New("OFX element not found")
+	}
+
+	currency := strings.ToUpper(ofxField(data, "CURDEF"))
+
+	var lines []*statementLine
+
+	for i, match := range ofxTransactionPattern.FindAllStringSubmatch(data, -1) {
+		block := match[1]
+
+		posted := ofxField(block, "DTPOSTED")
+		if len(posted) < 8 {
+			return nil, "", fmt.Errorf("transaction %d has no DTPOSTED", i+1)
+		}
+
+		date, err := time.ParseInLocation("20060102", posted[:8], time.Local)
+		if err != nil {
+			return nil, "", fmt.Errorf("transaction %d has invalid DTPOSTED %s", i+1, posted)
+		}
+
+		amount, err := sdec.NewFromString(strings.Replace(ofxField(block, "TRNAMT"), ",", ".", 1))
+		if err != nil {
+			return nil, "", fmt.Errorf("transaction %d has invalid TRNAMT", i+1)
+		}
+
+		var parts []string
+		for _, tag := range []string{"NAME", "MEMO", "CHECKNUM", "REFNUM"} {
+			if value := ofxField(block, tag); value != "" {
+				parts = append(parts, value)
+			}
+		}
+
+		line := statementLine{date: date, amount: amount, description: strings.Join(parts, " ")}
+		line.reference = ofxField(block, "FITID")
+
+		lines = append(lines, newStatementLine(&line, i))
+	}
+
+	return lines, currency, nil
+}
+
+// Value of an OFX element within a block; elements need not be closed in SGML OFX.
+func ofxField(block string, tag string) string {
+	pattern := regexp.MustCompile(`(?i)<` + tag + `>\s*([^<\r\n]*)`)
+
+	match := pattern.FindStringSubmatch(block)
+	if match == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(match[1])
+}
+
+// CAMT.053 document, with only the elements needed for statement lines. Namespaces of the various
+// versions are ignored.
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Amount      camtAmount     `xml:"Amt"`
+	CreditDebit string         `xml:"CdtDbtInd"`
+	Status      camtStatus     `xml:"Sts"`
+	BookingDate camtDate       `xml:"BookgDt"`
+	ValueDate   camtDate       `xml:"ValDt"`
+	ServicerRef string         `xml:"AcctSvcrRef"`
+	EntryRef    string         `xml:"NtryRef"`
+	Info        string         `xml:"AddtlNtryInf"`
+	Details     []camtTxDetail `xml:"NtryDtls>TxDtls"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// entry status is plain text before version 8, a code element after
+type camtStatus struct {
+	Text string `xml:",chardata"`
+	Code string `xml:"Cd"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtTxDetail struct {
+	EndToEndId   string   `xml:"Refs>EndToEndId"`
+	Unstructured []string `xml:"RmtInf>Ustrd"`
+	Reference    string   `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+}
+
+// Parse the booked entries of a CAMT.053 statement. Returns the lines and the statement currency.
+func parseCAMT053(data string) ([]*statementLine, string, error) {
+	var doc camtDocument
+
+	err := xml.Unmarshal([]byte(data), &doc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(doc.Statements) == 0 {
+		return nil, "", errors.New("Stmt element not found")
+	}
+
+	var lines []*statementLine
+	var currency string
+
+	for _, stmt := range doc.Statements {
+		for _, entry := range stmt.Entries {
+			status := strings.TrimSpace(entry.Status.Text + entry.Status.Code)
+			if (status == "PDNG") || (status == "INFO") {
+				continue
+			}
+
+			i := len(lines)
+
+			if entry.Amount.Currency != "" {
+				if (currency != "") && (entry.Amount.Currency != currency) {
+					return nil, "", fmt.Errorf("entry %d has currency %s, other entries %s", i+1, entry.Amount.Currency, currency)
+				}
+				currency = entry.Amount.Currency
+			}
+
+			amount, err := sdec.NewFromString(strings.TrimSpace(entry.Amount.Value))
+			if err != nil {
+				return nil, "", fmt.Errorf("entry %d has invalid Amt", i+1)
+			}
+
+			switch strings.TrimSpace(entry.CreditDebit) {
+			case "CRDT":
+			case "DBIT":
+				amount = amount.Neg()
+			default:
+				return nil, "", fmt.Errorf("entry %d has invalid CdtDbtInd", i+1)
+			}
+
+			dateText := entry.BookingDate.Date + entry.BookingDate.DateTime
+			if dateText == "" {
+				dateText = entry.ValueDate.Date + entry.ValueDate.DateTime
+			}
+
+			if len(dateText) < 10 {
+				return nil, "", fmt.Errorf("entry %d has no BookgDt", i+1)
+			}
+
+			date, err := time.ParseInLocation("2006-01-02", dateText[:10], time.Local)
+			if err != nil {
+				return nil, "", fmt.Errorf("entry %d has invalid BookgDt %s", i+1, dateText)
+			}
+
+			var parts []string
+			if info := strings.TrimSpace(entry.Info); info != "" {
+				parts = append(parts, info)
+			}
+
+			var endToEndId string
+			for _, detail := range entry.Details {
+				for _, text := range append(detail.Unstructured, detail.Reference) {
+					if text = strings.TrimSpace(text); text != "" {
+						parts = append(parts, text)
+					}
+				}
+
+				if id := strings.TrimSpace(detail.EndToEndId); (id != "") && (id != "NOTPROVIDED") {
+					parts = append(parts, id)
+					if endToEndId == "" {
+						endToEndId = id
+					}
+				}
+			}
+
+			line := statementLine{date: date, amount: amount, description: strings.Join(parts, " ")}
+			for _, reference := range []string{entry.ServicerRef, entry.EntryRef, endToEndId} {
+				if reference = strings.TrimSpace(reference); reference != "" {
+					line.reference = reference
+					break
+				}
+			}
+
+			lines = append(lines, newStatementLine(&line, i))
+		}
+	}
+
+	return lines, currency, nil
+}
+
+// Fit a parsed statement line to its columns. A line without a bank reference gets one from its date, amount and
+// position in the file, so importing the same file again skips it.
+func newStatementLine(line *statementLine, index int) *statementLine {
+	if line.reference == "" {
+		line.reference = fmt.Sprintf("%s:%s:%d", line.date.Format("20060102"), line.amount.StringFixed(2), index+1)
+	}
+
+	line.reference = truncateString(line.reference, 64)
+	line.description = truncateString(line.description, 255)
+
+	return line
+}
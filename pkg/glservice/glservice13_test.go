@@ -0,0 +1,272 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package glservice
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Expected fields of a parsed statement line.
+type wantLine struct {
+	date        time.Time
+	amount      string
+	reference   string
+	description string
+}
+
+// Compare parsed statement lines and currency with those expected.
+func checkStatementLines(t *testing.T, lines []*statementLine, currency string, want []wantLine, wantCurrency string) {
+	t.Helper()
+
+	if currency != wantCurrency {
+		t.Errorf("currency %q, want %q", currency, wantCurrency)
+	}
+
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+
+	for i, line := range lines {
+		if !line.date.Equal(want[i].date) {
+			t.Errorf("line %d date %v, want %v", i+1, line.date, want[i].date)
+		}
+
+		if line.amount.StringFixed(2) != want[i].amount {
+			t.Errorf("line %d amount %s, want %s", i+1, line.amount.StringFixed(2), want[i].amount)
+		}
+
+		if line.reference != want[i].reference {
+			t.Errorf("line %d reference %q, want %q", i+1, line.reference, want[i].reference)
+		}
+
+		if line.description != want[i].description {
+			t.Errorf("line %d description %q, want %q", i+1, line.description, want[i].description)
+		}
+	}
+}
+
+func TestParseOFX(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		wantErr      bool
+		wantLines    []wantLine
+		wantCurrency string
+	}{
+		{
+			name: "sgml",
+			data: `OFXHEADER:100
+DATA:OFXSGML
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>usd
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20211004120000[-5:EST]
+<TRNAMT>-42.50
+<FITID>2021100401
+<NAME>ACME SUPPLIES
+<MEMO>invoice 1001
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20211005
+<TRNAMT>1200,00
+<CHECKNUM>0042
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`,
+			wantLines: []wantLine{
+				{localDate(2021, time.October, 4), "-42.50", "2021100401", "ACME SUPPLIES invoice 1001"},
+				{localDate(2021, time.October, 5), "1200.00", "20211005:1200.00:2", "0042"},
+			},
+			wantCurrency: "USD",
+		},
+		{
+			name: "xml",
+			data: `<?xml version="1.0" encoding="UTF-8"?>
+<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>EUR</CURDEF><BANKTRANLIST>
+<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20211101</DTPOSTED><TRNAMT>-9.99</TRNAMT><FITID>A1</FITID><NAME>fee</NAME></STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`,
+			wantLines: []wantLine{
+				{localDate(2021, time.November, 1), "-9.99", "A1", "fee"},
+			},
+			wantCurrency: "EUR",
+		},
+		{
+			name:         "no transactions",
+			data:         "<OFX><CURDEF>USD</OFX>",
+			wantCurrency: "USD",
+		},
+		{
+			name: "long fields are cut to their columns",
+			data: "<OFX><STMTTRN><DTPOSTED>20211101<TRNAMT>1<FITID>" + strings.Repeat("r", 70) + "<NAME>" +
+				strings.Repeat("d", 300) + "</STMTTRN></OFX>",
+			wantLines: []wantLine{
+				{localDate(2021, time.November, 1), "1.00", strings.Repeat("r", 64), strings.Repeat("d", 255)},
+			},
+		},
+		{
+			name:    "not ofx",
+			data:    "Date,Amount\n2021-10-04,-42.50\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing date",
+			data:    "<OFX><STMTTRN><TRNAMT>1.00</STMTTRN></OFX>",
+			wantErr: true,
+		},
+		{
+			name:    "invalid date",
+			data:    "<OFX><STMTTRN><DTPOSTED>2021OCT4<TRNAMT>1.00</STMTTRN></OFX>",
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			data:    "<OFX><STMTTRN><DTPOSTED>20211004<TRNAMT>one</STMTTRN></OFX>",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, currency, err := parseOFX(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %d lines, want an error", len(lines))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			checkStatementLines(t, lines, currency, tt.wantLines, tt.wantCurrency)
+		})
+	}
+}
+
+// A CAMT.053 document with the given entries.
+func camtDocumentOf(entries string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+<BkToCstmrStmt><Stmt><Id>1</Id>` + entries + `</Stmt></BkToCstmrStmt>
+</Document>`
+}
+
+func TestParseCAMT053(t *testing.T) {
+	tests := []struct {
+		name         string
+		data         string
+		wantErr      bool
+		wantLines    []wantLine
+		wantCurrency string
+	}{
+		{
+			name: "booked entries",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">150.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts>
+<BookgDt><Dt>2021-10-04</Dt></BookgDt><AcctSvcrRef>BANKREF1</AcctSvcrRef><AddtlNtryInf>transfer</AddtlNtryInf>
+<NtryDtls><TxDtls><Refs><EndToEndId>E2E1</EndToEndId></Refs><RmtInf><Ustrd>invoice 1001</Ustrd></RmtInf></TxDtls></NtryDtls></Ntry>
+<Ntry><Amt Ccy="EUR">20.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts>
+<BookgDt><DtTm>2021-10-05T09:30:00</DtTm></BookgDt>
+<NtryDtls><TxDtls><Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs>
+<RmtInf><Strd><CdtrRefInf><Ref>RF18539007547034</Ref></CdtrRefInf></Strd></RmtInf></TxDtls></NtryDtls></Ntry>`),
+			wantLines: []wantLine{
+				{localDate(2021, time.October, 4), "150.00", "BANKREF1", "transfer invoice 1001 E2E1"},
+				{localDate(2021, time.October, 5), "-20.00", "20211005:-20.00:2", "RF18539007547034"},
+			},
+			wantCurrency: "EUR",
+		},
+		{
+			name: "pending and information entries are skipped",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>PDNG</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>
+<Ntry><Amt Ccy="EUR">2.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>INFO</Cd></Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>
+<Ntry><Amt Ccy="EUR">3.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt>
+<NtryRef>N3</NtryRef></Ntry>`),
+			wantLines: []wantLine{
+				{localDate(2021, time.October, 4), "3.00", "N3", ""},
+			},
+			wantCurrency: "EUR",
+		},
+		{
+			name: "value date without booking date",
+			data: camtDocumentOf(`
+<Ntry><Amt>5.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><ValDt><Dt>2021-10-06</Dt></ValDt>
+<NtryDtls><TxDtls><Refs><EndToEndId>E2E5</EndToEndId></Refs></TxDtls></NtryDtls></Ntry>`),
+			wantLines: []wantLine{
+				{localDate(2021, time.October, 6), "5.00", "E2E5", "E2E5"},
+			},
+		},
+		{
+			name: "mixed currencies",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>
+<Ntry><Amt Ccy="CHF">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>`),
+			wantErr: true,
+		},
+		{
+			name: "invalid credit debit indicator",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>RVSL</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>`),
+			wantErr: true,
+		},
+		{
+			name: "invalid amount",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">1,00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2021-10-04</Dt></BookgDt></Ntry>`),
+			wantErr: true,
+		},
+		{
+			name: "missing date",
+			data: camtDocumentOf(`
+<Ntry><Amt Ccy="EUR">1.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts></Ntry>`),
+			wantErr: true,
+		},
+		{
+			name:    "no statement",
+			data:    `<Document><BkToCstmrStmt></BkToCstmrStmt></Document>`,
+			wantErr: true,
+		},
+		{
+			name:    "not xml",
+			data:    "Date,Amount\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, currency, err := parseCAMT053(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %d lines, want an error", len(lines))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			checkStatementLines(t, lines, currency, tt.wantLines, tt.wantCurrency)
+		})
+	}
+}
@@ -0,0 +1,1063 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// Returned from a database transaction when applications would exceed the amount of an open item.
+var errOverApplied = errors.New("amount exceeds the open amount of the item")
+
+// Selects open items. The organization and account are optional, a zero subledger type, party or transaction
+// matches any, and an invalid as of time counts items and applications of any date.
+type openItemFilter struct {
+	mserviceId     int64
+	organizationId []byte
+	accountId      []byte
+	subledgerType  pb.SubledgerType
+	partyId        int64
+	transactionId  int64
+	sequenceNumber int32
+	asOf           sql.NullTime
+}
+
+// create general ledger control account for receivable or payable open items
+func (s *glService) CreateControlAccount(ctx context.Context, req *pb.CreateControlAccountRequest) (*pb.CreateControlAccountResponse, error) {
+	resp := &pb.CreateControlAccountResponse{}
+
+	gResp, orgId := s.checkControlAccount(req.GetMserviceId(), req.GetGlAccountId().GetGuid(), req.GetSubledgerType())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLControlAccount (uidGlAccountId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+	inbMserviceId, uidOrganizationId, intSubledgerType) VALUES (?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetGlAccountId().GetGuid(), req.GetMserviceId(), orgId, int32(req.GetSubledgerType()))
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger control account
+func (s *glService) UpdateControlAccount(ctx context.Context, req *pb.UpdateControlAccountRequest) (*pb.UpdateControlAccountResponse, error) {
+	resp := &pb.UpdateControlAccountResponse{}
+
+	gResp, _ := s.checkControlAccount(req.GetMserviceId(), req.GetGlAccountId().GetGuid(), req.GetSubledgerType())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkNoOpenItemApplications(req.GetMserviceId(), req.GetGlAccountId().GetGuid())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLControlAccount SET dtmModified = NOW(), intVersion = ?, intSubledgerType = ?
+	WHERE uidGlAccountId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, int32(req.GetSubledgerType()), req.GetGlAccountId().GetGuid(), req.GetVersion(),
+		req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger control account
+func (s *glService) DeleteControlAccount(ctx context.Context, req *pb.DeleteControlAccountRequest) (*pb.DeleteControlAccountResponse, error) {
+	resp := &pb.DeleteControlAccountResponse{}
+
+	gResp := s.checkNoOpenItemApplications(req.GetMserviceId(), req.GetGlAccountId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLControlAccount SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidGlAccountId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetGlAccountId().GetGuid(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger control accounts for organization
+func (s *glService) GetControlAccountsByOrganization(ctx context.Context, req *pb.GetControlAccountsByOrganizationRequest) (*pb.GetControlAccountsByOrganizationResponse, error) {
+	resp := &pb.GetControlAccountsByOrganizationResponse{}
+
+	sqlstring := `SELECT c.uidGlAccountId, c.dtmCreated, c.dtmModified, c.intVersion, c.inbMserviceId, c.uidOrganizationId,
+	c.intSubledgerType, a.chvAccountName
+	FROM tb_GLControlAccount AS c
+	JOIN tb_GLAccount AS a ON c.uidGlAccountId = a.uidGlAccountId
+	WHERE c.uidOrganizationId = ? AND c.inbMserviceId = ? AND c.bitIsDeleted = 0
+	ORDER BY c.intSubledgerType, a.chvAccountName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var control pb.GLControlAccount
+		var accountId []byte
+		var created time.Time
+		var modified time.Time
+		var orgId []byte
+		var subledgerType int32
+
+		err := rows.Scan(&accountId, &created, &modified, &control.Version, &control.MserviceId, &orgId, &subledgerType,
+			&control.AccountName)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		control.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		control.Created = dml.DateTimeFromTime(created)
+		control.Modified = dml.DateTimeFromTime(modified)
+		control.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		control.SubledgerType = pb.SubledgerType(subledgerType)
+
+		resp.GlControlAccounts = append(resp.GlControlAccounts, &control)
+	}
+
+	return resp, nil
+}
+
+// get receivable or payable open items of an organization
+func (s *glService) GetOpenItems(ctx context.Context, req *pb.GetOpenItemsRequest) (*pb.GetOpenItemsResponse, error) {
+	resp := &pb.GetOpenItemsResponse{}
+
+	filter := openItemFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		subledgerType:  req.GetSubledgerType(),
+		partyId:        req.GetPartyId(),
+		asOf:           sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	if req.GetAsOfDate() != nil {
+		filter.asOf.Time = req.GetAsOfDate().TimeFromDateTime()
+	}
+
+	items, err := s.getOpenItems(&filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getOpenItems", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	for _, item := range items {
+		if req.GetIncludeClosed() || !openAmount(item).IsZero() {
+			resp.GlOpenItems = append(resp.GlOpenItems, item)
+		}
+	}
+
+	return resp, nil
+}
+
+// apply a credit open item against a charge open item, or against the oldest open charges of its party
+func (s *glService) ApplyOpenItem(ctx context.Context, req *pb.ApplyOpenItemRequest) (*pb.ApplyOpenItemResponse, error) {
+	resp := &pb.ApplyOpenItemResponse{}
+
+	var amount sdec.Decimal
+	var err error
+
+	if req.GetAmount().GetPlaintext() != "" {
+		amount, err = sdec.NewFromString(req.GetAmount().GetPlaintext())
+		if (err != nil) || !amount.IsPositive() {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "amount must be a positive decimal"
+			return resp, nil
+		}
+	}
+
+	gResp, credit := s.getOpenItem(req.GetMserviceId(), req.GetGlTransactionId(), req.GetSequenceNumber())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	creditOpen := openAmount(credit).Neg()
+	if !creditOpen.IsPositive() {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "open item is not an unapplied credit"
+		return resp, nil
+	}
+
+	var charges []*pb.GLOpenItem
+
+	if req.GetChargeTransactionId() != 0 {
+		var charge *pb.GLOpenItem
+		gResp, charge = s.getOpenItem(req.GetMserviceId(), req.GetChargeTransactionId(), req.GetChargeSequenceNumber())
+		if (gResp.ErrorCode == 0) && (!bytes.Equal(charge.GetGlAccountId().GetGuid(), credit.GetGlAccountId().GetGuid()) ||
+			(charge.GetPartyId() != credit.GetPartyId())) {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = "charge and credit must be open items of the same account and party"
+		}
+
+		if (gResp.ErrorCode == 0) && !openAmount(charge).IsPositive() {
+			gResp.ErrorCode = 501
+			gResp.ErrorMessage = "open item is not an unpaid charge"
+		}
+
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		charges = append(charges, charge)
+	} else {
+		filter := openItemFilter{
+			mserviceId: req.GetMserviceId(),
+			accountId:  credit.GetGlAccountId().GetGuid(),
+			partyId:    credit.GetPartyId(),
+		}
+
+		items, err := s.getOpenItems(&filter)
+		if err != nil {
+			level.Error(s.logger).Log("what", "getOpenItems", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		// items are in date order, so the oldest charges are paid first
+		for _, item := range items {
+			if openAmount(item).IsPositive() {
+				charges = append(charges, item)
+			}
+		}
+
+		if len(charges) == 0 {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = "party has no unpaid charges on the account"
+			return resp, nil
+		}
+	}
+
+	remaining := amount
+	if remaining.IsZero() {
+		remaining = creditOpen
+	} else if remaining.GreaterThan(creditOpen) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "amount exceeds the open amount of the credit"
+		return resp, nil
+	}
+
+	var applications []*pb.GLOpenItemApplication
+
+	for _, charge := range charges {
+		if remaining.IsZero() {
+			break
+		}
+
+		applied := sdec.Min(remaining, openAmount(charge))
+		remaining = remaining.Sub(applied)
+
+		appliedDate := credit.GetTransactionDate()
+		if charge.GetTransactionDate().GetMilliseconds() > appliedDate.GetMilliseconds() {
+			appliedDate = charge.GetTransactionDate()
+		}
+
+		application := pb.GLOpenItemApplication{
+			MserviceId:           req.GetMserviceId(),
+			OrganizationId:       credit.GetOrganizationId(),
+			GlAccountId:          credit.GetGlAccountId(),
+			PartyId:              credit.GetPartyId(),
+			ChargeTransactionId:  charge.GetGlTransactionId(),
+			ChargeSequenceNumber: charge.GetSequenceNumber(),
+			CreditTransactionId:  credit.GetGlTransactionId(),
+			CreditSequenceNumber: credit.GetSequenceNumber(),
+			Amount:               decimalFromAmount(applied),
+			AppliedDate:          appliedDate,
+		}
+
+		applications = append(applications, &application)
+	}
+
+	// an explicit amount must be applied in full
+	if !amount.IsZero() && !remaining.IsZero() {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "amount exceeds the open amount of the charges"
+		return resp, nil
+	}
+
+	gResp, applicationIds := s.commitOpenItemApplications(applications)
+	if gResp.ErrorCode == 0 {
+		args := []interface{}{req.GetMserviceId()}
+		for _, applicationId := range applicationIds {
+			args = append(args, applicationId)
+		}
+
+		gResp, resp.GlOpenItemApplications = s.getOpenItemApplications(`inbMserviceId = ? AND inbApplicationId IN (?`+
+			strings.Repeat(", ?", len(applicationIds)-1)+`)`, args...)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// delete application of a credit open item against a charge open item
+func (s *glService) DeleteOpenItemApplication(ctx context.Context, req *pb.DeleteOpenItemApplicationRequest) (*pb.DeleteOpenItemApplicationResponse, error) {
+	resp := &pb.DeleteOpenItemApplicationResponse{}
+
+	sqlstring := `UPDATE tb_GLOpenItemApplication SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE inbApplicationId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetApplicationId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get open item applications of a transaction
+func (s *glService) GetOpenItemApplications(ctx context.Context, req *pb.GetOpenItemApplicationsRequest) (*pb.GetOpenItemApplicationsResponse, error) {
+	resp := &pb.GetOpenItemApplicationsResponse{}
+
+	gResp, applications := s.getOpenItemApplications(`inbMserviceId = ? AND (inbChargeTransactionId = ? OR inbCreditTransactionId = ?)`,
+		req.GetMserviceId(), req.GetGlTransactionId(), req.GetGlTransactionId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlOpenItemApplications = applications
+
+	return resp, nil
+}
+
+// get open receivable or payable balances of parties
+func (s *glService) GetPartyBalances(ctx context.Context, req *pb.GetPartyBalancesRequest) (*pb.GetPartyBalancesResponse, error) {
+	resp := &pb.GetPartyBalancesResponse{}
+
+	filter := openItemFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		subledgerType:  req.GetSubledgerType(),
+		partyId:        req.GetPartyId(),
+		asOf:           sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	if req.GetAsOfDate() != nil {
+		filter.asOf.Time = req.GetAsOfDate().TimeFromDateTime()
+	}
+
+	items, err := s.getOpenItems(&filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getOpenItems", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	var balance *pb.GLPartyBalance
+	charges := sdec.Zero
+	credits := sdec.Zero
+
+	// items are ordered by account and party, so each balance is a run of items
+	for _, item := range items {
+		if (balance == nil) || (item.GetPartyId() != balance.GetPartyId()) ||
+			!bytes.Equal(item.GetGlAccountId().GetGuid(), balance.GetGlAccountId().GetGuid()) {
+			if balance != nil {
+				resp.GlPartyBalances = append(resp.GlPartyBalances, newPartyBalance(balance, charges, credits))
+			}
+
+			balance = &pb.GLPartyBalance{
+				PartyId:       item.GetPartyId(),
+				PartyName:     item.GetPartyName(),
+				SubledgerType: item.GetSubledgerType(),
+				GlAccountId:   item.GetGlAccountId(),
+				AccountName:   item.GetAccountName(),
+			}
+			charges = sdec.Zero
+			credits = sdec.Zero
+		}
+
+		open := openAmount(item)
+		if open.IsPositive() {
+			charges = charges.Add(open)
+		} else {
+			credits = credits.Sub(open)
+		}
+
+		if !open.IsZero() {
+			balance.OpenItemCount++
+		}
+	}
+
+	if balance != nil {
+		resp.GlPartyBalances = append(resp.GlPartyBalances, newPartyBalance(balance, charges, credits))
+	}
+
+	return resp, nil
+}
+
+// get open receivable or payable balances of parties aged by days past due
+func (s *glService) GetPartyAging(ctx context.Context, req *pb.GetPartyAgingRequest) (*pb.GetPartyAgingResponse, error) {
+	resp := &pb.GetPartyAgingResponse{}
+
+	filter := openItemFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		subledgerType:  req.GetSubledgerType(),
+		partyId:        req.GetPartyId(),
+		asOf:           sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	if req.GetAsOfDate() != nil {
+		filter.asOf.Time = req.GetAsOfDate().TimeFromDateTime()
+	}
+
+	items, err := s.getOpenItems(&filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getOpenItems", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	var aging *pb.GLPartyAging
+	var buckets [5]sdec.Decimal
+	var totals [5]sdec.Decimal
+
+	// items are ordered by account and party, so each aging is a run of items
+	for _, item := range items {
+		open := openAmount(item)
+		if open.IsZero() {
+			continue
+		}
+
+		if (aging == nil) || (item.GetPartyId() != aging.GetPartyId()) ||
+			!bytes.Equal(item.GetGlAccountId().GetGuid(), aging.GetGlAccountId().GetGuid()) {
+			if aging != nil {
+				resp.GlPartyAgings = append(resp.GlPartyAgings, newPartyAging(aging, buckets))
+			}
+
+			aging = &pb.GLPartyAging{
+				PartyId:       item.GetPartyId(),
+				PartyName:     item.GetPartyName(),
+				SubledgerType: item.GetSubledgerType(),
+				GlAccountId:   item.GetGlAccountId(),
+				AccountName:   item.GetAccountName(),
+			}
+			buckets = [5]sdec.Decimal{}
+		}
+
+		due := item.GetDueDate()
+		if req.GetByTransactionDate() {
+			due = item.GetTransactionDate()
+		}
+
+		bucket := agingBucket(filter.asOf.Time, due.TimeFromDateTime())
+		buckets[bucket] = buckets[bucket].Add(open)
+
+		// what parties owe and what is owed to them offset each other in the totals
+		if item.GetSubledgerType() == pb.SubledgerType_SUBLEDGER_TYPE_PAYABLE &&
+			(req.GetSubledgerType() == pb.SubledgerType_SUBLEDGER_TYPE_UNSPECIFIED) {
+			open = open.Neg()
+		}
+
+		totals[bucket] = totals[bucket].Add(open)
+	}
+
+	if aging != nil {
+		resp.GlPartyAgings = append(resp.GlPartyAgings, newPartyAging(aging, buckets))
+	}
+
+	resp.TotalAging = newPartyAging(&pb.GLPartyAging{SubledgerType: req.GetSubledgerType()}, totals)
+
+	return resp, nil
+}
+
+// Get the open item of a transaction detail, with applications of any date.
+func (s *glService) getOpenItem(mserviceId int64, transactionId int64, sequenceNumber int32) (*genericResponse, *pb.GLOpenItem) {
+	resp := &genericResponse{}
+
+	filter := openItemFilter{
+		mserviceId:     mserviceId,
+		transactionId:  transactionId,
+		sequenceNumber: sequenceNumber,
+	}
+
+	items, err := s.getOpenItems(&filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getOpenItems", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if len(items) == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "open item not found"
+		return resp, nil
+	}
+
+	return resp, items[0]
+}
+
+// Get the open items selected by a filter, ordered by subledger, account, party and transaction date. An open item
+// is a posted detail on a control account of a transaction naming a party: the to party for a receivable and the
+// from party for a payable, or the other party when that one is not set.
+func (s *glService) getOpenItems(filter *openItemFilter) ([]*pb.GLOpenItem, error) {
+	sqlstring := `SELECT i.uidOrganizationId, i.uidGlAccountId, a.chvAccountName, i.intSubledgerType, i.inbPartyId,
+	COALESCE(p.chvPartyName, ''), i.inbGlTransactionId, i.intSequenceNumber, i.dtmTransactionDate, i.chvTransactionDescription,
+	i.chvPostedViaKey, i.decAmount, i.bitIsDebit, i.dtmDueDate,
+	(SELECT COALESCE(SUM(x.decAmount), 0) FROM tb_GLOpenItemApplication AS x
+		WHERE x.bitIsDeleted = 0
+		AND ((x.inbChargeTransactionId = i.inbGlTransactionId AND x.intChargeSequenceNumber = i.intSequenceNumber)
+		OR (x.inbCreditTransactionId = i.inbGlTransactionId AND x.intCreditSequenceNumber = i.intSequenceNumber))
+		AND (? IS NULL OR x.dtmApplied <= ?))
+	FROM (SELECT c.uidOrganizationId, d.uidGlAccountId, c.intSubledgerType, t.inbMserviceId,
+		CASE WHEN c.intSubledgerType = ? THEN COALESCE(t.inbToPartyId, t.inbFromPartyId)
+		ELSE COALESCE(t.inbFromPartyId, t.inbToPartyId) END AS inbPartyId,
+		t.inbGlTransactionId, d.intSequenceNumber, t.dtmTransactionDate, t.chvTransactionDescription, t.chvPostedViaKey,
+		d.decAmount, d.bitIsDebit, t.dtmDueDate
+		FROM tb_GLTransactionDetail AS d
+		JOIN tb_GLTransaction AS t ON d.inbGlTransactionId = t.inbGlTransactionId
+		JOIN tb_GLControlAccount AS c ON d.uidGlAccountId = c.uidGlAccountId
+		WHERE t.inbMserviceId = ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ? AND c.bitIsDeleted = 0
+		AND (? IS NULL OR t.dtmTransactionDate <= ?)
+		AND (? IS NULL OR c.uidOrganizationId = ?) AND (? IS NULL OR c.uidGlAccountId = ?)
+		AND (? = 0 OR c.intSubledgerType = ?)
+		AND (? = 0 OR (d.inbGlTransactionId = ? AND d.intSequenceNumber = ?))) AS i
+	JOIN tb_GLAccount AS a ON i.uidGlAccountId = a.uidGlAccountId
+	LEFT JOIN tb_GLParty AS p ON p.inbMserviceId = i.inbMserviceId AND p.inbPartyId = i.inbPartyId
+	WHERE i.inbPartyId IS NOT NULL AND (? = 0 OR i.inbPartyId = ?)
+	ORDER BY i.intSubledgerType, a.chvAccountName, i.uidGlAccountId, p.chvPartyName, i.inbPartyId, i.dtmTransactionDate,
+	i.inbGlTransactionId, i.intSequenceNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(filter.asOf, filter.asOf, int32(pb.SubledgerType_SUBLEDGER_TYPE_RECEIVABLE), filter.mserviceId,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), filter.asOf, filter.asOf, filter.organizationId,
+		filter.organizationId, filter.accountId, filter.accountId, int32(filter.subledgerType), int32(filter.subledgerType),
+		filter.transactionId, filter.transactionId, filter.sequenceNumber, filter.partyId, filter.partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var items []*pb.GLOpenItem
+
+	for rows.Next() {
+		var item pb.GLOpenItem
+		var orgId []byte
+		var accountId []byte
+		var subledgerType int32
+		var trandate time.Time
+		var via_key sql.NullString
+		var amount string
+		var isDebit bool
+		var due_date sql.NullTime
+		var applied string
+
+		err := rows.Scan(&orgId, &accountId, &item.AccountName, &subledgerType, &item.PartyId, &item.PartyName, &item.GlTransactionId,
+			&item.SequenceNumber, &trandate, &item.TransactionDescription, &via_key, &amount, &isDebit, &due_date, &applied)
+		if err != nil {
+			return nil, err
+		}
+
+		item.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		item.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		item.SubledgerType = pb.SubledgerType(subledgerType)
+		item.TransactionDate = dml.DateTimeFromTime(trandate)
+		item.PostedViaKey = via_key.String
+		item.DueDate = item.TransactionDate
+		if due_date.Valid {
+			item.DueDate = dml.DateTimeFromTime(due_date.Time)
+		}
+
+		itemAmount, err := sdec.NewFromString(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		appliedAmount, err := sdec.NewFromString(applied)
+		if err != nil {
+			return nil, err
+		}
+
+		// charges are debits to a receivable and credits to a payable
+		if isDebit != (item.SubledgerType == pb.SubledgerType_SUBLEDGER_TYPE_RECEIVABLE) {
+			itemAmount = itemAmount.Neg()
+		}
+
+		open := itemAmount.Sub(appliedAmount)
+		if itemAmount.IsNegative() {
+			open = itemAmount.Add(appliedAmount)
+		}
+
+		item.Amount = decimalFromAmount(itemAmount)
+		item.AppliedAmount = decimalFromAmount(appliedAmount)
+		item.OpenAmount = decimalFromAmount(open)
+
+		items = append(items, &item)
+	}
+
+	return items, rows.Err()
+}
+
+// Get the open item applications selected by a where clause, ordered by applied date.
+func (s *glService) getOpenItemApplications(where string, args ...interface{}) (*genericResponse, []*pb.GLOpenItemApplication) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT inbApplicationId, dtmCreated, dtmModified, intVersion, inbMserviceId, uidOrganizationId, uidGlAccountId,
+	inbPartyId, inbChargeTransactionId, intChargeSequenceNumber, inbCreditTransactionId, intCreditSequenceNumber, decAmount, dtmApplied
+	FROM tb_GLOpenItemApplication WHERE bitIsDeleted = 0 AND ` + where + `
+	ORDER BY dtmApplied, inbApplicationId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var applications []*pb.GLOpenItemApplication
+
+	for rows.Next() {
+		var application pb.GLOpenItemApplication
+		var created time.Time
+		var modified time.Time
+		var orgId []byte
+		var accountId []byte
+		var amount string
+		var applied time.Time
+
+		err := rows.Scan(&application.ApplicationId, &created, &modified, &application.Version, &application.MserviceId, &orgId,
+			&accountId, &application.PartyId, &application.ChargeTransactionId, &application.ChargeSequenceNumber,
+			&application.CreditTransactionId, &application.CreditSequenceNumber, &amount, &applied)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		application.Created = dml.DateTimeFromTime(created)
+		application.Modified = dml.DateTimeFromTime(modified)
+		application.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		application.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		application.Amount = &dml.Decimal{Plaintext: amount}
+		application.AppliedDate = dml.DateTimeFromTime(applied)
+
+		applications = append(applications, &application)
+	}
+
+	return resp, applications
+}
+
+// Insert open item applications in a single database transaction, returning their identifiers. The control account
+// is locked while the open amounts are checked again, so concurrent applications cannot apply an item twice.
+func (s *glService) commitOpenItemApplications(applications []*pb.GLOpenItemApplication) (*genericResponse, []int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	var accountId []byte
+	err = tx.QueryRow(`SELECT uidGlAccountId FROM tb_GLControlAccount WHERE uidGlAccountId = ? AND bitIsDeleted = 0 FOR UPDATE`,
+		applications[0].GetGlAccountId().GetGuid()).Scan(&accountId)
+	if err == sql.ErrNoRows {
+		err = errTransactionChanged
+	}
+
+	sqlstring := `INSERT INTO tb_GLOpenItemApplication (dtmCreated, dtmModified, intVersion, inbMserviceId, uidOrganizationId,
+	uidGlAccountId, inbPartyId, inbChargeTransactionId, intChargeSequenceNumber, inbCreditTransactionId, intCreditSequenceNumber,
+	decAmount, dtmApplied, dtmDeleted, bitIsDeleted) VALUES (NOW(), NOW(), 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), 0)`
+
+	var applicationIds []int64
+
+	for _, application := range applications {
+		if err != nil {
+			break
+		}
+
+		var res sql.Result
+		res, err = tx.Exec(sqlstring, application.GetMserviceId(), application.GetOrganizationId().GetGuid(),
+			application.GetGlAccountId().GetGuid(), application.GetPartyId(), application.GetChargeTransactionId(),
+			application.GetChargeSequenceNumber(), application.GetCreditTransactionId(), application.GetCreditSequenceNumber(),
+			application.GetAmount().GetPlaintext(), application.GetAppliedDate().TimeFromDateTime())
+		if err != nil {
+			break
+		}
+
+		var applicationId int64
+		applicationId, err = res.LastInsertId()
+		if err != nil {
+			break
+		}
+
+		applicationIds = append(applicationIds, applicationId)
+
+		err = checkOpenItemApplied(tx, application.GetChargeTransactionId(), application.GetChargeSequenceNumber())
+		if err == nil {
+			err = checkOpenItemApplied(tx, application.GetCreditTransactionId(), application.GetCreditSequenceNumber())
+		}
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err), nil
+	}
+
+	return resp, applicationIds
+}
+
+// Check, as part of a database transaction, that an open item is still posted and its applications do not exceed
+// its amount.
+func checkOpenItemApplied(tx *sql.Tx, transactionId int64, sequenceNumber int32) error {
+	var amount string
+	var applied string
+
+	err := tx.QueryRow(`SELECT d.decAmount, (SELECT COALESCE(SUM(x.decAmount), 0) FROM tb_GLOpenItemApplication AS x
+		WHERE x.bitIsDeleted = 0
+		AND ((x.inbChargeTransactionId = d.inbGlTransactionId AND x.intChargeSequenceNumber = d.intSequenceNumber)
+		OR (x.inbCreditTransactionId = d.inbGlTransactionId AND x.intCreditSequenceNumber = d.intSequenceNumber)))
+	FROM tb_GLTransactionDetail AS d
+	JOIN tb_GLTransaction AS t ON d.inbGlTransactionId = t.inbGlTransactionId
+	WHERE d.inbGlTransactionId = ? AND d.intSequenceNumber = ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ?`,
+		transactionId, sequenceNumber, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED)).Scan(&amount, &applied)
+	if err == sql.ErrNoRows {
+		return errTransactionChanged
+	} else if err != nil {
+		return err
+	}
+
+	itemAmount, err := sdec.NewFromString(amount)
+	if err != nil {
+		return err
+	}
+
+	appliedAmount, err := sdec.NewFromString(applied)
+	if err != nil {
+		return err
+	}
+
+	if appliedAmount.GreaterThan(itemAmount) {
+		return errOverApplied
+	}
+
+	return nil
+}
+
+// Check that an account can be a control account of a subledger: it must exist, and when its account type is
+// classified, a receivable must be an asset and a payable a liability. Returns the organization of the account.
+func (s *glService) checkControlAccount(mserviceId int64, accountId []byte, subledgerType pb.SubledgerType) (*genericResponse, []byte) {
+	resp := &genericResponse{}
+
+	var expected pb.AccountCategory
+
+	switch subledgerType {
+	case pb.SubledgerType_SUBLEDGER_TYPE_RECEIVABLE:
+		expected = pb.AccountCategory_ACCOUNT_CATEGORY_ASSET
+	case pb.SubledgerType_SUBLEDGER_TYPE_PAYABLE:
+		expected = pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY
+	default:
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "subledger_type missing or invalid"
+		return resp, nil
+	}
+
+	var orgId []byte
+	var category int32
+
+	err := s.db.QueryRow(`SELECT a.uidOrganizationId, y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidGlAccountId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0`, accountId, mserviceId).Scan(&orgId, &category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "account not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if (pb.AccountCategory(category) != pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED) && (pb.AccountCategory(category) != expected) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "a receivable control account must be an asset account, a payable control account a liability account"
+		return resp, nil
+	}
+
+	return resp, orgId
+}
+
+// Check that no open items of a control account have been applied, as changing its subledger would strand them.
+func (s *glService) checkNoOpenItemApplications(mserviceId int64, accountId []byte) *genericResponse {
+	resp := &genericResponse{}
+
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLOpenItemApplication WHERE uidGlAccountId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`,
+		accountId, mserviceId).Scan(&count)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if count > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "control account has open item applications"
+	}
+
+	return resp
+}
+
+// Check that no open item of a transaction has been applied, before the transaction stops counting.
+func (s *glService) checkTransactionNotApplied(transactionId int64) *genericResponse {
+	resp := &genericResponse{}
+
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLOpenItemApplication WHERE (inbChargeTransactionId = ? OR inbCreditTransactionId = ?)
+	AND bitIsDeleted = 0`,
+		transactionId, transactionId).Scan(&count)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if count > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction has open item applications"
+	}
+
+	return resp
+}
+
+// Open amount of an item, with the sign of the item amount.
+func openAmount(item *pb.GLOpenItem) sdec.Decimal {
+	open, _ := sdec.NewFromString(item.GetOpenAmount().GetPlaintext())
+	return open
+}
+
+// Aging bucket of an item due on a date: current, then 1 to 30, 31 to 60, 61 to 90 and over 90 days past due.
+func agingBucket(asOf time.Time, due time.Time) int {
+	days := int(startOfDay(asOf).Sub(startOfDay(due)).Round(24*time.Hour) / (24 * time.Hour))
+
+	switch {
+	case days <= 0:
+		return 0
+	case days <= 30:
+		return 1
+	case days <= 60:
+		return 2
+	case days <= 90:
+		return 3
+	}
+
+	return 4
+}
+
+// Fill in the buckets of a party aging.
+func newPartyAging(aging *pb.GLPartyAging, buckets [5]sdec.Decimal) *pb.GLPartyAging {
+	aging.Current = decimalFromAmount(buckets[0])
+	aging.Days_1_30 = decimalFromAmount(buckets[1])
+	aging.Days_31_60 = decimalFromAmount(buckets[2])
+	aging.Days_61_90 = decimalFromAmount(buckets[3])
+	aging.DaysOver_90 = decimalFromAmount(buckets[4])
+	aging.Total = decimalFromAmount(buckets[0].Add(buckets[1]).Add(buckets[2]).Add(buckets[3]).Add(buckets[4]))
+
+	return aging
+}
+
+// Fill in the totals of a party balance.
+func newPartyBalance(balance *pb.GLPartyBalance, charges sdec.Decimal, credits sdec.Decimal) *pb.GLPartyBalance {
+	balance.OpenCharges = decimalFromAmount(charges)
+	balance.OpenCredits = decimalFromAmount(credits)
+	balance.OpenBalance = decimalFromAmount(charges.Sub(credits))
+
+	return balance
+}
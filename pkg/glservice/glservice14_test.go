@@ -0,0 +1,51 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package glservice
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgingBucket(t *testing.T) {
+	asOf := localDate(2021, time.June, 30)
+
+	tests := []struct {
+		name string
+		due  time.Time
+		want int
+	}{
+		{"due later", localDate(2021, time.July, 15), 0},
+		{"due today", asOf, 0},
+		{"due later today", asOf.Add(20 * time.Hour), 0},
+		{"one day past due", localDate(2021, time.June, 29), 1},
+		{"30 days past due", localDate(2021, time.May, 31), 1},
+		{"31 days past due", localDate(2021, time.May, 30), 2},
+		{"60 days past due", localDate(2021, time.May, 1), 2},
+		{"61 days past due", localDate(2021, time.April, 30), 3},
+		{"90 days past due", localDate(2021, time.April, 1), 3},
+		{"91 days past due", localDate(2021, time.March, 31), 4},
+		{"a year past due", localDate(2020, time.June, 30), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the time of day of the as of date does not move an item to another bucket
+			for _, at := range []time.Time{asOf, asOf.Add(23 * time.Hour)} {
+				if got := agingBucket(at, tt.due); got != tt.want {
+					t.Errorf("as of %v got bucket %d, want %d", at, got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,570 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// tax codes such as VAT20 or GST-5
+var taxCodeValidator = regexp.MustCompile("^[A-Za-z0-9_\\-\\.]{1,32}$")
+
+// create general ledger tax code
+func (s *glService) CreateTaxCode(ctx context.Context, req *pb.CreateTaxCodeRequest) (*pb.CreateTaxCodeResponse, error) {
+	resp := &pb.CreateTaxCodeResponse{}
+
+	if !taxCodeValidator.MatchString(req.GetTaxCode()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "tax_code invalid format"
+		return resp, nil
+	}
+
+	gResp := s.checkTaxCode(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTaxRate(), req.GetTaxAccountId().GetGuid(),
+		req.GetTaxType())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLTaxCode (uidOrganizationId, chvTaxCode, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+	inbMserviceId, chvDescription, decTaxRate, uidTaxAccountId, intTaxType) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetTaxCode(), req.GetMserviceId(), req.GetDescription(),
+		req.GetTaxRate().GetPlaintext(), req.GetTaxAccountId().GetGuid(), int32(req.GetTaxType()))
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger tax code
+func (s *glService) UpdateTaxCode(ctx context.Context, req *pb.UpdateTaxCodeRequest) (*pb.UpdateTaxCodeResponse, error) {
+	resp := &pb.UpdateTaxCodeResponse{}
+
+	// tax lines already generated keep the rate they were generated with
+	gResp := s.checkTaxCode(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTaxRate(), req.GetTaxAccountId().GetGuid(),
+		req.GetTaxType())
+	if gResp.ErrorCode == 0 {
+		var accountId []byte
+		var taxType int32
+
+		err := s.db.QueryRow(`SELECT uidTaxAccountId, intTaxType FROM tb_GLTaxCode
+		WHERE uidOrganizationId = ? AND chvTaxCode = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+			req.GetOrganizationId().GetGuid(), req.GetTaxCode(), req.GetMserviceId()).Scan(&accountId, &taxType)
+		if err == sql.ErrNoRows {
+			gResp.ErrorCode = 404
+			gResp.ErrorMessage = "not found"
+		} else if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			gResp.ErrorCode = 500
+			gResp.ErrorMessage = err.Error()
+		} else if !bytes.Equal(accountId, req.GetTaxAccountId().GetGuid()) || (pb.TaxType(taxType) != req.GetTaxType()) {
+			// the tax report classifies tax lines by the type of their code, and they were posted to its account
+			gResp = s.checkTaxCodeNotUsed(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTaxCode())
+		}
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLTaxCode SET dtmModified = NOW(), intVersion = ?, chvDescription = ?, decTaxRate = ?, uidTaxAccountId = ?,
+	intTaxType = ? WHERE uidOrganizationId = ? AND chvTaxCode = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), req.GetTaxRate().GetPlaintext(), req.GetTaxAccountId().GetGuid(),
+		int32(req.GetTaxType()), req.GetOrganizationId().GetGuid(), req.GetTaxCode(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger tax code
+func (s *glService) DeleteTaxCode(ctx context.Context, req *pb.DeleteTaxCodeRequest) (*pb.DeleteTaxCodeResponse, error) {
+	resp := &pb.DeleteTaxCodeResponse{}
+
+	// the tax report needs the codes of the details that carry them
+	gResp := s.checkTaxCodeNotUsed(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTaxCode())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLTaxCode SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvTaxCode = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetTaxCode(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger tax codes for organization
+func (s *glService) GetTaxCodesByOrganization(ctx context.Context, req *pb.GetTaxCodesByOrganizationRequest) (*pb.GetTaxCodesByOrganizationResponse, error) {
+	resp := &pb.GetTaxCodesByOrganizationResponse{}
+
+	gResp, taxCodes := s.getTaxCodes(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlTaxCodes = taxCodes
+
+	return resp, nil
+}
+
+// get taxable amounts and tax of an organization per tax code for a period
+func (s *glService) GetTaxReport(ctx context.Context, req *pb.GetTaxReportRequest) (*pb.GetTaxReportResponse, error) {
+	resp := &pb.GetTaxReportResponse{}
+
+	if (req.GetStartDate() == nil) || (req.GetEndDate() == nil) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "start_date and end_date required"
+		return resp, nil
+	}
+
+	gResp, taxCodes := s.getTaxCodes(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// net debit of the details of each tax code, split between taxable details and tax lines
+	sqlstring := `SELECT d.chvTaxCode, d.bitIsTaxLine, SUM(CASE WHEN d.bitIsDebit = 1 THEN d.decAmount ELSE -d.decAmount END)
+	FROM tb_GLTransaction AS t
+	JOIN tb_GLTransactionDetail AS d
+	ON t.inbGlTransactionId = d.inbGlTransactionId
+	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
+	AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ? AND d.chvTaxCode IS NOT NULL
+	GROUP BY d.chvTaxCode, d.bitIsTaxLine`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId(), startOfDay(req.GetStartDate().TimeFromDateTime()),
+		req.GetEndDate().TimeFromDateTime(), int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED))
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	taxableMap := make(map[string]sdec.Decimal)
+	taxMap := make(map[string]sdec.Decimal)
+
+	for rows.Next() {
+		var code string
+		var isTaxLine bool
+		var amount string
+
+		err := rows.Scan(&code, &isTaxLine, &amount)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		netDebit, _ := sdec.NewFromString(amount)
+		if isTaxLine {
+			taxMap[code] = netDebit
+		} else {
+			taxableMap[code] = netDebit
+		}
+	}
+
+	// payable tax is charged on sales and credited, recoverable tax is paid on purchases and debited
+	netTax := sdec.New(0, 1) // zero
+	for _, taxCode := range taxCodes {
+		taxable := taxableMap[taxCode.GetTaxCode()]
+		tax := taxMap[taxCode.GetTaxCode()]
+
+		if taxCode.GetTaxType() == pb.TaxType_TAX_TYPE_PAYABLE {
+			taxable = taxable.Neg()
+			tax = tax.Neg()
+			netTax = netTax.Add(tax)
+		} else {
+			netTax = netTax.Sub(tax)
+		}
+
+		line := pb.GLTaxReportLine{
+			TaxCode:       taxCode.GetTaxCode(),
+			Description:   taxCode.GetDescription(),
+			TaxType:       taxCode.GetTaxType(),
+			TaxRate:       taxCode.GetTaxRate(),
+			TaxableAmount: decimalFromAmount(taxable),
+			TaxAmount:     decimalFromAmount(tax),
+		}
+
+		resp.GlTaxReportLines = append(resp.GlTaxReportLines, &line)
+	}
+
+	resp.NetTax = decimalFromAmount(netTax)
+
+	return resp, nil
+}
+
+// Get the tax codes of an organization, ordered by code.
+func (s *glService) getTaxCodes(mserviceId int64, organizationId []byte) (*genericResponse, []*pb.GLTaxCode) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvTaxCode, dtmCreated, dtmModified, intVersion, inbMserviceId, chvDescription,
+	decTaxRate, uidTaxAccountId, intTaxType
+	FROM tb_GLTaxCode
+	WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY chvTaxCode`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(organizationId, mserviceId)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var taxCodes []*pb.GLTaxCode
+
+	for rows.Next() {
+		var taxCode pb.GLTaxCode
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+		var rate string
+		var accountId []byte
+		var taxType int32
+
+		err := rows.Scan(&orgId, &taxCode.TaxCode, &created, &modified, &taxCode.Version, &taxCode.MserviceId, &taxCode.Description,
+			&rate, &accountId, &taxType)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		taxCode.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		taxCode.Created = dml.DateTimeFromTime(created)
+		taxCode.Modified = dml.DateTimeFromTime(modified)
+		taxCode.TaxRate, _ = dml.DecimalFromString(rate)
+		taxCode.TaxAccountId, _ = dml.GuidFromBytes(accountId)
+		taxCode.TaxType = pb.TaxType(taxType)
+
+		taxCodes = append(taxCodes, &taxCode)
+	}
+
+	return resp, taxCodes
+}
+
+// Check the rate and tax account of a tax code. The rate is a percent from 0 to 100, and the tax account must be
+// an account of the organization; when its account type is classified, recoverable tax goes to an asset and
+// payable tax to a liability.
+func (s *glService) checkTaxCode(mserviceId int64, organizationId []byte, taxRate *dml.Decimal, accountId []byte,
+	taxType pb.TaxType) *genericResponse {
+	resp := &genericResponse{}
+
+	var expected pb.AccountCategory
+
+	switch taxType {
+	case pb.TaxType_TAX_TYPE_RECOVERABLE:
+		expected = pb.AccountCategory_ACCOUNT_CATEGORY_ASSET
+	case pb.TaxType_TAX_TYPE_PAYABLE:
+		expected = pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY
+	default:
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "tax_type missing or invalid"
+		return resp
+	}
+
+	rate, err := sdec.NewFromString(taxRate.GetPlaintext())
+	if (err != nil) || rate.IsNegative() || rate.GreaterThan(sdec.NewFromInt(100)) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "tax_rate must be a percent from 0 to 100"
+		return resp
+	}
+
+	var accountOrgId []byte
+	var category int32
+
+	err = s.db.QueryRow(`SELECT a.uidOrganizationId, y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidGlAccountId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0`, accountId, mserviceId).Scan(&accountOrgId, &category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "tax account not found"
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if !bytes.Equal(accountOrgId, organizationId) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "tax account belongs to another organization"
+		return resp
+	}
+
+	if (pb.AccountCategory(category) != pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED) && (pb.AccountCategory(category) != expected) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "a recoverable tax account must be an asset account, a payable tax account a liability account"
+	}
+
+	return resp
+}
+
+// Check that no transaction detail or recurring entry detail of the organization carries a tax code.
+func (s *glService) checkTaxCodeNotUsed(mserviceId int64, organizationId []byte, taxCode string) *genericResponse {
+	resp := &genericResponse{}
+
+	var useCount int
+	err := s.db.QueryRow(`SELECT (SELECT COUNT(*) FROM tb_GLTransaction AS t
+	JOIN tb_GLTransactionDetail AS d ON t.inbGlTransactionId = d.inbGlTransactionId
+	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND d.chvTaxCode = ?)
+	+ (SELECT COUNT(*) FROM tb_GLRecurringEntry AS e
+	JOIN tb_GLRecurringEntryDetail AS d ON e.inbRecurringEntryId = d.inbRecurringEntryId
+	WHERE e.uidOrganizationId = ? AND e.inbMserviceId = ? AND e.bitIsDeleted = 0 AND d.chvTaxCode = ?)`,
+		organizationId, mserviceId, taxCode, organizationId, mserviceId, taxCode).Scan(&useCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if useCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "tax code is used by transaction details"
+	}
+
+	return resp
+}
+
+// Generate the tax lines of details entered net of tax, with the tax codes of the organization.
+// Tax codes are only read when a detail has one.
+func (s *glService) addTaxLines(mserviceId int64, organizationId []byte,
+	details []*pb.GLTransactionDetail) (*genericResponse, []*pb.GLTransactionDetail) {
+	var taxCodeMap map[string]*pb.GLTaxCode
+
+	for _, detail := range details {
+		if detail.GetIsTaxLine() || (detail.GetTaxCode() == "") {
+			continue
+		}
+
+		gResp, taxCodes := s.getTaxCodes(mserviceId, organizationId)
+		if gResp.ErrorCode != 0 {
+			return gResp, nil
+		}
+
+		taxCodeMap = make(map[string]*pb.GLTaxCode)
+		for _, taxCode := range taxCodes {
+			taxCodeMap[taxCode.GetTaxCode()] = taxCode
+		}
+
+		break
+	}
+
+	return withTaxLines(details, taxCodeMap)
+}
+
+// Add the tax lines of details entered net of tax. A detail carrying a tax code is followed by a line on the
+// tax account of the code, on the same side, for the tax at the rate of the code; a zero rate adds no line. Tax
+// lines copy the currency and dimensions of their detail. Tax lines supplied by the client are dropped, so details
+// read back from a transaction can be submitted again. Amounts must have been converted to the base currency.
+func withTaxLines(details []*pb.GLTransactionDetail, taxCodeMap map[string]*pb.GLTaxCode) (*genericResponse, []*pb.GLTransactionDetail) {
+	resp := &genericResponse{}
+
+	var result []*pb.GLTransactionDetail
+
+	for _, detail := range details {
+		if detail.GetIsTaxLine() {
+			continue
+		}
+
+		result = append(result, detail)
+
+		code := detail.GetTaxCode()
+		if code == "" {
+			continue
+		}
+
+		taxCode, ok := taxCodeMap[code]
+		if !ok {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("tax code %s not found", code)
+			return resp, nil
+		}
+
+		rate, _ := sdec.NewFromString(taxCode.GetTaxRate().GetPlaintext())
+		if rate.IsZero() {
+			continue
+		}
+
+		rate = rate.Div(sdec.NewFromInt(100))
+		amount, _ := sdec.NewFromString(detail.GetAmount().GetPlaintext())
+
+		taxLine := pb.GLTransactionDetail{
+			GlAccountId:  taxCode.GetTaxAccountId(),
+			Amount:       decimalFromAmount(amount.Mul(rate).Round(2)),
+			IsDebit:      detail.GetIsDebit(),
+			CurrencyCode: detail.GetCurrencyCode(),
+			TaxCode:      code,
+			IsTaxLine:    true,
+		}
+
+		if detail.GetCurrencyCode() != "" {
+			foreign, _ := sdec.NewFromString(detail.GetForeignAmount().GetPlaintext())
+			taxLine.ForeignAmount = decimalFromAmount(foreign.Mul(rate).Round(2))
+		}
+
+		for _, dim := range detail.GetDimensions() {
+			taxLine.Dimensions = append(taxLine.Dimensions, proto.Clone(dim).(*pb.GLDetailDimension))
+		}
+
+		result = append(result, &taxLine)
+	}
+
+	return resp, result
+}
+
+// Get the nullable tax code column of a transaction detail.
+func detailTaxColumn(detail *pb.GLTransactionDetail) sql.NullString {
+	var taxCode sql.NullString
+
+	if detail.GetTaxCode() != "" {
+		taxCode.String = detail.GetTaxCode()
+		taxCode.Valid = true
+	}
+
+	return taxCode
+}
@@ -0,0 +1,148 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// A transaction detail on an account with an optional tax code.
+func testTaxDetail(n byte, amount string, isDebit bool, taxCode string) *pb.GLTransactionDetail {
+	return &pb.GLTransactionDetail{
+		GlAccountId: testGuid(n),
+		Amount:      testAmount(amount),
+		IsDebit:     isDebit,
+		TaxCode:     taxCode,
+	}
+}
+
+func TestWithTaxLines(t *testing.T) {
+	taxCodes := map[string]*pb.GLTaxCode{
+		"VAT20":  {TaxCode: "VAT20", TaxRate: testAmount("20"), TaxAccountId: testGuid(50)},
+		"GST5.5": {TaxCode: "GST5.5", TaxRate: testAmount("5.5"), TaxAccountId: testGuid(51)},
+		"ZERO":   {TaxCode: "ZERO", TaxRate: testAmount("0"), TaxAccountId: testGuid(52)},
+	}
+
+	type wantLine struct {
+		account byte
+		amount  string
+		isDebit bool
+		taxLine bool
+	}
+
+	foreign := testTaxDetail(3, "120.00", true, "VAT20")
+	foreign.CurrencyCode = "EUR"
+	foreign.ForeignAmount = testAmount("100.00")
+	foreign.Dimensions = testTaggedDetail("cost_center", "CC-100").GetDimensions()
+
+	tests := []struct {
+		name     string
+		details  []*pb.GLTransactionDetail
+		wantCode int32
+		want     []wantLine
+	}{
+		{
+			name: "purchase with input tax",
+			details: []*pb.GLTransactionDetail{
+				testTaxDetail(1, "100.00", true, "VAT20"),
+				testTaxDetail(2, "120.00", false, ""),
+			},
+			want: []wantLine{{1, "100.00", true, false}, {50, "20.00", true, true}, {2, "120.00", false, false}},
+		},
+		{
+			name: "tax rounded to the cent",
+			details: []*pb.GLTransactionDetail{
+				testTaxDetail(1, "10.10", false, "GST5.5"),
+			},
+			want: []wantLine{{1, "10.10", false, false}, {51, "0.56", false, true}},
+		},
+		{
+			name: "zero rate adds no line",
+			details: []*pb.GLTransactionDetail{
+				testTaxDetail(1, "100.00", true, "ZERO"),
+			},
+			want: []wantLine{{1, "100.00", true, false}},
+		},
+		{
+			name: "supplied tax lines are replaced",
+			details: []*pb.GLTransactionDetail{
+				testTaxDetail(1, "100.00", true, "VAT20"),
+				{GlAccountId: testGuid(50), Amount: testAmount("19.00"), IsDebit: true, TaxCode: "VAT20", IsTaxLine: true},
+			},
+			want: []wantLine{{1, "100.00", true, false}, {50, "20.00", true, true}},
+		},
+		{
+			name: "unknown tax code",
+			details: []*pb.GLTransactionDetail{
+				testTaxDetail(1, "100.00", true, "VAT99"),
+			},
+			wantCode: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gResp, result := withTaxLines(tt.details, taxCodes)
+			if gResp.ErrorCode != tt.wantCode {
+				t.Fatalf("error code %d %s, want %d", gResp.ErrorCode, gResp.ErrorMessage, tt.wantCode)
+			}
+
+			if len(result) != len(tt.want) {
+				t.Fatalf("got %d details, want %d", len(result), len(tt.want))
+			}
+
+			for i, detail := range result {
+				want := tt.want[i]
+				if detail.GetGlAccountId().GetGuid()[15] != want.account {
+					t.Errorf("detail %d account %d, want %d", i, detail.GetGlAccountId().GetGuid()[15], want.account)
+				}
+
+				if detail.GetAmount().GetPlaintext() != want.amount {
+					t.Errorf("detail %d amount %s, want %s", i, detail.GetAmount().GetPlaintext(), want.amount)
+				}
+
+				if detail.GetIsDebit() != want.isDebit {
+					t.Errorf("detail %d debit %t, want %t", i, detail.GetIsDebit(), want.isDebit)
+				}
+
+				if detail.GetIsTaxLine() != want.taxLine {
+					t.Errorf("detail %d tax line %t, want %t", i, detail.GetIsTaxLine(), want.taxLine)
+				}
+			}
+		})
+	}
+
+	t.Run("tax line copies currency and dimensions", func(t *testing.T) {
+		_, result := withTaxLines([]*pb.GLTransactionDetail{foreign}, taxCodes)
+		if len(result) != 2 {
+			t.Fatalf("got %d details, want 2", len(result))
+		}
+
+		taxLine := result[1]
+		if (taxLine.GetCurrencyCode() != "EUR") || (taxLine.GetForeignAmount().GetPlaintext() != "20.00") {
+			t.Errorf("tax line currency %s foreign amount %s, want EUR 20.00", taxLine.GetCurrencyCode(),
+				taxLine.GetForeignAmount().GetPlaintext())
+		}
+
+		if (len(taxLine.GetDimensions()) != 1) || (taxLine.GetDimensions()[0].GetDimensionValue() != "CC-100") {
+			t.Fatalf("tax line dimensions %v, want cost_center CC-100", taxLine.GetDimensions())
+		}
+
+		if taxLine.GetDimensions()[0] == foreign.GetDimensions()[0] {
+			t.Errorf("tax line shares its dimension with the detail")
+		}
+	})
+}
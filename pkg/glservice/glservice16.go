@@ -0,0 +1,994 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+var fixedAssetColumns = `inbFixedAssetId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, chvAssetName, chvDescription, dtmAcquisitionDate, decAcquisitionCost, decSalvageValue, intUsefulLifeMonths,
+	intDepreciationMethod, decDecliningFactor, uidAssetAccountId, uidAccumulatedAccountId, uidExpenseAccountId,
+	decAccumulatedDepreciation, dtmDepreciatedThrough, intAssetStatus, dtmDisposalDate, decDisposalProceeds, inbDisposalTransactionId`
+
+// create general ledger fixed asset
+func (s *glService) CreateFixedAsset(ctx context.Context, req *pb.CreateFixedAssetRequest) (*pb.CreateFixedAssetResponse, error) {
+	resp := &pb.CreateFixedAssetResponse{}
+
+	asset := pb.GLFixedAsset{
+		MserviceId:           req.GetMserviceId(),
+		OrganizationId:       req.GetOrganizationId(),
+		AssetName:            req.GetAssetName(),
+		Description:          req.GetDescription(),
+		AcquisitionDate:      req.GetAcquisitionDate(),
+		AcquisitionCost:      req.GetAcquisitionCost(),
+		SalvageValue:         req.GetSalvageValue(),
+		UsefulLifeMonths:     req.GetUsefulLifeMonths(),
+		DepreciationMethod:   req.GetDepreciationMethod(),
+		DecliningFactor:      req.GetDecliningFactor(),
+		AssetAccountId:       req.GetAssetAccountId(),
+		AccumulatedAccountId: req.GetAccumulatedAccountId(),
+		ExpenseAccountId:     req.GetExpenseAccountId(),
+	}
+
+	gResp := s.checkFixedAsset(&asset)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLFixedAsset (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, chvAssetName, chvDescription, dtmAcquisitionDate, decAcquisitionCost, decSalvageValue, intUsefulLifeMonths,
+	intDepreciationMethod, decDecliningFactor, uidAssetAccountId, uidAccumulatedAccountId, uidExpenseAccountId,
+	decAccumulatedDepreciation, intAssetStatus)
+	VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), asset.GetAssetName(), asset.GetDescription(),
+		asset.GetAcquisitionDate().TimeFromDateTime(), asset.GetAcquisitionCost().GetPlaintext(), asset.GetSalvageValue().GetPlaintext(),
+		asset.GetUsefulLifeMonths(), int32(asset.GetDepreciationMethod()), asset.GetDecliningFactor().GetPlaintext(),
+		asset.GetAssetAccountId().GetGuid(), asset.GetAccumulatedAccountId().GetGuid(), asset.GetExpenseAccountId().GetGuid(),
+		int32(pb.AssetStatus_ASSET_STATUS_ACTIVE))
+
+	if err == nil {
+		assetId, err := res.LastInsertId()
+		if err != nil {
+			level.Error(s.logger).Log("what", "LastInsertId", "error", err)
+		} else {
+			resp.FixedAssetId = assetId
+		}
+
+		resp.Version = 1
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger fixed asset
+func (s *glService) UpdateFixedAsset(ctx context.Context, req *pb.UpdateFixedAssetRequest) (*pb.UpdateFixedAssetResponse, error) {
+	resp := &pb.UpdateFixedAssetResponse{}
+
+	gResp, asset := s.GetFixedAssetHelper(req.GetFixedAssetId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if asset.GetAssetStatus() == pb.AssetStatus_ASSET_STATUS_DISPOSED {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fixed asset has been disposed of"
+		return resp, nil
+	}
+
+	// once depreciation is posted, only estimates may change and the change applies from then on
+	if asset.GetDepreciatedThrough() != nil {
+		if !assetDecimal(req.GetAcquisitionCost()).Equal(assetDecimal(asset.GetAcquisitionCost())) ||
+			(req.GetAcquisitionDate() == nil) ||
+			!startOfDay(req.GetAcquisitionDate().TimeFromDateTime()).Equal(startOfDay(asset.GetAcquisitionDate().TimeFromDateTime())) ||
+			!bytes.Equal(req.GetAssetAccountId().GetGuid(), asset.GetAssetAccountId().GetGuid()) ||
+			!bytes.Equal(req.GetAccumulatedAccountId().GetGuid(), asset.GetAccumulatedAccountId().GetGuid()) ||
+			!bytes.Equal(req.GetExpenseAccountId().GetGuid(), asset.GetExpenseAccountId().GetGuid()) {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = "acquisition and accounts of a fixed asset cannot change once depreciation is posted"
+			return resp, nil
+		}
+	}
+
+	asset.AssetName = req.GetAssetName()
+	asset.Description = req.GetDescription()
+	asset.AcquisitionDate = req.GetAcquisitionDate()
+	asset.AcquisitionCost = req.GetAcquisitionCost()
+	asset.SalvageValue = req.GetSalvageValue()
+	asset.UsefulLifeMonths = req.GetUsefulLifeMonths()
+	asset.DepreciationMethod = req.GetDepreciationMethod()
+	asset.DecliningFactor = req.GetDecliningFactor()
+	asset.AssetAccountId = req.GetAssetAccountId()
+	asset.AccumulatedAccountId = req.GetAccumulatedAccountId()
+	asset.ExpenseAccountId = req.GetExpenseAccountId()
+
+	gResp = s.checkFixedAsset(asset)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLFixedAsset SET dtmModified = NOW(), intVersion = ?, chvAssetName = ?, chvDescription = ?,
+	dtmAcquisitionDate = ?, decAcquisitionCost = ?, decSalvageValue = ?, intUsefulLifeMonths = ?, intDepreciationMethod = ?,
+	decDecliningFactor = ?, uidAssetAccountId = ?, uidAccumulatedAccountId = ?, uidExpenseAccountId = ?, intAssetStatus = ?
+	WHERE inbFixedAssetId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intAssetStatus <> ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, asset.GetAssetName(), asset.GetDescription(), asset.GetAcquisitionDate().TimeFromDateTime(),
+		asset.GetAcquisitionCost().GetPlaintext(), asset.GetSalvageValue().GetPlaintext(), asset.GetUsefulLifeMonths(),
+		int32(asset.GetDepreciationMethod()), asset.GetDecliningFactor().GetPlaintext(), asset.GetAssetAccountId().GetGuid(),
+		asset.GetAccumulatedAccountId().GetGuid(), asset.GetExpenseAccountId().GetGuid(), int32(depreciationStatus(asset)),
+		req.GetFixedAssetId(), req.GetVersion(), req.GetMserviceId(), int32(pb.AssetStatus_ASSET_STATUS_DISPOSED))
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger fixed asset
+func (s *glService) DeleteFixedAsset(ctx context.Context, req *pb.DeleteFixedAssetRequest) (*pb.DeleteFixedAssetResponse, error) {
+	resp := &pb.DeleteFixedAssetResponse{}
+
+	// posted depreciation would be left without its asset
+	sqlstring := `UPDATE tb_GLFixedAsset SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ?
+	WHERE inbFixedAssetId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND dtmDepreciatedThrough IS NULL`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetFixedAssetId(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found or depreciation posted"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger fixed asset by id
+func (s *glService) GetFixedAssetById(ctx context.Context, req *pb.GetFixedAssetByIdRequest) (*pb.GetFixedAssetByIdResponse, error) {
+	resp := &pb.GetFixedAssetByIdResponse{}
+
+	gResp, asset := s.GetFixedAssetHelper(req.GetFixedAssetId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlFixedAsset = asset
+
+	return resp, nil
+}
+
+// get general ledger fixed assets for organization
+func (s *glService) GetFixedAssetsByOrganization(ctx context.Context, req *pb.GetFixedAssetsByOrganizationRequest) (*pb.GetFixedAssetsByOrganizationResponse, error) {
+	resp := &pb.GetFixedAssetsByOrganizationResponse{}
+
+	gResp, assets := s.getFixedAssets(`uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND (? = 0 OR intAssetStatus = ?)`,
+		req.GetOrganizationId().GetGuid(), req.GetMserviceId(), int32(req.GetAssetStatus()), int32(req.GetAssetStatus()))
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlFixedAssets = assets
+
+	return resp, nil
+}
+
+// post the depreciation of the fixed assets of an organization for a fiscal period
+func (s *glService) RunDepreciation(ctx context.Context, req *pb.RunDepreciationRequest) (*pb.RunDepreciationResponse, error) {
+	resp := &pb.RunDepreciationResponse{}
+
+	orgId := req.GetOrganizationId().GetGuid()
+
+	var periodName string
+	var startDate time.Time
+	var endDate time.Time
+
+	err := s.db.QueryRow(`SELECT chvPeriodName, dtmStartDate, dtmEndDate FROM tb_GLFiscalPeriod
+	WHERE uidOrganizationId = ? AND intFiscalYear = ? AND intPeriodNumber = ? AND inbMserviceId = ?`,
+		orgId, req.GetFiscalYear(), req.GetPeriodNumber(), req.GetMserviceId()).Scan(&periodName, &startDate, &endDate)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "fiscal period not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	// the transaction is dated on the last day of the period
+	gResp := s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, endDate)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// assets already depreciated through the period are left alone, so a period can be run again for new assets
+	gResp, assets := s.getFixedAssets(`uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intAssetStatus = ?
+	AND dtmAcquisitionDate <= ? AND (dtmDepreciatedThrough IS NULL OR dtmDepreciatedThrough < ?)`,
+		orgId, req.GetMserviceId(), int32(pb.AssetStatus_ASSET_STATUS_ACTIVE), endDate, endDate)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var depreciated []*pb.GLFixedAsset
+	var entries []*pb.GLDepreciationEntry
+	var details []*pb.GLTransactionDetail
+
+	for _, asset := range assets {
+		amount := assetDepreciation(asset, endDate)
+		if amount.IsZero() {
+			continue
+		}
+
+		details = append(details, newAssetDetail(asset.GetExpenseAccountId(), amount, true),
+			newAssetDetail(asset.GetAccumulatedAccountId(), amount, false))
+		entries = append(entries, newDepreciationEntry(asset, amount, endDate))
+
+		addDepreciation(asset, amount, endDate)
+		depreciated = append(depreciated, asset)
+	}
+
+	if len(depreciated) == 0 {
+		return resp, nil
+	}
+
+	description := req.GetTransactionDescription()
+	if description == "" {
+		description = fmt.Sprintf("depreciation %s", periodName)
+	}
+
+	tranReq := pb.PostJournalEntryRequest{
+		MserviceId:             req.GetMserviceId(),
+		OrganizationId:         req.GetOrganizationId(),
+		TransactionDate:        dml.DateTimeFromTime(endDate),
+		TransactionDescription: description,
+		TransactionTypeId:      req.GetTransactionTypeId(),
+		GlTransactionDetails:   details,
+	}
+
+	gResp = s.checkTransactionType(req.GetMserviceId(), req.GetTransactionTypeId())
+	if gResp.ErrorCode == 0 {
+		gResp, tranReq.GlTransactionDetails = s.prepareTransactionDetails(req.GetMserviceId(), orgId, endDate, details)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, transactionId := s.commitFixedAssetTransaction(depreciated, entries, &tranReq)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlTransactionId = transactionId
+	resp.GlDepreciationEntries = entries
+
+	return resp, nil
+}
+
+// dispose of a general ledger fixed asset, posting its depreciation to date and the gain or loss
+func (s *glService) DisposeFixedAsset(ctx context.Context, req *pb.DisposeFixedAssetRequest) (*pb.DisposeFixedAssetResponse, error) {
+	resp := &pb.DisposeFixedAssetResponse{}
+
+	gResp, asset := s.GetFixedAssetHelper(req.GetFixedAssetId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if asset.GetVersion() != req.GetVersion() {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	if asset.GetAssetStatus() == pb.AssetStatus_ASSET_STATUS_DISPOSED {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fixed asset has been disposed of"
+		return resp, nil
+	}
+
+	if req.GetDisposalDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "disposal_date missing"
+		return resp, nil
+	}
+
+	disposalDate := startOfDay(req.GetDisposalDate().TimeFromDateTime())
+
+	if disposalDate.Before(startOfDay(asset.GetAcquisitionDate().TimeFromDateTime())) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "disposal_date before acquisition_date"
+		return resp, nil
+	}
+
+	if (asset.GetDepreciatedThrough() != nil) && asset.GetDepreciatedThrough().TimeFromDateTime().After(disposalDate) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fixed asset has depreciation posted after disposal_date"
+		return resp, nil
+	}
+
+	proceeds := sdec.New(0, 1) // zero
+	if req.GetDisposalProceeds().GetPlaintext() != "" {
+		var err error
+		proceeds, err = sdec.NewFromString(req.GetDisposalProceeds().GetPlaintext())
+		if (err != nil) || proceeds.IsNegative() {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "disposal_proceeds invalid"
+			return resp, nil
+		}
+	}
+
+	if proceeds.IsPositive() && (req.GetProceedsAccountId() == nil) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "proceeds_account_id missing"
+		return resp, nil
+	}
+
+	if req.GetGainLossAccountId() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "gain_loss_account_id missing"
+		return resp, nil
+	}
+
+	orgId := asset.GetOrganizationId().GetGuid()
+
+	gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, disposalDate)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var entries []*pb.GLDepreciationEntry
+	var details []*pb.GLTransactionDetail
+
+	// depreciation since the last run is posted with the disposal
+	amount := assetDepreciation(asset, disposalDate)
+	if !amount.IsZero() {
+		details = append(details, newAssetDetail(asset.GetExpenseAccountId(), amount, true),
+			newAssetDetail(asset.GetAccumulatedAccountId(), amount, false))
+		entries = append(entries, newDepreciationEntry(asset, amount, disposalDate))
+		addDepreciation(asset, amount, disposalDate)
+	}
+
+	// take the asset and its accumulated depreciation off the books against the proceeds
+	cost := assetDecimal(asset.GetAcquisitionCost())
+	accumulated := assetDecimal(asset.GetAccumulatedDepreciation())
+
+	if !accumulated.IsZero() {
+		details = append(details, newAssetDetail(asset.GetAccumulatedAccountId(), accumulated, true))
+	}
+
+	if !proceeds.IsZero() {
+		details = append(details, newAssetDetail(req.GetProceedsAccountId(), proceeds, true))
+	}
+
+	details = append(details, newAssetDetail(asset.GetAssetAccountId(), cost, false))
+
+	gainLoss := proceeds.Sub(cost.Sub(accumulated))
+	if gainLoss.IsPositive() {
+		details = append(details, newAssetDetail(req.GetGainLossAccountId(), gainLoss, false))
+	} else if gainLoss.IsNegative() {
+		details = append(details, newAssetDetail(req.GetGainLossAccountId(), gainLoss.Neg(), true))
+	}
+
+	asset.AssetStatus = pb.AssetStatus_ASSET_STATUS_DISPOSED
+	asset.DisposalDate = dml.DateTimeFromTime(disposalDate)
+	asset.DisposalProceeds = decimalFromAmount(proceeds)
+
+	tranReq := pb.PostJournalEntryRequest{
+		MserviceId:             req.GetMserviceId(),
+		OrganizationId:         asset.GetOrganizationId(),
+		TransactionDate:        asset.GetDisposalDate(),
+		TransactionDescription: truncateString(fmt.Sprintf("disposal of %s", asset.GetAssetName()), 255),
+		TransactionTypeId:      req.GetTransactionTypeId(),
+		GlTransactionDetails:   details,
+	}
+
+	gResp = s.checkTransactionType(req.GetMserviceId(), req.GetTransactionTypeId())
+	if gResp.ErrorCode == 0 {
+		gResp, tranReq.GlTransactionDetails = s.prepareTransactionDetails(req.GetMserviceId(), orgId, disposalDate, details)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, transactionId := s.commitFixedAssetTransaction([]*pb.GLFixedAsset{asset}, entries, &tranReq)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+	resp.GlTransactionId = transactionId
+	resp.GainLoss = decimalFromAmount(gainLoss)
+
+	return resp, nil
+}
+
+// get depreciation posted for a general ledger fixed asset
+func (s *glService) GetDepreciationEntries(ctx context.Context, req *pb.GetDepreciationEntriesRequest) (*pb.GetDepreciationEntriesResponse, error) {
+	resp := &pb.GetDepreciationEntriesResponse{}
+
+	sqlstring := `SELECT e.inbFixedAssetId, e.dtmDepreciationDate, e.dtmCreated, e.decAmount, e.inbGlTransactionId, a.chvAssetName
+	FROM tb_GLDepreciationEntry AS e
+	JOIN tb_GLFixedAsset AS a ON e.inbFixedAssetId = a.inbFixedAssetId
+	WHERE e.inbFixedAssetId = ? AND a.inbMserviceId = ?
+	ORDER BY e.dtmDepreciationDate`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetFixedAssetId(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry pb.GLDepreciationEntry
+		var depreciationDate time.Time
+		var created time.Time
+		var amount string
+
+		err := rows.Scan(&entry.FixedAssetId, &depreciationDate, &created, &amount, &entry.GlTransactionId, &entry.AssetName)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		entry.DepreciationDate = dml.DateTimeFromTime(depreciationDate)
+		entry.Created = dml.DateTimeFromTime(created)
+		entry.Amount, _ = dml.DecimalFromString(amount)
+
+		resp.GlDepreciationEntries = append(resp.GlDepreciationEntries, &entry)
+	}
+
+	return resp, nil
+}
+
+// Get a fixed asset that has not been deleted.
+func (s *glService) GetFixedAssetHelper(assetId int64, mserviceId int64) (*genericResponse, *pb.GLFixedAsset) {
+	gResp, assets := s.getFixedAssets(`inbFixedAssetId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`, assetId, mserviceId)
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	if len(assets) == 0 {
+		gResp.ErrorCode = 404
+		gResp.ErrorMessage = "not found"
+		return gResp, nil
+	}
+
+	return gResp, assets[0]
+}
+
+// Get the fixed assets selected by a where clause, ordered by acquisition date.
+func (s *glService) getFixedAssets(where string, args ...interface{}) (*genericResponse, []*pb.GLFixedAsset) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT ` + fixedAssetColumns + `
+	FROM tb_GLFixedAsset WHERE ` + where + `
+	ORDER BY dtmAcquisitionDate, inbFixedAssetId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var assets []*pb.GLFixedAsset
+
+	for rows.Next() {
+		var asset pb.GLFixedAsset
+		var created time.Time
+		var modified time.Time
+		var deleted time.Time
+		var orgId []byte
+		var acquisitionDate time.Time
+		var cost string
+		var salvage string
+		var method int32
+		var factor string
+		var assetAccountId []byte
+		var accumulatedAccountId []byte
+		var expenseAccountId []byte
+		var accumulated string
+		var depreciatedThrough sql.NullTime
+		var status int32
+		var disposalDate sql.NullTime
+		var proceeds sql.NullString
+		var disposalTransactionId sql.NullInt64
+
+		err := rows.Scan(&asset.FixedAssetId, &created, &modified, &deleted, &asset.IsDeleted, &asset.Version, &asset.MserviceId,
+			&orgId, &asset.AssetName, &asset.Description, &acquisitionDate, &cost, &salvage, &asset.UsefulLifeMonths, &method,
+			&factor, &assetAccountId, &accumulatedAccountId, &expenseAccountId, &accumulated, &depreciatedThrough, &status,
+			&disposalDate, &proceeds, &disposalTransactionId)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		asset.Created = dml.DateTimeFromTime(created)
+		asset.Modified = dml.DateTimeFromTime(modified)
+		if asset.IsDeleted {
+			asset.Deleted = dml.DateTimeFromTime(deleted)
+		}
+		asset.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		asset.AcquisitionDate = dml.DateTimeFromTime(acquisitionDate)
+		asset.AcquisitionCost, _ = dml.DecimalFromString(cost)
+		asset.SalvageValue, _ = dml.DecimalFromString(salvage)
+		asset.DepreciationMethod = pb.DepreciationMethod(method)
+		asset.DecliningFactor, _ = dml.DecimalFromString(factor)
+		asset.AssetAccountId, _ = dml.GuidFromBytes(assetAccountId)
+		asset.AccumulatedAccountId, _ = dml.GuidFromBytes(accumulatedAccountId)
+		asset.ExpenseAccountId, _ = dml.GuidFromBytes(expenseAccountId)
+		asset.AccumulatedDepreciation, _ = dml.DecimalFromString(accumulated)
+		if depreciatedThrough.Valid {
+			asset.DepreciatedThrough = dml.DateTimeFromTime(depreciatedThrough.Time)
+		}
+		asset.AssetStatus = pb.AssetStatus(status)
+		if disposalDate.Valid {
+			asset.DisposalDate = dml.DateTimeFromTime(disposalDate.Time)
+		}
+		if proceeds.Valid {
+			asset.DisposalProceeds, _ = dml.DecimalFromString(proceeds.String)
+		}
+		if disposalTransactionId.Valid {
+			asset.DisposalTransactionId = disposalTransactionId.Int64
+		}
+		asset.BookValue = decimalFromAmount(assetDecimal(asset.GetAcquisitionCost()).Sub(assetDecimal(asset.GetAccumulatedDepreciation())))
+
+		assets = append(assets, &asset)
+	}
+
+	return resp, assets
+}
+
+// Post a fixed asset transaction, record its depreciation entries and store the new depreciation and disposal
+// values of its assets, in a single database transaction. The version check on each asset keeps two runs from
+// depreciating it twice; a disposed asset is linked to the new transaction.
+func (s *glService) commitFixedAssetTransaction(assets []*pb.GLFixedAsset, entries []*pb.GLDepreciationEntry,
+	req *pb.PostJournalEntryRequest) (*genericResponse, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	transactionId, err := insertJournalEntry(tx, req)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	sqlstring1 := `UPDATE tb_GLFixedAsset SET dtmModified = NOW(), intVersion = ?, decAccumulatedDepreciation = ?,
+	dtmDepreciatedThrough = ?, intAssetStatus = ?, dtmDisposalDate = ?, decDisposalProceeds = ?, inbDisposalTransactionId = ?
+	WHERE inbFixedAssetId = ? AND intVersion = ? AND bitIsDeleted = 0`
+
+	for _, asset := range assets {
+		var disposalDate sql.NullTime
+		var proceeds sql.NullString
+		var disposalId sql.NullInt64
+
+		if asset.GetAssetStatus() == pb.AssetStatus_ASSET_STATUS_DISPOSED {
+			disposalDate.Time = asset.GetDisposalDate().TimeFromDateTime()
+			disposalDate.Valid = true
+			proceeds.String = asset.GetDisposalProceeds().GetPlaintext()
+			proceeds.Valid = true
+			disposalId.Int64 = transactionId
+			disposalId.Valid = true
+		}
+
+		res, err := tx.Exec(sqlstring1, asset.GetVersion()+1, asset.GetAccumulatedDepreciation().GetPlaintext(),
+			asset.GetDepreciatedThrough().TimeFromDateTime(), int32(asset.GetAssetStatus()), disposalDate, proceeds, disposalId,
+			asset.GetFixedAssetId(), asset.GetVersion())
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, 0
+		}
+
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected != 1 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("fixed asset %d not found", asset.GetFixedAssetId())
+			return resp, 0
+		}
+	}
+
+	sqlstring2 := `INSERT INTO tb_GLDepreciationEntry (inbFixedAssetId, dtmDepreciationDate, dtmCreated, decAmount, inbGlTransactionId)
+	VALUES (?, ?, NOW(), ?, ?)`
+
+	for _, entry := range entries {
+		_, err := tx.Exec(sqlstring2, entry.GetFixedAssetId(), entry.GetDepreciationDate().TimeFromDateTime(),
+			entry.GetAmount().GetPlaintext(), transactionId)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, 0
+		}
+
+		entry.GlTransactionId = transactionId
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	return resp, transactionId
+}
+
+// Check that a transaction type exists, before any transaction of the type is committed.
+func (s *glService) checkTransactionType(mserviceId int64, typeId int32) *genericResponse {
+	resp := &genericResponse{}
+
+	var found int32
+	err := s.db.QueryRow(`SELECT intTransactionTypeId FROM tb_GLTransactionType WHERE intTransactionTypeId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		typeId, mserviceId).Scan(&found)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "transaction type not found"
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
+
+// Check the fields of a fixed asset and fill in defaults: no salvage value, and a declining balance at twice the
+// straight line rate. The three accounts must be accounts of the organization of the asset.
+func (s *glService) checkFixedAsset(asset *pb.GLFixedAsset) *genericResponse {
+	resp := &genericResponse{}
+
+	if (asset.GetAssetName() == "") || (len(asset.GetAssetName()) > 64) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "asset_name missing or too long"
+		return resp
+	}
+
+	if asset.GetAcquisitionDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "acquisition_date missing"
+		return resp
+	}
+
+	cost, err := sdec.NewFromString(asset.GetAcquisitionCost().GetPlaintext())
+	if (err != nil) || !cost.IsPositive() {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "acquisition_cost missing or invalid"
+		return resp
+	}
+
+	if asset.GetSalvageValue().GetPlaintext() == "" {
+		asset.SalvageValue = decimalFromAmount(sdec.New(0, 1))
+	}
+
+	salvage, err := sdec.NewFromString(asset.GetSalvageValue().GetPlaintext())
+	if (err != nil) || salvage.IsNegative() || salvage.GreaterThan(cost) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "salvage_value must be from 0 to acquisition_cost"
+		return resp
+	}
+
+	if asset.GetUsefulLifeMonths() <= 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "useful_life_months missing or invalid"
+		return resp
+	}
+
+	switch asset.GetDepreciationMethod() {
+	case pb.DepreciationMethod_DEPRECIATION_METHOD_STRAIGHT_LINE, pb.DepreciationMethod_DEPRECIATION_METHOD_DECLINING_BALANCE:
+	default:
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "depreciation_method missing or invalid"
+		return resp
+	}
+
+	if asset.GetDecliningFactor().GetPlaintext() == "" {
+		asset.DecliningFactor = &dml.Decimal{Plaintext: "2"}
+	}
+
+	factor, err := sdec.NewFromString(asset.GetDecliningFactor().GetPlaintext())
+	if (err != nil) || !factor.IsPositive() {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "declining_factor invalid"
+		return resp
+	}
+
+	sqlstring := `SELECT uidOrganizationId FROM tb_GLAccount WHERE uidGlAccountId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	for _, accountId := range []*dml.Guid{asset.GetAssetAccountId(), asset.GetAccumulatedAccountId(), asset.GetExpenseAccountId()} {
+		var accountOrgId []byte
+
+		err = stmt.QueryRow(accountId.GetGuid(), asset.GetMserviceId()).Scan(&accountOrgId)
+		if err == sql.ErrNoRows {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "account not found"
+			return resp
+		} else if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		if !bytes.Equal(accountOrgId, asset.GetOrganizationId().GetGuid()) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("account %x belongs to another organization", accountId.GetGuid())
+			return resp
+		}
+	}
+
+	return resp
+}
+
+// Depreciation of a fixed asset from the day after it was last depreciated through, or from its acquisition
+// date, up to and including a date. Straight line spreads the cost less salvage value evenly over the days of the
+// useful life; declining balance takes the factor times the straight line rate of the book value per year. What
+// is left above the salvage value is taken once the useful life has ended.
+func assetDepreciation(asset *pb.GLFixedAsset, through time.Time) sdec.Decimal {
+	zero := sdec.New(0, 1)
+
+	cost := assetDecimal(asset.GetAcquisitionCost())
+	depreciable := cost.Sub(assetDecimal(asset.GetSalvageValue()))
+	accumulated := assetDecimal(asset.GetAccumulatedDepreciation())
+	remaining := depreciable.Sub(accumulated)
+	if !remaining.IsPositive() {
+		return zero
+	}
+
+	acquired := startOfDay(asset.GetAcquisitionDate().TimeFromDateTime())
+	endOfLife := acquired.AddDate(0, int(asset.GetUsefulLifeMonths()), 0)
+
+	from := acquired
+	if asset.GetDepreciatedThrough() != nil {
+		from = startOfDay(asset.GetDepreciatedThrough().TimeFromDateTime()).AddDate(0, 0, 1)
+	}
+
+	to := startOfDay(through).AddDate(0, 0, 1)
+	if !to.After(from) {
+		return zero
+	}
+
+	if !to.Before(endOfLife) {
+		return remaining
+	}
+
+	var amount sdec.Decimal
+
+	if asset.GetDepreciationMethod() == pb.DepreciationMethod_DEPRECIATION_METHOD_DECLINING_BALANCE {
+		annualRate := assetDecimal(asset.GetDecliningFactor()).Mul(sdec.NewFromInt(12)).Div(sdec.NewFromInt(int64(asset.GetUsefulLifeMonths())))
+		amount = cost.Sub(accumulated).Mul(annualRate).Mul(sdec.NewFromInt(daysBetween(from, to))).Div(sdec.NewFromInt(365)).Round(2)
+	} else {
+		amount = depreciable.Mul(sdec.NewFromInt(daysBetween(acquired, to))).Div(sdec.NewFromInt(daysBetween(acquired, endOfLife))).Round(2)
+		amount = amount.Sub(accumulated)
+	}
+
+	if amount.IsNegative() {
+		return zero
+	}
+
+	if amount.GreaterThan(remaining) {
+		return remaining
+	}
+
+	return amount
+}
+
+// Add depreciation through a date to a fixed asset.
+func addDepreciation(asset *pb.GLFixedAsset, amount sdec.Decimal, through time.Time) {
+	asset.AccumulatedDepreciation = decimalFromAmount(assetDecimal(asset.GetAccumulatedDepreciation()).Add(amount))
+	asset.DepreciatedThrough = dml.DateTimeFromTime(through)
+	asset.AssetStatus = depreciationStatus(asset)
+	asset.BookValue = decimalFromAmount(assetDecimal(asset.GetAcquisitionCost()).Sub(assetDecimal(asset.GetAccumulatedDepreciation())))
+}
+
+// Status of an asset in use: fully depreciated once depreciated down to its salvage value, else active.
+func depreciationStatus(asset *pb.GLFixedAsset) pb.AssetStatus {
+	depreciable := assetDecimal(asset.GetAcquisitionCost()).Sub(assetDecimal(asset.GetSalvageValue()))
+	if assetDecimal(asset.GetAccumulatedDepreciation()).GreaterThanOrEqual(depreciable) {
+		return pb.AssetStatus_ASSET_STATUS_FULLY_DEPRECIATED
+	}
+
+	return pb.AssetStatus_ASSET_STATUS_ACTIVE
+}
+
+// Build a depreciation entry of a fixed asset, its transaction is filled in when committed.
+func newDepreciationEntry(asset *pb.GLFixedAsset, amount sdec.Decimal, through time.Time) *pb.GLDepreciationEntry {
+	return &pb.GLDepreciationEntry{
+		FixedAssetId:     asset.GetFixedAssetId(),
+		DepreciationDate: dml.DateTimeFromTime(through),
+		Amount:           decimalFromAmount(amount),
+		AssetName:        asset.GetAssetName(),
+	}
+}
+
+// Build a transaction detail of a fixed asset transaction.
+func newAssetDetail(accountId *dml.Guid, amount sdec.Decimal, isDebit bool) *pb.GLTransactionDetail {
+	return &pb.GLTransactionDetail{
+		GlAccountId: accountId,
+		Amount:      decimalFromAmount(amount),
+		IsDebit:     isDebit,
+	}
+}
+
+// Value of a decimal field of a fixed asset, zero when unset.
+func assetDecimal(d *dml.Decimal) sdec.Decimal {
+	value, err := sdec.NewFromString(d.GetPlaintext())
+	if err != nil {
+		return sdec.New(0, 1)
+	}
+
+	return value
+}
+
+// Whole days from one start of day to another, across daylight saving changes.
+func daysBetween(from time.Time, to time.Time) int64 {
+	return int64(math.Round(to.Sub(from).Hours() / 24))
+}
@@ -0,0 +1,150 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// A fixed asset acquired on the first of January 2021, depreciated through a date when through is set.
+func testAsset(method pb.DepreciationMethod, cost string, salvage string, lifeMonths int32, factor string,
+	accumulated string, through *time.Time) *pb.GLFixedAsset {
+	asset := &pb.GLFixedAsset{
+		AcquisitionDate:         dml.DateTimeFromTime(localDate(2021, time.January, 1)),
+		AcquisitionCost:         &dml.Decimal{Plaintext: cost},
+		SalvageValue:            &dml.Decimal{Plaintext: salvage},
+		UsefulLifeMonths:        lifeMonths,
+		DepreciationMethod:      method,
+		DecliningFactor:         &dml.Decimal{Plaintext: factor},
+		AccumulatedDepreciation: &dml.Decimal{Plaintext: accumulated},
+	}
+
+	if through != nil {
+		asset.DepreciatedThrough = dml.DateTimeFromTime(*through)
+	}
+
+	return asset
+}
+
+func TestAssetDepreciation(t *testing.T) {
+	straightLine := pb.DepreciationMethod_DEPRECIATION_METHOD_STRAIGHT_LINE
+	decliningBalance := pb.DepreciationMethod_DEPRECIATION_METHOD_DECLINING_BALANCE
+	endOfJanuary := localDate(2021, time.January, 31)
+	endOf2024 := localDate(2024, time.December, 31)
+
+	tests := []struct {
+		name    string
+		asset   *pb.GLFixedAsset
+		through time.Time
+		want    string
+	}{
+		{
+			name:    "straight line first month",
+			asset:   testAsset(straightLine, "12000", "0", 12, "", "0", nil),
+			through: localDate(2021, time.January, 31),
+			want:    "1019.18", // 12000 * 31 / 365
+		},
+		{
+			name:    "straight line second month",
+			asset:   testAsset(straightLine, "12000", "0", 12, "", "1019.18", &endOfJanuary),
+			through: localDate(2021, time.February, 28),
+			want:    "920.55", // 12000 * 59 / 365 less the first month
+		},
+		{
+			name:    "straight line with salvage value",
+			asset:   testAsset(straightLine, "12000", "2000", 12, "", "0", nil),
+			through: localDate(2021, time.January, 31),
+			want:    "849.32", // 10000 * 31 / 365
+		},
+		{
+			name:    "already depreciated through the date",
+			asset:   testAsset(straightLine, "12000", "0", 12, "", "1019.18", &endOfJanuary),
+			through: localDate(2021, time.January, 31),
+			want:    "0.00",
+		},
+		{
+			name:    "through before acquisition",
+			asset:   testAsset(straightLine, "12000", "0", 12, "", "0", nil),
+			through: localDate(2020, time.December, 31),
+			want:    "0.00",
+		},
+		{
+			name:    "remainder after the useful life",
+			asset:   testAsset(straightLine, "12000", "2000", 12, "", "9000", &endOfJanuary),
+			through: localDate(2022, time.June, 30),
+			want:    "1000.00",
+		},
+		{
+			name:    "fully depreciated",
+			asset:   testAsset(straightLine, "12000", "2000", 12, "", "10000", &endOfJanuary),
+			through: localDate(2022, time.June, 30),
+			want:    "0.00",
+		},
+		{
+			name:    "declining balance first year",
+			asset:   testAsset(decliningBalance, "10000", "1000", 60, "2", "0", nil),
+			through: localDate(2021, time.December, 31),
+			want:    "4000.00", // 10000 * 2 * 12 / 60
+		},
+		{
+			name:    "declining balance stops at salvage value",
+			asset:   testAsset(decliningBalance, "10000", "1000", 60, "2", "8500", &endOf2024),
+			through: localDate(2025, time.November, 30),
+			want:    "500.00", // 1500 * 0.4 * 334 / 365 is more than is left
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := assetDepreciation(tt.asset, tt.through)
+			if got.StringFixed(2) != tt.want {
+				t.Errorf("got %s, want %s", got.StringFixed(2), tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	// an hour is lost when clocks go forward in the night of the 28th of March 2021
+	winter := time.FixedZone("CET", 3600)
+	summer := time.FixedZone("CEST", 7200)
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want int64
+	}{
+		{"same day", localDate(2021, time.March, 1), localDate(2021, time.March, 1), 0},
+		{"one day", localDate(2021, time.March, 1), localDate(2021, time.March, 2), 1},
+		{"common year", localDate(2021, time.January, 1), localDate(2022, time.January, 1), 365},
+		{"leap year", localDate(2020, time.January, 1), localDate(2021, time.January, 1), 366},
+		{"backwards", localDate(2021, time.March, 2), localDate(2021, time.March, 1), -1},
+		{"clocks forward", time.Date(2021, time.March, 27, 0, 0, 0, 0, winter), time.Date(2021, time.March, 29, 0, 0, 0, 0, summer), 2},
+		{"clocks back", time.Date(2021, time.October, 30, 0, 0, 0, 0, summer), time.Date(2021, time.November, 1, 0, 0, 0, 0, winter), 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := daysBetween(tt.from, tt.to); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
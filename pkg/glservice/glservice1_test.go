@@ -0,0 +1,96 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+func TestValidateAccountClassification(t *testing.T) {
+	debit := pb.NormalBalance_NORMAL_BALANCE_DEBIT
+	credit := pb.NormalBalance_NORMAL_BALANCE_CREDIT
+	unspecified := pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED
+
+	tests := []struct {
+		name          string
+		category      pb.AccountCategory
+		normalBalance pb.NormalBalance
+		want          pb.NormalBalance
+		wantCode      int32
+	}{
+		{
+			name:          "asset defaults to debit",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_ASSET,
+			normalBalance: unspecified,
+			want:          debit,
+		},
+		{
+			name:          "liability defaults to credit",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY,
+			normalBalance: unspecified,
+			want:          credit,
+		},
+		{
+			name:          "contra asset defaults to credit",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_ASSET,
+			normalBalance: unspecified,
+			want:          credit,
+		},
+		{
+			name:          "matching side",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE,
+			normalBalance: debit,
+			want:          debit,
+		},
+		{
+			name:          "side opposite to the category",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE,
+			normalBalance: debit,
+			wantCode:      510,
+		},
+		{
+			name:          "category missing",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED,
+			normalBalance: debit,
+			wantCode:      510,
+		},
+		{
+			name:          "category out of range",
+			category:      pb.AccountCategory(99),
+			normalBalance: unspecified,
+			wantCode:      510,
+		},
+		{
+			name:          "normal balance out of range",
+			category:      pb.AccountCategory_ACCOUNT_CATEGORY_EQUITY,
+			normalBalance: pb.NormalBalance(7),
+			wantCode:      510,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gResp := validateAccountClassification(tt.category, tt.normalBalance)
+			if gResp.ErrorCode != tt.wantCode {
+				t.Fatalf("error code %d %s, want %d", gResp.ErrorCode, gResp.ErrorMessage, tt.wantCode)
+			}
+
+			if (tt.wantCode == 0) && (got != tt.want) {
+				t.Errorf("normal balance %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
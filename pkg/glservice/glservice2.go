@@ -432,9 +432,31 @@ func (s *glService) GetPartiesByMservice(ctx context.Context, req *pb.GetParties
 func (s *glService) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
 	resp := &pb.CreateAccountResponse{}
 
+	gResp := s.checkAccountCode(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetAccountCode(), req.GetAccountTypeId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var parentId []byte
+	if len(req.GetParentAccountId().GetGuid()) > 0 {
+		parentId = req.GetParentAccountId().GetGuid()
+		gResp = s.checkParentAccount(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), nil, parentId, req.GetAccountTypeId())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+	}
+
+	var code sql.NullString
+	code.String = req.GetAccountCode()
+	code.Valid = code.String != ""
+
 	sqlstring := `INSERT INTO tb_GLAccount (uidGlAccountId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, 
-		inbMserviceId, uidOrganizationId, chvAccountName, chvAccountDescription, intAccountTypeId) 
-		VALUES (?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?)`
+		inbMserviceId, uidOrganizationId, chvAccountName, chvAccountDescription, intAccountTypeId, uidParentAccountId,
+		chvAccountCode) VALUES (?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?)`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -450,7 +472,8 @@ func (s *glService) CreateAccount(ctx context.Context, req *pb.CreateAccountRequ
 
 	guid := req.GetOrganizationId()
 
-	res, err := stmt.Exec(glId.Guid, req.GetMserviceId(), guid.Guid, req.GetAccountName(), req.GetAccountDescription(), req.GetAccountTypeId())
+	res, err := stmt.Exec(glId.Guid, req.GetMserviceId(), guid.Guid, req.GetAccountName(), req.GetAccountDescription(), req.GetAccountTypeId(),
+		parentId, code)
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
@@ -475,8 +498,43 @@ func (s *glService) CreateAccount(ctx context.Context, req *pb.CreateAccountRequ
 func (s *glService) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
 	resp := &pb.UpdateAccountResponse{}
 
+	var orgId []byte
+	err := s.db.QueryRow(`SELECT uidOrganizationId FROM tb_GLAccount WHERE inbMserviceId = ? AND uidGlAccountId = ? AND bitIsDeleted = 0`,
+		req.GetMserviceId(), req.GetGlAccountId().GetGuid()).Scan(&orgId)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	// the new parent and the current children must stay in the same category as the account
+	var parentId []byte
+	if len(req.GetParentAccountId().GetGuid()) > 0 {
+		parentId = req.GetParentAccountId().GetGuid()
+	}
+
+	gResp := s.checkParentAccount(req.GetMserviceId(), orgId, req.GetGlAccountId().GetGuid(), parentId, req.GetAccountTypeId())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkAccountCode(req.GetMserviceId(), orgId, req.GetAccountCode(), req.GetAccountTypeId())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var code sql.NullString
+	code.String = req.GetAccountCode()
+	code.Valid = code.String != ""
+
 	sqlstring := `UPDATE tb_GLAccount SET dtmModified = NOW(), intVersion = ?, chvAccountName = ?, chvAccountDescription = ?, 
-	intAccountTypeId = ? WHERE inbMserviceId = ? AND uidGlAccountId = ? AND intVersion = ? AND bitIsDeleted = 0`
+	intAccountTypeId = ?, uidParentAccountId = ?, chvAccountCode = ? WHERE inbMserviceId = ? AND uidGlAccountId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -488,8 +546,8 @@ func (s *glService) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequ
 
 	defer stmt.Close()
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetAccountName(), req.GetAccountDescription(), req.GetAccountTypeId(),
-		req.GetMserviceId(), req.GetGlAccountId().Guid, req.GetVersion())
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetAccountName(), req.GetAccountDescription(), req.GetAccountTypeId(), parentId,
+		code, req.GetMserviceId(), req.GetGlAccountId().Guid, req.GetVersion())
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
@@ -513,6 +571,23 @@ func (s *glService) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequ
 func (s *glService) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
 	resp := &pb.DeleteAccountResponse{}
 
+	// an account with children would leave them pointing at a deleted parent
+	var children int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLAccount WHERE inbMserviceId = ? AND uidParentAccountId = ? AND bitIsDeleted = 0`,
+		req.GetMserviceId(), req.GetGlAccountId().GetGuid()).Scan(&children)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if children > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "account has child accounts"
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_GLAccount SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ? 
 	WHERE inbMserviceId = ? AND uidGlAccountId = ? AND intVersion = ? AND bitIsDeleted = 0`
 
@@ -552,7 +627,7 @@ func (s *glService) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRe
 
 	sqlstring := `SELECT a.uidGlAccountId, a.dtmCreated, a.dtmModified, a.intVersion, 
 	a.inbMserviceId, a.uidOrganizationId, a.chvAccountName, a.chvAccountDescription, a.intAccountTypeId,
-	o.chvOrganizationName, t.chvAccountType
+	o.chvOrganizationName, t.chvAccountType, a.uidParentAccountId, a.chvAccountCode
 	FROM tb_GLAccount AS a
 	JOIN tb_GLOrganization AS o
 	ON a.uidOrganizationId = o.uidOrganizationId
@@ -572,17 +647,23 @@ func (s *glService) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRe
 
 	var acctGid []byte
 	var orgGid []byte
+	var parentGid []byte
+	var code sql.NullString
 	var created time.Time
 	var modified time.Time
 	var acct pb.GLAccount
 
 	err = stmt.QueryRow(req.GetMserviceId(), req.GlAccountId.Guid).Scan(&acctGid, &created, &modified, &acct.Version,
 		&acct.MserviceId, &orgGid, &acct.AccountName, &acct.AccountDescription, &acct.AccountTypeId,
-		&acct.OrganizationName, &acct.AccountType)
+		&acct.OrganizationName, &acct.AccountType, &parentGid, &code)
 
 	if err == nil {
 		acct.GlAccountId, _ = dml.GuidFromBytes(acctGid)
 		acct.OrganizationId, _ = dml.GuidFromBytes(orgGid)
+		if parentGid != nil {
+			acct.ParentAccountId, _ = dml.GuidFromBytes(parentGid)
+		}
+		acct.AccountCode = code.String
 		acct.Created = dml.DateTimeFromTime(created)
 		acct.Modified = dml.DateTimeFromTime(modified)
 		resp.GlAccount = &acct
@@ -604,15 +685,61 @@ func (s *glService) GetAccountById(ctx context.Context, req *pb.GetAccountByIdRe
 func (s *glService) GetAccountsByOrganization(ctx context.Context, req *pb.GetAccountsByOrganizationRequest) (*pb.GetAccountsByOrganizationResponse, error) {
 	resp := &pb.GetAccountsByOrganizationResponse{}
 
+	gResp, accounts := s.getOrganizationAccounts(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	if gResp.ErrorCode == 0 {
+		resp.GlAccounts = accounts
+	} else {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get general ledger accounts of organization as a tree
+func (s *glService) GetAccountTree(ctx context.Context, req *pb.GetAccountTreeRequest) (*pb.GetAccountTreeResponse, error) {
+	resp := &pb.GetAccountTreeResponse{}
+
+	gResp, accounts := s.getOrganizationAccounts(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	nodes := make(map[string]*pb.GLAccountTreeNode)
+	for _, acct := range accounts {
+		nodes[string(acct.GetGlAccountId().GetGuid())] = &pb.GLAccountTreeNode{GlAccount: acct}
+	}
+
+	// accounts come back ordered by code, so children are too
+	for _, acct := range accounts {
+		node := nodes[string(acct.GetGlAccountId().GetGuid())]
+		parent, ok := nodes[string(acct.GetParentAccountId().GetGuid())]
+		if ok {
+			parent.ChildNodes = append(parent.ChildNodes, node)
+		} else {
+			resp.GlAccountTreeNodes = append(resp.GlAccountTreeNodes, node)
+		}
+	}
+
+	return resp, nil
+}
+
+// Get all accounts of an organization, ordered by account code then name.
+func (s *glService) getOrganizationAccounts(mserviceId int64, organizationId []byte) (*genericResponse, []*pb.GLAccount) {
+	resp := &genericResponse{}
+
 	sqlstring := `SELECT a.uidGlAccountId, a.dtmCreated, a.dtmModified, a.intVersion, 
 	a.inbMserviceId, a.uidOrganizationId, a.chvAccountName, a.chvAccountDescription, a.intAccountTypeId,
-	o.chvOrganizationName, t.chvAccountType
+	o.chvOrganizationName, t.chvAccountType, a.uidParentAccountId, a.chvAccountCode
 	FROM tb_GLAccount AS a
 	JOIN tb_GLOrganization AS o
 	ON a.uidOrganizationId = o.uidOrganizationId AND a.inbMserviceId = o.inbMserviceId
 	JOIN tb_GLAccountType AS t
     ON a.inbMserviceId = t.inbMserviceId AND a.intAccountTypeId = t.intAccountTypeId
-	WHERE a.inbMserviceId = ? AND a.uidOrganizationId = ? AND a.bitIsDeleted = 0 AND o.bitIsDeleted = 0`
+	WHERE a.inbMserviceId = ? AND a.uidOrganizationId = ? AND a.bitIsDeleted = 0 AND o.bitIsDeleted = 0
+	ORDER BY ` + accountCodeOrder + `, a.chvAccountName`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -624,7 +751,7 @@ func (s *glService) GetAccountsByOrganization(ctx context.Context, req *pb.GetAc
 
 	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetMserviceId(), req.GetOrganizationId().Guid)
+	rows, err := stmt.Query(mserviceId, organizationId)
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
 		resp.ErrorCode = 500
@@ -633,16 +760,21 @@ func (s *glService) GetAccountsByOrganization(ctx context.Context, req *pb.GetAc
 	}
 
 	defer rows.Close()
+
+	var accounts []*pb.GLAccount
+
 	for rows.Next() {
 		var acctGid []byte
 		var orgGid []byte
+		var parentGid []byte
+		var code sql.NullString
 		var created time.Time
 		var modified time.Time
 		var acct pb.GLAccount
 
 		err := rows.Scan(&acctGid, &created, &modified, &acct.Version,
 			&acct.MserviceId, &orgGid, &acct.AccountName, &acct.AccountDescription, &acct.AccountTypeId,
-			&acct.OrganizationName, &acct.AccountType)
+			&acct.OrganizationName, &acct.AccountType, &parentGid, &code)
 
 		if err != nil {
 			level.Error(s.logger).Log("what", "Scan", "error", err)
@@ -655,10 +787,128 @@ func (s *glService) GetAccountsByOrganization(ctx context.Context, req *pb.GetAc
 		var oid dml.Guid
 		oid.Guid = orgGid
 		acct.OrganizationId = &oid
+		if parentGid != nil {
+			acct.ParentAccountId, _ = dml.GuidFromBytes(parentGid)
+		}
+		acct.AccountCode = code.String
 		acct.Created = dml.DateTimeFromTime(created)
 		acct.Modified = dml.DateTimeFromTime(modified)
-		resp.GlAccounts = append(resp.GlAccounts, &acct)
+		accounts = append(accounts, &acct)
 	}
 
-	return resp, nil
+	return resp, accounts
+}
+
+// Check that an account may be placed under a parent account: the parent must be an account of the same organization
+// in the same category, and must not be the account itself or one of its descendants. When accountId is set, the
+// existing children of the account must be in the same category as its account type.
+func (s *glService) checkParentAccount(mserviceId int64, organizationId []byte, accountId []byte, parentId []byte,
+	accountTypeId int32) *genericResponse {
+	resp := &genericResponse{}
+
+	var category int32
+	err := s.db.QueryRow(`SELECT intAccountCategory FROM tb_GLAccountType WHERE inbMserviceId = ? AND intAccountTypeId = ?`,
+		mserviceId, accountTypeId).Scan(&category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "account type not found"
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	sqlstring := `SELECT a.uidGlAccountId, a.uidParentAccountId, y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.inbMserviceId = ? AND a.uidOrganizationId = ? AND a.bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(mserviceId, organizationId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer rows.Close()
+
+	parents := make(map[string]string)
+	categories := make(map[string]pb.AccountCategory)
+
+	for rows.Next() {
+		var acctGid []byte
+		var parentGid []byte
+		var acctCategory int32
+
+		err := rows.Scan(&acctGid, &parentGid, &acctCategory)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		if parentGid != nil {
+			parents[string(acctGid)] = string(parentGid)
+		}
+		categories[string(acctGid)] = pb.AccountCategory(acctCategory)
+	}
+
+	base := baseCategory(pb.AccountCategory(category))
+
+	if parentId != nil {
+		parentCategory, ok := categories[string(parentId)]
+		if !ok {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "parent account not found"
+			return resp
+		}
+
+		if baseCategory(parentCategory) != base {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "parent account must be in the same account category"
+			return resp
+		}
+
+		// walk up from the new parent, which must not lead back to the account
+		id := string(parentId)
+		for steps := 0; steps <= len(categories); steps++ {
+			if id == string(accountId) {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = "parent account would form a cycle"
+				return resp
+			}
+
+			next, ok := parents[id]
+			if !ok {
+				break
+			}
+			id = next
+		}
+	}
+
+	if accountId != nil {
+		for child, parent := range parents {
+			if (parent == string(accountId)) && (baseCategory(categories[child]) != base) {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = "child accounts must be in the same account category"
+				return resp
+			}
+		}
+	}
+
+	return resp
 }
@@ -14,8 +14,10 @@
 package glservice
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -28,6 +30,8 @@ import (
 	pb "github.com/gaterace/mledger/pkg/mserviceledger"
 
 	sdec "github.com/shopspring/decimal"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // Generic response to set specific API method response.
@@ -36,13 +40,35 @@ type genericResponse struct {
 	ErrorMessage string
 }
 
+// Error of a versioned update within a database transaction that found no row at the expected version and status.
+var errTransactionChanged = errors.New("not found")
+
 // create general ledger transaction
 func (s *glService) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionResponse, error) {
 	resp := &pb.CreateTransactionResponse{}
 
+	// make sure the transaction date is in an open fiscal period
+	gResp := s.checkPeriodOpen(ctx, req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTransactionDate().TimeFromDateTime())
+	if gResp.ErrorCode == 0 {
+		gResp = checkDueDate(req.GetTransactionDate(), req.GetDueDate())
+	}
+
+	var reverse_date sql.NullTime
+	if gResp.ErrorCode == 0 {
+		gResp, reverse_date = s.checkAutoReverse(req.GetMserviceId(), req.GetOrganizationId().GetGuid(),
+			req.GetTransactionDate().TimeFromDateTime(), req.GetAutoReverseMode(), req.GetAutoReverseDate())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
 	sqlstring := `INSERT INTO tb_GLTransaction (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
 	uidOrganizationId, dtmTransactionDate, chvTransactionDescription, intTransactionTypeId, inbFromPartyId, inbToPartyId,
-	chvPostedViaKey, dtmPostedViaDate) VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	chvPostedViaKey, dtmPostedViaDate, bitIsClosingEntry, intTransactionStatus, intAutoReverseMode, dtmAutoReverseDate, dtmDueDate)
+	VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, ?, ?, ?, ?)`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -79,7 +105,8 @@ func (s *glService) CreateTransaction(ctx context.Context, req *pb.CreateTransac
 		via_date.Valid = true
 	}
 
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetOrganizationId().Guid, req.GetTransactionDate().TimeFromDateTime(), req.GetTransactionDescription(), req.GetTransactionTypeId(), &from_party, &to_party, &via_key, &via_date)
+	res, err := stmt.Exec(req.GetMserviceId(), req.GetOrganizationId().Guid, req.GetTransactionDate().TimeFromDateTime(), req.GetTransactionDescription(), req.GetTransactionTypeId(), &from_party, &to_party, &via_key, &via_date,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT), int32(req.GetAutoReverseMode()), &reverse_date, dueDate(req.GetDueDate()))
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
@@ -111,9 +138,40 @@ func (s *glService) CreateTransaction(ctx context.Context, req *pb.CreateTransac
 func (s *glService) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.UpdateTransactionResponse, error) {
 	resp := &pb.UpdateTransactionResponse{}
 
+	// make sure both the current and new transaction dates are in open fiscal periods
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = checkTransactionDraft(tran.GetTransactionStatus())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetTransactionDate().TimeFromDateTime())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), req.GetTransactionDate().TimeFromDateTime())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp = checkDueDate(req.GetTransactionDate(), req.GetDueDate())
+	}
+
+	var reverse_date sql.NullTime
+	if gResp.ErrorCode == 0 {
+		gResp, reverse_date = s.checkAutoReverse(req.GetMserviceId(), tran.GetOrganizationId().GetGuid(),
+			req.GetTransactionDate().TimeFromDateTime(), req.GetAutoReverseMode(), req.GetAutoReverseDate())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
 	sqlstring := `UPDATE tb_GLTransaction SET dtmModified = NOW(), intVersion = ?, dtmTransactionDate = ?, chvTransactionDescription= ?,
-	intTransactionTypeId = ?, inbFromPartyId = ?, inbToPartyId = ?, chvPostedViaKey = ?, dtmPostedViaDate = ?
-	WHERE  inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	intTransactionTypeId = ?, inbFromPartyId = ?, inbToPartyId = ?, chvPostedViaKey = ?, dtmPostedViaDate = ?, intAutoReverseMode = ?,
+	dtmAutoReverseDate = ?, dtmDueDate = ?
+	WHERE  inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -151,7 +209,9 @@ func (s *glService) UpdateTransaction(ctx context.Context, req *pb.UpdateTransac
 	}
 
 	res, err := stmt.Exec(req.GetVersion()+1, req.GetTransactionDate().TimeFromDateTime(), req.GetTransactionDescription(), req.GetTransactionTypeId(), &from_party,
-		&to_party, &via_key, &via_date, req.GetGlTransactionId(), req.GetVersion(), req.GetMserviceId())
+		&to_party, &via_key, &via_date, int32(req.GetAutoReverseMode()), &reverse_date, dueDate(req.GetDueDate()), req.GetGlTransactionId(),
+		req.GetVersion(), req.GetMserviceId(),
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT))
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
@@ -175,29 +235,61 @@ func (s *glService) UpdateTransaction(ctx context.Context, req *pb.UpdateTransac
 func (s *glService) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.DeleteTransactionResponse, error) {
 	resp := &pb.DeleteTransactionResponse{}
 
-	sqlstring := `UPDATE tb_GLTransaction SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ? 
-	WHERE inbGlTransactionId = ? AND  intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	// only a draft may be deleted, a posted transaction is reversed or voided
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = checkTransactionDraft(tran.GetTransactionStatus())
+	}
 
-	stmt, err := s.db.Prepare(sqlstring)
+	// make sure the transaction date is in an open fiscal period
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetTransactionDate().TimeFromDateTime())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// the details of a draft are removed along with it
+	tx, err := s.db.Begin()
 	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		level.Error(s.logger).Log("what", "Begin", "error", err)
 		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		resp.ErrorMessage = err.Error()
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetGlTransactionId(), req.GetVersion(), req.GetMserviceId())
+	sqlstring := `UPDATE tb_GLTransaction SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ? 
+	WHERE inbGlTransactionId = ? AND  intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`
+
+	res, err := tx.Exec(sqlstring, req.GetVersion()+1, req.GetGlTransactionId(), req.GetVersion(), req.GetMserviceId(),
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT))
 
 	if err == nil {
 		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
+		if rowsAffected != 1 {
 			resp.ErrorCode = 404
 			resp.ErrorMessage = "not found"
+			return resp, nil
 		}
+
+		_, err = tx.Exec(`DELETE FROM tb_GLTransactionDetailDimension WHERE inbGlTransactionId = ?`, req.GetGlTransactionId())
+	}
+
+	if err == nil {
+		_, err = tx.Exec(`DELETE FROM tb_GLTransactionDetail WHERE inbGlTransactionId = ?`, req.GetGlTransactionId())
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
 	} else {
 		resp.ErrorCode = 501
 		resp.ErrorMessage = err.Error()
@@ -227,66 +319,14 @@ func (s *glService) GetTransactionById(ctx context.Context, req *pb.GetTransacti
 func (s *glService) GetTransactionWrapperById(ctx context.Context, req *pb.GetTransactionWrapperByIdRequest) (*pb.GetTransactionWrapperByIdResponse, error) {
 	resp := &pb.GetTransactionWrapperByIdResponse{}
 
-	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
-	if gResp.ErrorCode != 0 {
+	gResp, wrap := s.GetTransactionWrapperHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		resp.GlTransactionWrapper = wrap
+	} else {
 		resp.ErrorCode = gResp.ErrorCode
 		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	wrap := ConvertTransactionToWrapper(tran)
-
-	sqlstring := `SELECT d.inbGlTransactionId, d.intSequenceNumber, d.uidGlAccountId, d.decAmount, d.bitIsDebit
-	FROM tb_GLTransactionDetail AS d
-	WHERE d.inbGlTransactionId = ?
-	ORDER BY d.intSequenceNumber`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	rows, err := stmt.Query(req.GetGlTransactionId())
-
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	defer rows.Close()
-
-	for rows.Next() {
-		var gid []byte
-		var amount string
-		var detail pb.GLTransactionDetail
-
-		err := rows.Scan(&detail.GlTransactionId, &detail.SequenceNumber, &gid, &amount, &detail.IsDebit)
-
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
-
-		detail.GlAccountId, _ = dml.GuidFromBytes(gid)
-		amt, err := dml.DecimalFromString(amount)
-		if err == nil {
-			detail.Amount = amt
-		}
-
-		wrap.GlTransactionDetails = append(wrap.GlTransactionDetails, &detail)
-
-	}
-
-	resp.GlTransactionWrapper = wrap
-
 	return resp, nil
 }
 
@@ -296,12 +336,14 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 
 	sqlstring := `SELECT t.inbGlTransactionId, t.dtmCreated, t.dtmModified, t.intVersion, t.inbMserviceId, t.uidOrganizationId, 
 	t.dtmTransactionDate, t.chvTransactionDescription, t.intTransactionTypeId, t.inbFromPartyId, t.inbToPartyId, t.chvPostedViaKey, 
-	t.dtmPostedViaDate, y.chvTransactionType
+	t.dtmPostedViaDate, t.bitIsClosingEntry, t.intTransactionStatus, t.dtmPosted, t.dtmVoided, t.inbReversesTransactionId,
+	t.inbReversedByTransactionId, t.intAutoReverseMode, t.dtmAutoReverseDate, t.inbIntercompanyTransactionId, t.dtmDueDate,
+	y.chvTransactionType
 	FROM tb_GLTransaction AS t
 	JOIN tb_GLTransactionType AS y
 	ON t.intTransactionTypeId = y.intTransactionTypeId
 	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
-	AND t.bitIsDeleted = 0 AND y.bitIsDeleted = 0`
+	AND t.bitIsDeleted = 0 AND y.bitIsDeleted = 0 AND (? = 0 OR t.intTransactionStatus = ?)`
 
 	stmt, err := s.db.Prepare(sqlstring)
 	if err != nil {
@@ -316,7 +358,9 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 	start_date := req.GetStartDate().TimeFromDateTime()
 	end_date := req.GetEndDate().TimeFromDateTime()
 
-	rows, err := stmt.Query(req.GetOrganizationId().Guid, req.GetMserviceId(), start_date, end_date)
+	status_filter := int32(req.GetTransactionStatus())
+
+	rows, err := stmt.Query(req.GetOrganizationId().Guid, req.GetMserviceId(), start_date, end_date, status_filter, status_filter)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -334,6 +378,15 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 		var to_party sql.NullInt64
 		var via_key sql.NullString
 		var via_date sql.NullTime
+		var status int32
+		var posted sql.NullTime
+		var voided sql.NullTime
+		var reverses sql.NullInt64
+		var reversed_by sql.NullInt64
+		var reverse_mode int32
+		var reverse_date sql.NullTime
+		var intercompany sql.NullInt64
+		var due_date sql.NullTime
 		var tran pb.GLTransaction
 		var created time.Time
 		var modified time.Time
@@ -342,7 +395,8 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 
 		err := rows.Scan(&tran.GlTransactionId, &created, &modified, &tran.Version,
 			&tran.MserviceId, &orgGid, &trandate, &tran.TransactionDescription, &tran.TransactionTypeId, &from_party, &to_party, &via_key,
-			&via_date, &tran.TransactionType)
+			&via_date, &tran.IsClosingEntry, &status, &posted, &voided, &reverses, &reversed_by, &reverse_mode, &reverse_date,
+			&intercompany, &due_date, &tran.TransactionType)
 
 		if err != nil {
 			level.Error(s.logger).Log("what", "Scan", "error", err)
@@ -370,6 +424,29 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 		if via_date.Valid {
 			tran.PostedViaDate = dml.DateTimeFromTime(via_date.Time)
 		}
+		tran.TransactionStatus = pb.TransactionStatus(status)
+		if posted.Valid {
+			tran.Posted = dml.DateTimeFromTime(posted.Time)
+		}
+		if voided.Valid {
+			tran.Voided = dml.DateTimeFromTime(voided.Time)
+		}
+		if reverses.Valid {
+			tran.ReversesTransactionId = reverses.Int64
+		}
+		if reversed_by.Valid {
+			tran.ReversedByTransactionId = reversed_by.Int64
+		}
+		tran.AutoReverseMode = pb.AutoReverseMode(reverse_mode)
+		if reverse_date.Valid {
+			tran.AutoReverseDate = dml.DateTimeFromTime(reverse_date.Time)
+		}
+		if intercompany.Valid {
+			tran.IntercompanyTransactionId = intercompany.Int64
+		}
+		if due_date.Valid {
+			tran.DueDate = dml.DateTimeFromTime(due_date.Time)
+		}
 
 		wrap := ConvertTransactionToWrapper(&tran)
 		wrapMap[wrap.GetGlTransactionId()] = wrap
@@ -378,12 +455,13 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 	}
 
 	// now get the details
-	sqlstring2 := `SELECT d.inbGlTransactionId, d.intSequenceNumber, d.uidGlAccountId, d.decAmount, d.bitIsDebit
+	sqlstring2 := `SELECT d.inbGlTransactionId, d.intSequenceNumber, d.uidGlAccountId, d.decAmount, d.bitIsDebit,
+	d.chvCurrencyCode, d.decForeignAmount, d.chvTaxCode, d.bitIsTaxLine
 	FROM tb_GLTransaction AS t
 	JOIN tb_GLTransactionDetail AS d
 	ON t.inbGlTransactionId = d.inbGlTransactionId
 	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
-	AND t.bitIsDeleted = 0 
+	AND t.bitIsDeleted = 0 AND (? = 0 OR t.intTransactionStatus = ?)
 	ORDER BY d.inbGlTransactionId, d.intSequenceNumber`
 
 	stmt2, err := s.db.Prepare(sqlstring2)
@@ -396,7 +474,7 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 
 	defer stmt2.Close()
 
-	rows2, err := stmt2.Query(req.GetOrganizationId().Guid, req.GetMserviceId(), start_date, end_date)
+	rows2, err := stmt2.Query(req.GetOrganizationId().Guid, req.GetMserviceId(), start_date, end_date, status_filter, status_filter)
 
 	if err != nil {
 		level.Error(s.logger).Log("what", "Query", "error", err)
@@ -407,12 +485,18 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 
 	defer rows2.Close()
 
+	detailMap := make(map[string]*pb.GLTransactionDetail)
+
 	for rows2.Next() {
 		var gid []byte
 		var amount string
+		var currency sql.NullString
+		var foreign sql.NullString
+		var taxCode sql.NullString
 		var detail pb.GLTransactionDetail
 
-		err := rows2.Scan(&detail.GlTransactionId, &detail.SequenceNumber, &gid, &amount, &detail.IsDebit)
+		err := rows2.Scan(&detail.GlTransactionId, &detail.SequenceNumber, &gid, &amount, &detail.IsDebit, &currency, &foreign,
+			&taxCode, &detail.IsTaxLine)
 
 		if err != nil {
 			level.Error(s.logger).Log("what", "Scan", "error", err)
@@ -428,12 +512,31 @@ func (s *glService) GetTransactionWrappersByDate(ctx context.Context, req *pb.Ge
 			if err == nil {
 				detail.Amount = amt
 			}
+			setDetailForeignColumns(&detail, currency, foreign)
+			detail.TaxCode = taxCode.String
 
 			wrap.GlTransactionDetails = append(wrap.GlTransactionDetails, &detail)
+			detailMap[detailKey(detail.GetGlTransactionId(), detail.GetSequenceNumber())] = &detail
 		}
 
 	}
 
+	// and the dimension values of the details
+	sqlstring3 := `SELECT m.inbGlTransactionId, m.intSequenceNumber, m.chvDimensionName, m.chvDimensionValue
+	FROM tb_GLTransaction AS t
+	JOIN tb_GLTransactionDetailDimension AS m
+	ON t.inbGlTransactionId = m.inbGlTransactionId
+	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
+	AND t.bitIsDeleted = 0 AND (? = 0 OR t.intTransactionStatus = ?)
+	ORDER BY m.inbGlTransactionId, m.intSequenceNumber, m.chvDimensionName`
+
+	gResp := s.loadDetailDimensions(detailMap, sqlstring3, req.GetOrganizationId().Guid, req.GetMserviceId(), start_date, end_date,
+		status_filter, status_filter)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
 	return resp, nil
 }
 
@@ -442,7 +545,8 @@ func (s *glService) AddTransactionDetails(ctx context.Context, req *pb.AddTransa
 	resp := &pb.AddTransactionDetailsResponse{}
 
 	// make sure we are referring to a valid transaction
-	sqlstring1 := `SELECT inbGlTransactionId FROM tb_GLTransaction WHERE inbGlTransactionId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	sqlstring1 := `SELECT inbGlTransactionId, uidOrganizationId, dtmTransactionDate, intTransactionStatus FROM tb_GLTransaction
+	WHERE inbGlTransactionId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
 
 	stmt1, err := s.db.Prepare(sqlstring1)
 	if err != nil {
@@ -455,8 +559,11 @@ func (s *glService) AddTransactionDetails(ctx context.Context, req *pb.AddTransa
 	defer stmt1.Close()
 
 	var transactionId int64
+	var orgId []byte
+	var trandate time.Time
+	var status int32
 
-	err = stmt1.QueryRow(req.GetGlTransactionId(), req.GetMserviceId()).Scan(&transactionId)
+	err = stmt1.QueryRow(req.GetGlTransactionId(), req.GetMserviceId()).Scan(&transactionId, &orgId, &trandate, &status)
 
 	if err != nil {
 		resp.ErrorCode = 404
@@ -464,101 +571,1132 @@ func (s *glService) AddTransactionDetails(ctx context.Context, req *pb.AddTransa
 		return resp, nil
 	}
 
-	// make sure the details refer to valid accounts
+	// make sure the transaction is a draft dated in an open fiscal period
+	gResp := checkTransactionDraft(pb.TransactionStatus(status))
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, trandate)
+	}
 
-	sqlstring2 := `SELECT uidGlAccountId FROM tb_GLAccount WHERE uidGlAccountId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt2, err := s.db.Prepare(sqlstring2)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer stmt2.Close()
+	gResp, req.GlTransactionDetails = s.prepareTransactionDetails(req.GetMserviceId(), orgId, trandate, req.GetGlTransactionDetails())
 
-	creditAmt := sdec.New(0, 1) // zero
-	debitAmt := sdec.New(0, 1)  // zero
-	for _, detail := range req.GetGlTransactionDetails() {
-		var accountId []byte
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
 
-		amt, _ := detail.Amount.ConvertDecimal()
+	version, err := s.CommitTransactionDetails(req.GetGlTransactionId(), req.GetGlTransactionDetails())
 
-		if detail.IsDebit {
-			debitAmt = debitAmt.Add(amt)
-		} else {
-			creditAmt = creditAmt.Add(amt)
-		}
+	if err == nil {
+		resp.Version = version
+	} else if err == errTransactionChanged {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "transaction not found"
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("commit error: %s", err)
+	}
 
-		aid := detail.GetGlAccountId().Guid
-		// aid_str := hex.EncodeToString(aid)
-		// s.logger.Printf("account_id : %s\n", aid_str)
+	return resp, nil
+}
 
-		err := stmt2.QueryRow(aid, req.GetMserviceId()).Scan(&accountId)
-		if err != nil {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "account not found"
-			return resp, nil
-		}
+// replace all details of a draft general ledger transaction
+func (s *glService) ReplaceTransactionDetails(ctx context.Context, req *pb.ReplaceTransactionDetailsRequest) (*pb.ReplaceTransactionDetailsResponse, error) {
+	resp := &pb.ReplaceTransactionDetailsResponse{}
+
+	// make sure the transaction is a draft dated in an open fiscal period
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = checkTransactionDraft(tran.GetTransactionStatus())
 	}
 
-	// make sure debits and credits match
-	if !creditAmt.Equals(debitAmt) {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = "credits and debits do not balance"
-		return resp, nil
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetTransactionDate().TimeFromDateTime())
 	}
 
-	err = s.CommitTransactionDetails(req.GetGlTransactionDetails())
+	if gResp.ErrorCode == 0 {
+		gResp, req.GlTransactionDetails = s.prepareTransactionDetails(req.GetMserviceId(), tran.GetOrganizationId().GetGuid(),
+			tran.GetTransactionDate().TimeFromDateTime(), req.GetGlTransactionDetails())
+	}
 
-	if err != nil {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = fmt.Sprintf("commit error: %s", err)
+	if gResp.ErrorCode == 0 {
+		gResp = s.CommitReplaceDetails(req.GetGlTransactionId(), req.GetMserviceId(), req.GetVersion(), req.GetGlTransactionDetails())
+	}
+
+	if gResp.ErrorCode == 0 {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
 	return resp, nil
 }
 
-// get current server version and uptime - health check
-func (s *glService) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
-	resp := &pb.GetServerVersionResponse{}
+// reverse general ledger transaction with a mirror image transaction
+func (s *glService) ReverseTransaction(ctx context.Context, req *pb.ReverseTransactionRequest) (*pb.ReverseTransactionResponse, error) {
+	resp := &pb.ReverseTransactionResponse{}
 
-	currentSecs := time.Now().Unix()
-	resp.ServerVersion = "v0.9.3"
-	resp.ServerUptime = currentSecs - s.startSecs
+	if req.GetTransactionDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "transaction_date missing"
+		return resp, nil
+	}
 
-	return resp, nil
-}
+	tranDate := req.GetTransactionDate().TimeFromDateTime()
 
-func (s *glService) CommitTransactionDetails(details []*pb.GLTransactionDetail) error {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return err
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkReverseTransaction(ctx, tran, tranDate)
 	}
 
-	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+	// the other side of an intercompany entry is reversed with it, so the partner organization keeps no unmatched balance
+	var partner *pb.GLTransaction
+	if (gResp.ErrorCode == 0) && (tran.GetIntercompanyTransactionId() != 0) {
+		gResp, partner = s.GetTransactionHelper(tran.GetIntercompanyTransactionId(), req.GetMserviceId())
+		if gResp.ErrorCode == 0 {
+			gResp = partnerResponse(s.checkReverseTransaction(ctx, partner, tranDate), partner.GetGlTransactionId())
+		}
+	}
 
-	sqlstring := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit)
-	VALUES(?, ?, ?, ?, ?)`
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
 
-	stmt, err := tx.Prepare(sqlstring)
-	if err != nil {
-		return err
+	gResp, details := s.getTransactionDetails(tran.GetGlTransactionId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
 	}
 
-	defer stmt.Close()
+	if len(details) == 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction has no details"
+		return resp, nil
+	}
 
-	for _, detail := range details {
-		_, err := stmt.Exec(detail.GetGlTransactionId(), detail.GetSequenceNumber(), detail.GetGlAccountId().Guid, detail.GetAmount().StringFromDecimal(),
-			detail.GetIsDebit())
-		if err != nil {
-			return err
+	description := req.GetTransactionDescription()
+	if description == "" {
+		description = fmt.Sprintf("reversal of transaction %d", tran.GetGlTransactionId())
+	}
+
+	var reversingId int64
+
+	if partner == nil {
+		gResp, reversingId = s.CommitReversal(tran, req.GetVersion(), tranDate, description, reversingDetails(details))
+	} else {
+		var partnerDetails []*pb.GLTransactionDetail
+		gResp, partnerDetails = s.getTransactionDetails(partner.GetGlTransactionId())
+		if gResp.ErrorCode == 0 {
+			partnerDescription := req.GetTransactionDescription()
+			if partnerDescription == "" {
+				partnerDescription = fmt.Sprintf("reversal of transaction %d", partner.GetGlTransactionId())
+			}
+
+			gResp, reversingId = s.commitIntercompanyReversal(tran, req.GetVersion(), partner, tranDate, description, partnerDescription,
+				reversingDetails(details), reversingDetails(partnerDetails))
 		}
 	}
 
-	err = tx.Commit()
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
 
-	return err
+	resp.Version = req.GetVersion() + 1
+	resp.ReversingTransactionId = reversingId
+
+	return resp, nil
+}
+
+// void general ledger transaction
+func (s *glService) VoidTransaction(ctx context.Context, req *pb.VoidTransactionRequest) (*pb.VoidTransactionResponse, error) {
+	resp := &pb.VoidTransactionResponse{}
+
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkVoidTransaction(ctx, tran)
+	}
+
+	// the other side of an intercompany entry is voided with it, so the partner organization keeps no unmatched balance
+	var partner *pb.GLTransaction
+	if (gResp.ErrorCode == 0) && (tran.GetIntercompanyTransactionId() != 0) {
+		gResp, partner = s.GetTransactionHelper(tran.GetIntercompanyTransactionId(), req.GetMserviceId())
+		if gResp.ErrorCode == 0 {
+			gResp = partnerResponse(s.checkVoidTransaction(ctx, partner), partner.GetGlTransactionId())
+		}
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp = s.CommitVoid(tran, req.GetVersion(), partner)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+
+	return resp, nil
+}
+
+// post a draft general ledger transaction
+func (s *glService) PostTransaction(ctx context.Context, req *pb.PostTransactionRequest) (*pb.PostTransactionResponse, error) {
+	resp := &pb.PostTransactionResponse{}
+
+	gResp, tran := s.GetTransactionHelper(req.GetGlTransactionId(), req.GetMserviceId())
+	if gResp.ErrorCode == 0 {
+		gResp = checkTransactionDraft(tran.GetTransactionStatus())
+	}
+
+	if gResp.ErrorCode == 0 {
+		// make sure the transaction date is in an open fiscal period
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetTransactionDate().TimeFromDateTime())
+	}
+
+	// an immediate reversal is checked up front so the transaction is not left behind without it
+	if (gResp.ErrorCode == 0) && (tran.GetAutoReverseMode() == pb.AutoReverseMode_AUTO_REVERSE_MODE_IMMEDIATE) {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetAutoReverseDate().TimeFromDateTime())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, details := s.getTransactionDetails(req.GetGlTransactionId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if len(details) == 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction has no details"
+		return resp, nil
+	}
+
+	// accounts may have been deleted since the details were added
+	gResp = s.validateTransactionDetails(req.GetMserviceId(), tran.GetOrganizationId().GetGuid(), details)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, resp.ReversingTransactionId = s.CommitPostTransaction(tran, req.GetVersion(), details)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+	if resp.GetReversingTransactionId() != 0 {
+		resp.Version++
+	}
+
+	return resp, nil
+}
+
+// create and post general ledger transaction with its details in one step
+func (s *glService) PostJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*pb.PostJournalEntryResponse, error) {
+	resp := &pb.PostJournalEntryResponse{}
+
+	if len(req.GetGlTransactionDetails()) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "transaction details required"
+		return resp, nil
+	}
+
+	// make sure the transaction date is in an open fiscal period
+	gResp := s.checkPeriodOpen(ctx, req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTransactionDate().TimeFromDateTime())
+	if gResp.ErrorCode == 0 {
+		gResp = checkDueDate(req.GetTransactionDate(), req.GetDueDate())
+	}
+
+	if gResp.ErrorCode == 0 {
+		gResp, req.GlTransactionDetails = s.prepareTransactionDetails(req.GetMserviceId(), req.GetOrganizationId().GetGuid(),
+			req.GetTransactionDate().TimeFromDateTime(), req.GetGlTransactionDetails())
+	}
+
+	var reverse_date sql.NullTime
+	if gResp.ErrorCode == 0 {
+		gResp, reverse_date = s.checkAutoReverse(req.GetMserviceId(), req.GetOrganizationId().GetGuid(),
+			req.GetTransactionDate().TimeFromDateTime(), req.GetAutoReverseMode(), req.GetAutoReverseDate())
+	}
+
+	// an immediate reversal is checked up front so the transaction is not left behind without it
+	if (gResp.ErrorCode == 0) && (req.GetAutoReverseMode() == pb.AutoReverseMode_AUTO_REVERSE_MODE_IMMEDIATE) {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), req.GetOrganizationId().GetGuid(), reverse_date.Time)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if reverse_date.Valid {
+		req.AutoReverseDate = dml.DateTimeFromTime(reverse_date.Time)
+	}
+
+	// an immediate reversal is posted together with the transaction
+	var immediate_date sql.NullTime
+	if req.GetAutoReverseMode() == pb.AutoReverseMode_AUTO_REVERSE_MODE_IMMEDIATE {
+		immediate_date = reverse_date
+	}
+
+	gResp, transactionId, reversingId := s.CommitJournalEntry(req, immediate_date, "")
+	resp.ReversingTransactionId = reversingId
+
+	if transactionId != 0 {
+		vResp, wrap := s.GetTransactionWrapperHelper(transactionId, req.GetMserviceId())
+		if gResp.ErrorCode == 0 {
+			gResp = vResp
+		}
+
+		resp.GlTransactionWrapper = wrap
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get current server version and uptime - health check
+func (s *glService) GetServerVersion(ctx context.Context, req *pb.GetServerVersionRequest) (*pb.GetServerVersionResponse, error) {
+	resp := &pb.GetServerVersionResponse{}
+
+	currentSecs := time.Now().Unix()
+	resp.ServerVersion = "v0.9.3"
+	resp.ServerUptime = currentSecs - s.startSecs
+
+	return resp, nil
+}
+
+// Prepare transaction details entered by a client for posting: convert them to the base currency, generate their tax
+// lines, then check their accounts, balance and dimensions. The details returned replace those passed in.
+func (s *glService) prepareTransactionDetails(mserviceId int64, organizationId []byte, tranDate time.Time,
+	details []*pb.GLTransactionDetail) (*genericResponse, []*pb.GLTransactionDetail) {
+	gResp, details := s.completeTransactionDetails(mserviceId, organizationId, tranDate, details)
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkTransactionDetails(mserviceId, organizationId, details)
+	}
+
+	return gResp, details
+}
+
+// Convert transaction details to the base currency and generate their tax lines, the first half of
+// prepareTransactionDetails for callers that add lines of their own before the checks.
+func (s *glService) completeTransactionDetails(mserviceId int64, organizationId []byte, tranDate time.Time,
+	details []*pb.GLTransactionDetail) (*genericResponse, []*pb.GLTransactionDetail) {
+	gResp := s.convertTransactionDetails(mserviceId, organizationId, tranDate, details)
+	if gResp.ErrorCode == 0 {
+		gResp, details = s.addTaxLines(mserviceId, organizationId, details)
+	}
+
+	return gResp, details
+}
+
+// Check the accounts, balance and dimensions of completed transaction details, the second half of
+// prepareTransactionDetails.
+func (s *glService) checkTransactionDetails(mserviceId int64, organizationId []byte, details []*pb.GLTransactionDetail) *genericResponse {
+	gResp := s.validateTransactionDetails(mserviceId, organizationId, details)
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkDetailDimensions(mserviceId, organizationId, details)
+	}
+
+	return gResp
+}
+
+// Check that transaction details refer to valid accounts of the organization and that debits and credits balance.
+func (s *glService) validateTransactionDetails(mserviceId int64, organizationId []byte, details []*pb.GLTransactionDetail) *genericResponse {
+	resp := &genericResponse{}
+
+	// make sure the details refer to valid accounts
+	sqlstring := `SELECT uidOrganizationId FROM tb_GLAccount WHERE uidGlAccountId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	creditAmt := sdec.New(0, 1) // zero
+	debitAmt := sdec.New(0, 1)  // zero
+	for _, detail := range details {
+		var accountOrgId []byte
+
+		amt, _ := detail.Amount.ConvertDecimal()
+
+		if detail.IsDebit {
+			debitAmt = debitAmt.Add(amt)
+		} else {
+			creditAmt = creditAmt.Add(amt)
+		}
+
+		aid := detail.GetGlAccountId().Guid
+		// aid_str := hex.EncodeToString(aid)
+		// s.logger.Printf("account_id : %s\n", aid_str)
+
+		err := stmt.QueryRow(aid, mserviceId).Scan(&accountOrgId)
+		if err != nil {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "account not found"
+			return resp
+		}
+
+		// an account of another organization would put the entry in two ledgers
+		if !bytes.Equal(accountOrgId, organizationId) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("account %x belongs to another organization, use post_intercompany_entry", aid)
+			return resp
+		}
+	}
+
+	// make sure debits and credits match
+	if !creditAmt.Equals(debitAmt) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "credits and debits do not balance"
+		return resp
+	}
+
+	return resp
+}
+
+// Append details to a draft transaction and bump its version in a single database transaction, returning the new version.
+// Sequence numbers are assigned after the last existing detail, ignoring those supplied by the client.
+func (s *glService) CommitTransactionDetails(transactionId int64, details []*pb.GLTransactionDetail) (int32, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	// bump the version, so a concurrent replace of the details fails its version check
+	res, err := tx.Exec(`UPDATE tb_GLTransaction SET dtmModified = NOW(), intVersion = intVersion + 1
+	WHERE inbGlTransactionId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`, transactionId,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT))
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		return 0, errTransactionChanged
+	}
+
+	var version int32
+	err = tx.QueryRow(`SELECT intVersion FROM tb_GLTransaction WHERE inbGlTransactionId = ?`, transactionId).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence int32
+	err = tx.QueryRow(`SELECT COALESCE(MAX(intSequenceNumber), 0) FROM tb_GLTransactionDetail WHERE inbGlTransactionId = ?`,
+		transactionId).Scan(&sequence)
+	if err != nil {
+		return 0, err
+	}
+
+	sqlstring := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit,
+	chvCurrencyCode, decForeignAmount, chvTaxCode, bitIsTaxLine) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(sqlstring)
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	for _, detail := range details {
+		sequence++
+		currency, foreign := detailForeignColumns(detail)
+		_, err := stmt.Exec(transactionId, sequence, detail.GetGlAccountId().Guid, detail.GetAmount().StringFromDecimal(),
+			detail.GetIsDebit(), currency, foreign, detailTaxColumn(detail), detail.GetIsTaxLine())
+		if err == nil {
+			err = commitDetailDimensions(tx, transactionId, sequence, detail)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// Swap the whole detail set of a draft transaction and bump its version, in a single database transaction.
+func (s *glService) CommitReplaceDetails(transactionId int64, mserviceId int64, version int32,
+	details []*pb.GLTransactionDetail) *genericResponse {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring1 := `UPDATE tb_GLTransaction SET dtmModified = NOW(), intVersion = ?
+	WHERE inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`
+
+	res, err := tx.Exec(sqlstring1, version+1, transactionId, version, mserviceId, int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT))
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp
+	}
+
+	_, err = tx.Exec(`DELETE FROM tb_GLTransactionDetailDimension WHERE inbGlTransactionId = ?`, transactionId)
+	if err == nil {
+		_, err = tx.Exec(`DELETE FROM tb_GLTransactionDetail WHERE inbGlTransactionId = ?`, transactionId)
+	}
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	sqlstring2 := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit,
+	chvCurrencyCode, decForeignAmount, chvTaxCode, bitIsTaxLine) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for i, detail := range details {
+		currency, foreign := detailForeignColumns(detail)
+		_, err := tx.Exec(sqlstring2, transactionId, i+1, detail.GetGlAccountId().GetGuid(),
+			detail.GetAmount().StringFromDecimal(), detail.GetIsDebit(), currency, foreign, detailTaxColumn(detail), detail.GetIsTaxLine())
+		if err == nil {
+			err = commitDetailDimensions(tx, transactionId, int32(i+1), detail)
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp
+}
+
+// Insert a reversing transaction with its details and link it to the original transaction, in a single database transaction.
+func (s *glService) CommitReversal(tran *pb.GLTransaction, version int32, tranDate time.Time, description string,
+	details []*pb.GLTransactionDetail) (*genericResponse, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	reversingId, err := insertReversal(tx, tran, version, tranDate, description, details)
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err), 0
+	}
+
+	return resp, reversingId
+}
+
+// Post a draft transaction and bump its version, in a single database transaction. The reversal of an immediately
+// reversing transaction is inserted in the same database transaction, so it is never posted without it.
+func (s *glService) CommitPostTransaction(tran *pb.GLTransaction, version int32, details []*pb.GLTransactionDetail) (*genericResponse, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring := `UPDATE tb_GLTransaction SET dtmModified = NOW(), dtmPosted = NOW(), intTransactionStatus = ?, intVersion = ?
+	WHERE inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`
+
+	res, err := tx.Exec(sqlstring, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), version+1, tran.GetGlTransactionId(), version,
+		tran.GetMserviceId(), int32(pb.TransactionStatus_TRANSACTION_STATUS_DRAFT))
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected != 1 {
+			err = errTransactionChanged
+		}
+	}
+
+	var reversingId int64
+	if (err == nil) && (tran.GetAutoReverseMode() == pb.AutoReverseMode_AUTO_REVERSE_MODE_IMMEDIATE) {
+		reversingId, err = insertReversal(tx, tran, version+1, tran.GetAutoReverseDate().TimeFromDateTime(),
+			autoReversalDescription(tran.GetGlTransactionId()), reversingDetails(details))
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err), 0
+	}
+
+	return resp, reversingId
+}
+
+// Void a posted transaction and bump its version, in a single database transaction. The partner transaction of an
+// intercompany entry, when given, is voided at the version it was read at in the same database transaction.
+func (s *glService) CommitVoid(tran *pb.GLTransaction, version int32, partner *pb.GLTransaction) *genericResponse {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	err = voidTransaction(tx, tran, version)
+	if (err == nil) && (partner != nil) {
+		err = voidTransaction(tx, partner, partner.GetVersion())
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err)
+	}
+
+	return resp
+}
+
+// Void a posted transaction at the given version as part of a database transaction.
+func voidTransaction(tx *sql.Tx, tran *pb.GLTransaction, version int32) error {
+	sqlstring := `UPDATE tb_GLTransaction SET dtmModified = NOW(), dtmVoided = NOW(), intTransactionStatus = ?, intVersion = ?
+	WHERE inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?`
+
+	res, err := tx.Exec(sqlstring, int32(pb.TransactionStatus_TRANSACTION_STATUS_VOID), version+1, tran.GetGlTransactionId(), version,
+		tran.GetMserviceId(), int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		return errTransactionChanged
+	}
+
+	return nil
+}
+
+// Insert a reversing transaction with its details and link it to the original transaction as part of a database
+// transaction, returning the reversing transaction id. The original must be posted, not yet reversed and at the given version.
+func insertReversal(tx *sql.Tx, tran *pb.GLTransaction, version int32, tranDate time.Time, description string,
+	details []*pb.GLTransactionDetail) (int64, error) {
+	var from_party sql.NullInt64
+	var to_party sql.NullInt64
+
+	if tran.GetFromPartyId() != 0 {
+		from_party.Int64 = tran.GetFromPartyId()
+		from_party.Valid = true
+	}
+
+	if tran.GetToPartyId() != 0 {
+		to_party.Int64 = tran.GetToPartyId()
+		to_party.Valid = true
+	}
+
+	sqlstring1 := `INSERT INTO tb_GLTransaction (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, dtmTransactionDate, chvTransactionDescription, intTransactionTypeId, inbFromPartyId, inbToPartyId,
+	bitIsClosingEntry, intTransactionStatus, dtmPosted, inbReversesTransactionId, intAutoReverseMode)
+	VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, 0, ?, NOW(), ?, 0)`
+
+	var reversingId int64
+
+	res, err := tx.Exec(sqlstring1, tran.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tranDate, description,
+		tran.GetTransactionTypeId(), &from_party, &to_party, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), tran.GetGlTransactionId())
+	if err == nil {
+		reversingId, err = res.LastInsertId()
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	sqlstring2 := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit,
+	chvCurrencyCode, decForeignAmount, chvTaxCode, bitIsTaxLine) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for _, detail := range details {
+		currency, foreign := detailForeignColumns(detail)
+		_, err := tx.Exec(sqlstring2, reversingId, detail.GetSequenceNumber(), detail.GetGlAccountId().GetGuid(),
+			detail.GetAmount().StringFromDecimal(), detail.GetIsDebit(), currency, foreign, detailTaxColumn(detail), detail.GetIsTaxLine())
+		if err == nil {
+			err = commitDetailDimensions(tx, reversingId, detail.GetSequenceNumber(), detail)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	sqlstring3 := `UPDATE tb_GLTransaction SET dtmModified = NOW(), intVersion = ?, inbReversedByTransactionId = ?
+	WHERE inbGlTransactionId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intTransactionStatus = ?
+	AND inbReversedByTransactionId IS NULL`
+
+	res, err = tx.Exec(sqlstring3, version+1, reversingId, tran.GetGlTransactionId(), version, tran.GetMserviceId(),
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED))
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		return 0, errTransactionChanged
+	}
+
+	return reversingId, nil
+
+}
+
+// Get the response for an error from a database transaction posting transactions.
+func (s *glService) commitErrorResponse(err error) *genericResponse {
+	resp := &genericResponse{}
+
+	if err == errTransactionChanged {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp
+	}
+
+	level.Error(s.logger).Log("what", "Exec", "error", err)
+	resp.ErrorCode = 501
+	resp.ErrorMessage = err.Error()
+
+	return resp
+}
+
+// Get the details of the reversal of a transaction: copies of its details on the opposite side, numbered in order.
+func reversingDetails(details []*pb.GLTransactionDetail) []*pb.GLTransactionDetail {
+	var reversing []*pb.GLTransactionDetail
+
+	for i, detail := range details {
+		reversal := proto.Clone(detail).(*pb.GLTransactionDetail)
+		reversal.SequenceNumber = int32(i + 1)
+		reversal.IsDebit = !detail.GetIsDebit()
+		reversing = append(reversing, reversal)
+	}
+
+	return reversing
+}
+
+// Get the description of the automatic reversal of a transaction.
+func autoReversalDescription(transactionId int64) string {
+	return fmt.Sprintf("automatic reversal of transaction %d", transactionId)
+}
+
+// Insert a posted transaction header and all of its details, in a single database transaction.
+// Details are numbered in the order given. With a valid reversal date, the reversal of the transaction is inserted
+// and linked in the same database transaction, with the automatic reversal description unless one is given.
+func (s *glService) CommitJournalEntry(req *pb.PostJournalEntryRequest, reverseDate sql.NullTime,
+	reverseDescription string) (*genericResponse, int64, int64) {
+	resp := &genericResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	transactionId, err := insertJournalEntry(tx, req)
+
+	var reversingId int64
+	if (err == nil) && reverseDate.Valid {
+		tran := pb.GLTransaction{
+			GlTransactionId:   transactionId,
+			MserviceId:        req.GetMserviceId(),
+			OrganizationId:    req.GetOrganizationId(),
+			TransactionTypeId: req.GetTransactionTypeId(),
+			FromPartyId:       req.GetFromPartyId(),
+			ToPartyId:         req.GetToPartyId(),
+		}
+
+		if reverseDescription == "" {
+			reverseDescription = autoReversalDescription(transactionId)
+		}
+
+		reversingId, err = insertReversal(tx, &tran, 1, reverseDate.Time, reverseDescription, reversingDetails(req.GetGlTransactionDetails()))
+	}
+
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		return s.commitErrorResponse(err), 0, 0
+	}
+
+	return resp, transactionId, reversingId
+}
+
+// Insert a posted transaction header and its details as part of a database transaction, returning the new transaction id.
+func insertJournalEntry(tx *sql.Tx, req *pb.PostJournalEntryRequest) (int64, error) {
+	var from_party sql.NullInt64
+	var to_party sql.NullInt64
+	var via_key sql.NullString
+	var via_date sql.NullTime
+	var reverse_date sql.NullTime
+
+	if req.GetFromPartyId() != 0 {
+		from_party.Int64 = req.GetFromPartyId()
+		from_party.Valid = true
+	}
+
+	if req.GetToPartyId() != 0 {
+		to_party.Int64 = req.GetToPartyId()
+		to_party.Valid = true
+	}
+
+	if req.GetPostedViaKey() != "" {
+		via_key.String = req.GetPostedViaKey()
+		via_key.Valid = true
+	}
+
+	if req.GetPostedViaDate() != nil {
+		via_date.Time = req.GetPostedViaDate().TimeFromDateTime()
+		via_date.Valid = true
+	}
+
+	if req.GetAutoReverseDate() != nil {
+		reverse_date.Time = req.GetAutoReverseDate().TimeFromDateTime()
+		reverse_date.Valid = true
+	}
+
+	sqlstring1 := `INSERT INTO tb_GLTransaction (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+	uidOrganizationId, dtmTransactionDate, chvTransactionDescription, intTransactionTypeId, inbFromPartyId, inbToPartyId,
+	chvPostedViaKey, dtmPostedViaDate, bitIsClosingEntry, intTransactionStatus, dtmPosted, intAutoReverseMode, dtmAutoReverseDate,
+	dtmDueDate)
+	VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, 0, ?, NOW(), ?, ?, ?)`
+
+	res, err := tx.Exec(sqlstring1, req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetTransactionDate().TimeFromDateTime(),
+		req.GetTransactionDescription(), req.GetTransactionTypeId(), &from_party, &to_party, &via_key, &via_date,
+		int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), int32(req.GetAutoReverseMode()), &reverse_date, dueDate(req.GetDueDate()))
+	if err != nil {
+		return 0, err
+	}
+
+	transactionId, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	sqlstring2 := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit,
+	chvCurrencyCode, decForeignAmount, chvTaxCode, bitIsTaxLine) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	for i, detail := range req.GetGlTransactionDetails() {
+		currency, foreign := detailForeignColumns(detail)
+		_, err := tx.Exec(sqlstring2, transactionId, i+1, detail.GetGlAccountId().GetGuid(),
+			detail.GetAmount().StringFromDecimal(), detail.GetIsDebit(), currency, foreign, detailTaxColumn(detail), detail.GetIsTaxLine())
+		if err == nil {
+			err = commitDetailDimensions(tx, transactionId, int32(i+1), detail)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return transactionId, nil
+}
+
+// Check the automatic reversal requested for a transaction, returning the reversal date to store. The date defaults to
+// the start of the fiscal period after the transaction date.
+func (s *glService) checkAutoReverse(mserviceId int64, organizationId []byte, tranDate time.Time, mode pb.AutoReverseMode,
+	date *dml.DateTime) (*genericResponse, sql.NullTime) {
+	resp := &genericResponse{}
+
+	var result sql.NullTime
+
+	if mode == pb.AutoReverseMode_AUTO_REVERSE_MODE_UNSPECIFIED {
+		if date != nil {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "auto_reverse_mode missing"
+		}
+
+		return resp, result
+	}
+
+	if _, ok := pb.AutoReverseMode_name[int32(mode)]; !ok {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "auto_reverse_mode invalid"
+		return resp, result
+	}
+
+	if date == nil {
+		gResp, nextStart := s.nextPeriodStart(mserviceId, organizationId, tranDate)
+		if gResp.ErrorCode != 0 {
+			return gResp, result
+		}
+
+		result.Time = nextStart
+	} else {
+		result.Time = date.TimeFromDateTime()
+	}
+
+	if !startOfDay(result.Time).After(startOfDay(tranDate)) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "auto_reverse_date must be after transaction_date"
+		return resp, result
+	}
+
+	result.Valid = true
+
+	return resp, result
+}
+
+// Post the reversal of an auto-reversing transaction on its reversal date, returning the reversing transaction id.
+func (s *glService) commitAutoReversal(transactionId int64, mserviceId int64) (*genericResponse, int64) {
+	gResp, tran := s.GetTransactionHelper(transactionId, mserviceId)
+	if gResp.ErrorCode != 0 {
+		return gResp, 0
+	}
+
+	gResp, details := s.getTransactionDetails(transactionId)
+	if gResp.ErrorCode != 0 {
+		return gResp, 0
+	}
+
+	return s.CommitReversal(tran, tran.GetVersion(), tran.GetAutoReverseDate().TimeFromDateTime(), autoReversalDescription(transactionId),
+		reversingDetails(details))
+}
+
+// Post the reversals of auto-reversing transactions whose reversal date is on or before the given time, returning the
+// number posted. A reversal dated in a closed fiscal period is held back and retried on the next run.
+func (s *glService) GenerateAutoReversals(asOf time.Time) int {
+	sqlstring := `SELECT inbGlTransactionId, inbMserviceId, uidOrganizationId, dtmAutoReverseDate FROM tb_GLTransaction
+	WHERE bitIsDeleted = 0 AND intTransactionStatus = ? AND intAutoReverseMode = ? AND inbReversedByTransactionId IS NULL
+	AND dtmAutoReverseDate <= ?
+	ORDER BY inbGlTransactionId`
+
+	rows, err := s.db.Query(sqlstring, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED),
+		int32(pb.AutoReverseMode_AUTO_REVERSE_MODE_ON_DATE), asOf)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		return 0
+	}
+
+	type dueReversal struct {
+		transactionId  int64
+		mserviceId     int64
+		organizationId []byte
+		reverseDate    time.Time
+	}
+
+	var due []dueReversal
+
+	for rows.Next() {
+		var d dueReversal
+		err := rows.Scan(&d.transactionId, &d.mserviceId, &d.organizationId, &d.reverseDate)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			rows.Close()
+			return 0
+		}
+
+		due = append(due, d)
+	}
+
+	rows.Close()
+
+	count := 0
+	for _, d := range due {
+		gResp := s.checkPeriodOpen(context.Background(), d.mserviceId, d.organizationId, d.reverseDate)
+		if gResp.ErrorCode == 0 {
+			gResp, _ = s.commitAutoReversal(d.transactionId, d.mserviceId)
+		}
+
+		if gResp.ErrorCode != 0 {
+			level.Warn(s.logger).Log("what", "GenerateAutoReversals", "transactionid", d.transactionId,
+				"errcode", gResp.ErrorCode, "error", gResp.ErrorMessage)
+			continue
+		}
+
+		count++
+	}
+
+	if count > 0 {
+		level.Info(s.logger).Log("msg", "posted automatic reversals", "count", count)
+	}
+
+	return count
+}
+
+// Check that a transaction can be reversed on a date: it must be posted, not yet reversed, and the date must be in an
+// open fiscal period of its organization.
+func (s *glService) checkReverseTransaction(ctx context.Context, tran *pb.GLTransaction, tranDate time.Time) *genericResponse {
+	resp := checkTransactionPosted(tran.GetTransactionStatus(), tran.GetIsClosingEntry())
+	if resp.ErrorCode != 0 {
+		return resp
+	}
+
+	if tran.GetReversedByTransactionId() != 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction is already reversed"
+		return resp
+	}
+
+	// make sure the reversing transaction date is in an open fiscal period
+	return s.checkPeriodOpen(ctx, tran.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tranDate)
+}
+
+// Check that a transaction can be voided: it must be posted, not part of a reversed pair, have no applied open items,
+// and be dated in an open fiscal period.
+func (s *glService) checkVoidTransaction(ctx context.Context, tran *pb.GLTransaction) *genericResponse {
+	resp := checkTransactionPosted(tran.GetTransactionStatus(), tran.GetIsClosingEntry())
+	if resp.ErrorCode != 0 {
+		return resp
+	}
+
+	// a reversed pair stays together
+	if tran.GetReversedByTransactionId() != 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction has been reversed"
+		return resp
+	}
+
+	if tran.GetReversesTransactionId() != 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction is a reversal"
+		return resp
+	}
+
+	// payments applied to the open items of a voided transaction would be left hanging
+	resp = s.checkTransactionNotApplied(tran.GetGlTransactionId())
+	if resp.ErrorCode != 0 {
+		return resp
+	}
+
+	// a statement line matched to a voided transaction would stay reconciled against nothing
+	resp = s.checkTransactionNotMatched(tran.GetGlTransactionId())
+	if resp.ErrorCode != 0 {
+		return resp
+	}
+
+	// make sure the transaction date is in an open fiscal period
+	return s.checkPeriodOpen(ctx, tran.GetMserviceId(), tran.GetOrganizationId().GetGuid(), tran.GetTransactionDate().TimeFromDateTime())
+}
+
+// Check that a due date, when given, is not before the transaction date.
+func checkDueDate(tranDate *dml.DateTime, due *dml.DateTime) *genericResponse {
+	resp := &genericResponse{}
+
+	if (due != nil) && due.TimeFromDateTime().Before(tranDate.TimeFromDateTime()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "due_date must not be before transaction_date"
+	}
+
+	return resp
+}
+
+// Due date column of a transaction, null when not given.
+func dueDate(due *dml.DateTime) sql.NullTime {
+	var due_date sql.NullTime
+
+	if due != nil {
+		due_date.Time = due.TimeFromDateTime()
+		due_date.Valid = true
+	}
+
+	return due_date
+}
+
+// Check that a transaction is still a draft, the only status in which it may change.
+func checkTransactionDraft(status pb.TransactionStatus) *genericResponse {
+	resp := &genericResponse{}
+
+	if status != pb.TransactionStatus_TRANSACTION_STATUS_DRAFT {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction is not a draft"
+	}
+
+	return resp
+}
+
+// Check that a transaction is posted and may be reversed or voided; closing entries are only reversed by reopening the fiscal year.
+func checkTransactionPosted(status pb.TransactionStatus, isClosingEntry bool) *genericResponse {
+	resp := &genericResponse{}
+
+	if status != pb.TransactionStatus_TRANSACTION_STATUS_POSTED {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction is not posted"
+	} else if isClosingEntry {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "transaction is a closing entry, use reopen_fiscal_year"
+	}
+
+	return resp
 }
 
 func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64) (*genericResponse, *pb.GLTransaction) {
@@ -566,7 +1704,9 @@ func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64)
 
 	sqlstring := `SELECT t.inbGlTransactionId, t.dtmCreated, t.dtmModified, t.intVersion, t.inbMserviceId, t.uidOrganizationId, 
 	t.dtmTransactionDate, t.chvTransactionDescription, t.intTransactionTypeId, t.inbFromPartyId, t.inbToPartyId, t.chvPostedViaKey, 
-	t.dtmPostedViaDate, y.chvTransactionType
+	t.dtmPostedViaDate, t.bitIsClosingEntry, t.intTransactionStatus, t.dtmPosted, t.dtmVoided, t.inbReversesTransactionId,
+	t.inbReversedByTransactionId, t.intAutoReverseMode, t.dtmAutoReverseDate, t.inbIntercompanyTransactionId, t.dtmDueDate,
+	y.chvTransactionType
 	FROM tb_GLTransaction AS t
 	JOIN tb_GLTransactionType AS y
 	ON t.intTransactionTypeId = y.intTransactionTypeId
@@ -586,6 +1726,15 @@ func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64)
 	var to_party sql.NullInt64
 	var via_key sql.NullString
 	var via_date sql.NullTime
+	var status int32
+	var posted sql.NullTime
+	var voided sql.NullTime
+	var reverses sql.NullInt64
+	var reversed_by sql.NullInt64
+	var reverse_mode int32
+	var reverse_date sql.NullTime
+	var intercompany sql.NullInt64
+	var due_date sql.NullTime
 	var tran pb.GLTransaction
 	var created time.Time
 	var modified time.Time
@@ -594,7 +1743,8 @@ func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64)
 
 	err = stmt.QueryRow(transactionId, mserviceId).Scan(&tran.GlTransactionId, &created, &modified, &tran.Version,
 		&tran.MserviceId, &orgGid, &trandate, &tran.TransactionDescription, &tran.TransactionTypeId, &from_party, &to_party, &via_key,
-		&via_date, &tran.TransactionType)
+		&via_date, &tran.IsClosingEntry, &status, &posted, &voided, &reverses, &reversed_by, &reverse_mode, &reverse_date,
+		&intercompany, &due_date, &tran.TransactionType)
 
 	if err == nil {
 		var oid dml.Guid
@@ -616,6 +1766,29 @@ func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64)
 		if via_date.Valid {
 			tran.PostedViaDate = dml.DateTimeFromTime(via_date.Time)
 		}
+		tran.TransactionStatus = pb.TransactionStatus(status)
+		if posted.Valid {
+			tran.Posted = dml.DateTimeFromTime(posted.Time)
+		}
+		if voided.Valid {
+			tran.Voided = dml.DateTimeFromTime(voided.Time)
+		}
+		if reverses.Valid {
+			tran.ReversesTransactionId = reverses.Int64
+		}
+		if reversed_by.Valid {
+			tran.ReversedByTransactionId = reversed_by.Int64
+		}
+		tran.AutoReverseMode = pb.AutoReverseMode(reverse_mode)
+		if reverse_date.Valid {
+			tran.AutoReverseDate = dml.DateTimeFromTime(reverse_date.Time)
+		}
+		if intercompany.Valid {
+			tran.IntercompanyTransactionId = intercompany.Int64
+		}
+		if due_date.Valid {
+			tran.DueDate = dml.DateTimeFromTime(due_date.Time)
+		}
 
 	} else if err == sql.ErrNoRows {
 		resp.ErrorCode = 404
@@ -632,6 +1805,102 @@ func (s *glService) GetTransactionHelper(transactionId int64, mserviceId int64)
 
 }
 
+// Get the details of a general ledger transaction in sequence order.
+func (s *glService) getTransactionDetails(transactionId int64) (*genericResponse, []*pb.GLTransactionDetail) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT d.inbGlTransactionId, d.intSequenceNumber, d.uidGlAccountId, d.decAmount, d.bitIsDebit,
+	d.chvCurrencyCode, d.decForeignAmount, d.chvTaxCode, d.bitIsTaxLine
+	FROM tb_GLTransactionDetail AS d
+	WHERE d.inbGlTransactionId = ?
+	ORDER BY d.intSequenceNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(transactionId)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var details []*pb.GLTransactionDetail
+	detailMap := make(map[string]*pb.GLTransactionDetail)
+
+	for rows.Next() {
+		var gid []byte
+		var amount string
+		var currency sql.NullString
+		var foreign sql.NullString
+		var taxCode sql.NullString
+		var detail pb.GLTransactionDetail
+
+		err := rows.Scan(&detail.GlTransactionId, &detail.SequenceNumber, &gid, &amount, &detail.IsDebit, &currency, &foreign,
+			&taxCode, &detail.IsTaxLine)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		detail.GlAccountId, _ = dml.GuidFromBytes(gid)
+		amt, err := dml.DecimalFromString(amount)
+		if err == nil {
+			detail.Amount = amt
+		}
+		setDetailForeignColumns(&detail, currency, foreign)
+		detail.TaxCode = taxCode.String
+
+		details = append(details, &detail)
+		detailMap[detailKey(detail.GetGlTransactionId(), detail.GetSequenceNumber())] = &detail
+	}
+
+	sqlstring2 := `SELECT m.inbGlTransactionId, m.intSequenceNumber, m.chvDimensionName, m.chvDimensionValue
+	FROM tb_GLTransactionDetailDimension AS m
+	WHERE m.inbGlTransactionId = ?
+	ORDER BY m.intSequenceNumber, m.chvDimensionName`
+
+	gResp := s.loadDetailDimensions(detailMap, sqlstring2, transactionId)
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	return resp, details
+}
+
+// Get a transaction together with its details.
+func (s *glService) GetTransactionWrapperHelper(transactionId int64, mserviceId int64) (*genericResponse, *pb.GLTransactionWrapper) {
+	gResp, tran := s.GetTransactionHelper(transactionId, mserviceId)
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	wrap := ConvertTransactionToWrapper(tran)
+
+	gResp, details := s.getTransactionDetails(transactionId)
+	if gResp.ErrorCode != 0 {
+		return gResp, nil
+	}
+
+	wrap.GlTransactionDetails = details
+
+	return gResp, wrap
+}
+
 func ConvertTransactionToWrapper(tran *pb.GLTransaction) *pb.GLTransactionWrapper {
 	wrap := pb.GLTransactionWrapper{}
 	wrap.GlTransactionId = tran.GetGlTransactionId()
@@ -649,6 +1918,16 @@ func ConvertTransactionToWrapper(tran *pb.GLTransaction) *pb.GLTransactionWrappe
 	wrap.ToPartyName = tran.GetToPartyName()
 	wrap.PostedViaKey = tran.GetPostedViaKey()
 	wrap.PostedViaDate = tran.GetPostedViaDate()
+	wrap.IsClosingEntry = tran.GetIsClosingEntry()
+	wrap.TransactionStatus = tran.GetTransactionStatus()
+	wrap.Posted = tran.GetPosted()
+	wrap.Voided = tran.GetVoided()
+	wrap.ReversesTransactionId = tran.GetReversesTransactionId()
+	wrap.ReversedByTransactionId = tran.GetReversedByTransactionId()
+	wrap.AutoReverseMode = tran.GetAutoReverseMode()
+	wrap.AutoReverseDate = tran.GetAutoReverseDate()
+	wrap.IntercompanyTransactionId = tran.GetIntercompanyTransactionId()
+	wrap.DueDate = tran.GetDueDate()
 
 	return &wrap
 }
@@ -0,0 +1,784 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// Totals of transaction details for a single general ledger account.
+type accountBalance struct {
+	accountId     []byte
+	parentId      []byte
+	accountName   string
+	accountCode   sql.NullString
+	accountTypeId int32
+	accountType   string
+	category      pb.AccountCategory
+	normalBalance pb.NormalBalance
+	debits        sdec.Decimal
+	credits       sdec.Decimal
+}
+
+// Net balance of the account, debits less credits.
+func (b *accountBalance) net() sdec.Decimal {
+	return b.debits.Sub(b.credits)
+}
+
+// Signed balance of the account, positive when on its normal balance side.
+func (b *accountBalance) balance() sdec.Decimal {
+	if b.normalBalance == pb.NormalBalance_NORMAL_BALANCE_CREDIT {
+		return b.net().Neg()
+	}
+
+	return b.net()
+}
+
+// Sign turning debits less credits into a balance positive on the normal balance side of the account category.
+func (b *accountBalance) normalSign() sdec.Decimal {
+	if categoryNormalBalance[b.category] == pb.NormalBalance_NORMAL_BALANCE_CREDIT {
+		return sdec.New(-1, 0)
+	}
+
+	return sdec.New(1, 0)
+}
+
+// Balance of the account as shown on financial reports, positive on the normal side of the report section.
+// Contra accounts are reported in the section of the accounts they offset, reducing its total.
+func (b *accountBalance) reportAmount() sdec.Decimal {
+	if categoryNormalBalance[baseCategory(b.category)] == pb.NormalBalance_NORMAL_BALANCE_CREDIT {
+		return b.net().Neg()
+	}
+
+	return b.net()
+}
+
+// Get the report section category for an account category, mapping contra categories to the category they offset.
+func baseCategory(category pb.AccountCategory) pb.AccountCategory {
+	switch category {
+	case pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_ASSET:
+		return pb.AccountCategory_ACCOUNT_CATEGORY_ASSET
+	case pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_LIABILITY:
+		return pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY
+	case pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_EQUITY:
+		return pb.AccountCategory_ACCOUNT_CATEGORY_EQUITY
+	case pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_REVENUE:
+		return pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE
+	case pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_EXPENSE:
+		return pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE
+	}
+
+	return category
+}
+
+// Selection criteria for accumulating account balances.
+// Either organizationId or accountId selects the accounts.
+type balanceFilter struct {
+	mserviceId     int64
+	organizationId []byte
+	accountId      []byte
+	startDate      sql.NullTime
+	endDate        time.Time
+	// leave out year end closing entries
+	excludeClosing bool
+	// only count transactions with this status
+	status pb.TransactionStatus
+	// fold balances of child accounts into their parents
+	rollup bool
+	// only count details tagged with this dimension value, or untagged details when the value is null
+	dimensionName  string
+	dimensionValue sql.NullString
+}
+
+// Restrict transaction details aliased d to a dimension value; takes the dimension name twice, then the value.
+const detailDimensionClause = `(? = '' OR (SELECT m.chvDimensionValue FROM tb_GLTransactionDetailDimension AS m
+	WHERE m.inbGlTransactionId = d.inbGlTransactionId AND m.intSequenceNumber = d.intSequenceNumber
+	AND m.chvDimensionName = ?) <=> ?)`
+
+// Apply the optional dimension filter of a report request; a name without a value selects untagged details.
+func (f *balanceFilter) setDimension(dim *pb.GLDetailDimension) {
+	if dim.GetDimensionName() != "" {
+		f.dimensionName = dim.GetDimensionName()
+		f.dimensionValue.String = dim.GetDimensionValue()
+		f.dimensionValue.Valid = dim.GetDimensionValue() != ""
+	}
+}
+
+// Reports count posted transactions unless another status is requested.
+func reportStatus(status pb.TransactionStatus) pb.TransactionStatus {
+	if status == pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED {
+		return pb.TransactionStatus_TRANSACTION_STATUS_POSTED
+	}
+
+	return status
+}
+
+// get general ledger trial balance for organization as of date
+func (s *glService) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	resp := &pb.GetTrialBalanceResponse{}
+
+	filter := balanceFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		endDate:        req.GetAsOfDate().TimeFromDateTime(),
+		status:         reportStatus(req.GetTransactionStatus()),
+		rollup:         req.GetRollupChildren(),
+	}
+
+	filter.setDimension(req.GetDimensionFilter())
+
+	balances, err := s.getAccountBalances(&filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getAccountBalances", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	report := newTrialBalanceReport(balances)
+
+	resp.GlAccountTypeBalances = report.typeBalances
+	resp.TotalDebits = decimalFromAmount(report.totalDebits)
+	resp.TotalCredits = decimalFromAmount(report.totalCredits)
+	resp.IsBalanced = report.totalDebits.Equal(report.totalCredits)
+
+	return resp, nil
+}
+
+// get general ledger account balance as of date
+func (s *glService) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	resp := &pb.GetAccountBalanceResponse{}
+
+	// without an account the filter would select every account of the mservice
+	if len(req.GetGlAccountId().GetGuid()) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "gl_account_id missing"
+		return resp, nil
+	}
+
+	filter := balanceFilter{
+		mserviceId: req.GetMserviceId(),
+		accountId:  req.GetGlAccountId().GetGuid(),
+		endDate:    req.GetAsOfDate().TimeFromDateTime(),
+		status:     reportStatus(req.GetTransactionStatus()),
+		rollup:     req.GetRollupChildren(),
+	}
+
+	filter.setDimension(req.GetDimensionFilter())
+
+	bal, gResp := s.getSingleAccountBalance(&filter)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlAccountBalance = convertAccountBalance(bal)
+
+	return resp, nil
+}
+
+// get general ledger account statement with running balance between dates
+func (s *glService) GetAccountLedger(ctx context.Context, req *pb.GetAccountLedgerRequest) (*pb.GetAccountLedgerResponse, error) {
+	resp := &pb.GetAccountLedgerResponse{}
+
+	// without an account the filter would select every account of the mservice
+	if len(req.GetGlAccountId().GetGuid()) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "gl_account_id missing"
+		return resp, nil
+	}
+
+	start_date := req.GetStartDate().TimeFromDateTime()
+	end_date := req.GetEndDate().TimeFromDateTime()
+
+	// opening balance includes everything before the start date
+	filter := balanceFilter{
+		mserviceId: req.GetMserviceId(),
+		accountId:  req.GetGlAccountId().GetGuid(),
+		endDate:    start_date.Add(-time.Second),
+		status:     reportStatus(req.GetTransactionStatus()),
+	}
+
+	filter.setDimension(req.GetDimensionFilter())
+
+	opening, gResp := s.getSingleAccountBalance(&filter)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlAccountId, _ = dml.GuidFromBytes(opening.accountId)
+	resp.AccountName = opening.accountName
+	// balances are signed like those of get_account_balance, positive on the normal side of the account
+	sign := opening.normalSign()
+	resp.OpeningBalance = decimalFromAmount(opening.net().Mul(sign))
+
+	sqlstring := `SELECT t.inbGlTransactionId, d.intSequenceNumber, t.dtmTransactionDate, t.chvTransactionDescription,
+	t.intTransactionTypeId, y.chvTransactionType, t.inbFromPartyId, f.chvPartyName, t.inbToPartyId, p.chvPartyName,
+	t.chvPostedViaKey, d.decAmount, d.bitIsDebit
+	FROM tb_GLTransactionDetail AS d
+	JOIN tb_GLTransaction AS t
+	ON d.inbGlTransactionId = t.inbGlTransactionId
+	JOIN tb_GLTransactionType AS y
+	ON t.inbMserviceId = y.inbMserviceId AND t.intTransactionTypeId = y.intTransactionTypeId
+	LEFT JOIN tb_GLParty AS f
+	ON t.inbMserviceId = f.inbMserviceId AND t.inbFromPartyId = f.inbPartyId
+	LEFT JOIN tb_GLParty AS p
+	ON t.inbMserviceId = p.inbMserviceId AND t.inbToPartyId = p.inbPartyId
+	WHERE d.uidGlAccountId = ? AND t.inbMserviceId = ? AND t.dtmTransactionDate >= ? AND t.dtmTransactionDate <= ?
+	AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ? AND ` + detailDimensionClause + `
+	ORDER BY t.dtmTransactionDate, t.inbGlTransactionId, d.intSequenceNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetGlAccountId().GetGuid(), req.GetMserviceId(), start_date, end_date, int32(filter.status),
+		filter.dimensionName, filter.dimensionName, filter.dimensionValue)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	running := opening.net()
+	debits := sdec.Zero
+	credits := sdec.Zero
+
+	for rows.Next() {
+		var from_party sql.NullInt64
+		var from_name sql.NullString
+		var to_party sql.NullInt64
+		var to_name sql.NullString
+		var via_key sql.NullString
+		var trandate time.Time
+		var amount string
+		var entry pb.GLLedgerEntry
+
+		err := rows.Scan(&entry.GlTransactionId, &entry.SequenceNumber, &trandate, &entry.TransactionDescription,
+			&entry.TransactionTypeId, &entry.TransactionType, &from_party, &from_name, &to_party, &to_name,
+			&via_key, &amount, &entry.IsDebit)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		entry.TransactionDate = dml.DateTimeFromTime(trandate)
+		if from_party.Valid {
+			entry.FromPartyId = from_party.Int64
+			entry.FromPartyName = from_name.String
+		}
+		if to_party.Valid {
+			entry.ToPartyId = to_party.Int64
+			entry.ToPartyName = to_name.String
+		}
+		if via_key.Valid {
+			entry.PostedViaKey = via_key.String
+		}
+
+		amt, err := sdec.NewFromString(amount)
+		if err != nil {
+			level.Error(s.logger).Log("what", "NewFromString", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		if entry.IsDebit {
+			debits = debits.Add(amt)
+			running = running.Add(amt)
+		} else {
+			credits = credits.Add(amt)
+			running = running.Sub(amt)
+		}
+
+		entry.Amount = decimalFromAmount(amt)
+		entry.RunningBalance = decimalFromAmount(running.Mul(sign))
+
+		resp.GlLedgerEntries = append(resp.GlLedgerEntries, &entry)
+	}
+
+	resp.TotalDebits = decimalFromAmount(debits)
+	resp.TotalCredits = decimalFromAmount(credits)
+	resp.ClosingBalance = decimalFromAmount(running.Mul(sign))
+
+	return resp, nil
+}
+
+// get general ledger balance sheet for organization as of date
+func (s *glService) GetBalanceSheet(ctx context.Context, req *pb.GetBalanceSheetRequest) (*pb.GetBalanceSheetResponse, error) {
+	resp := &pb.GetBalanceSheetResponse{}
+
+	filter := balanceFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		endDate:        req.GetAsOfDate().TimeFromDateTime(),
+		status:         reportStatus(req.GetTransactionStatus()),
+		rollup:         req.GetRollupChildren(),
+	}
+
+	filter.setDimension(req.GetDimensionFilter())
+
+	balances, gResp := s.getReportBalances(&filter)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	report := newBalanceSheetReport(balances)
+
+	resp.AssetLines = report.assetLines
+	resp.LiabilityLines = report.liabilityLines
+	resp.EquityLines = report.equityLines
+	resp.TotalAssets = decimalFromAmount(report.totalAssets)
+	resp.TotalLiabilities = decimalFromAmount(report.totalLiabilities)
+	resp.CurrentNetIncome = decimalFromAmount(report.netIncome)
+	resp.TotalEquity = decimalFromAmount(report.totalEquity)
+	resp.TotalLiabilitiesAndEquity = decimalFromAmount(report.totalLiabilities.Add(report.totalEquity))
+	resp.IsBalanced = report.totalAssets.Equal(report.totalLiabilities.Add(report.totalEquity))
+
+	return resp, nil
+}
+
+// get general ledger income statement for organization between dates
+func (s *glService) GetIncomeStatement(ctx context.Context, req *pb.GetIncomeStatementRequest) (*pb.GetIncomeStatementResponse, error) {
+	resp := &pb.GetIncomeStatementResponse{}
+
+	filter := balanceFilter{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: req.GetOrganizationId().GetGuid(),
+		endDate:        req.GetEndDate().TimeFromDateTime(),
+		excludeClosing: true,
+		status:         reportStatus(req.GetTransactionStatus()),
+		rollup:         req.GetRollupChildren(),
+	}
+
+	filter.setDimension(req.GetDimensionFilter())
+
+	filter.startDate.Time = req.GetStartDate().TimeFromDateTime()
+	filter.startDate.Valid = true
+
+	balances, gResp := s.getReportBalances(&filter)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	report := newIncomeStatementReport(balances)
+
+	resp.RevenueLines = report.revenueLines
+	resp.ExpenseLines = report.expenseLines
+	resp.TotalRevenue = decimalFromAmount(report.totalRevenue)
+	resp.TotalExpenses = decimalFromAmount(report.totalExpenses)
+	resp.NetIncome = decimalFromAmount(report.totalRevenue.Sub(report.totalExpenses))
+
+	return resp, nil
+}
+
+// Trial balance lines grouped by account type, with grand totals.
+type trialBalanceReport struct {
+	typeBalances []*pb.GLAccountTypeBalance
+	totalDebits  sdec.Decimal
+	totalCredits sdec.Decimal
+}
+
+// Group account balances, ordered by account type, into a trial balance.
+func newTrialBalanceReport(balances []*accountBalance) *trialBalanceReport {
+	report := trialBalanceReport{totalDebits: sdec.Zero, totalCredits: sdec.Zero}
+
+	var typeBalance *pb.GLAccountTypeBalance
+	var typeDebits sdec.Decimal
+	var typeCredits sdec.Decimal
+
+	for _, bal := range balances {
+		if (typeBalance == nil) || (typeBalance.AccountTypeId != bal.accountTypeId) {
+			typeBalance = &pb.GLAccountTypeBalance{}
+			typeBalance.AccountTypeId = bal.accountTypeId
+			typeBalance.AccountType = bal.accountType
+			typeDebits = sdec.Zero
+			typeCredits = sdec.Zero
+			report.typeBalances = append(report.typeBalances, typeBalance)
+		}
+
+		typeBalance.GlAccountBalances = append(typeBalance.GlAccountBalances, convertAccountBalance(bal))
+
+		typeDebits = typeDebits.Add(bal.debits)
+		typeCredits = typeCredits.Add(bal.credits)
+		typeBalance.TotalDebits = decimalFromAmount(typeDebits)
+		typeBalance.TotalCredits = decimalFromAmount(typeCredits)
+		typeBalance.NetBalance = decimalFromAmount(typeDebits.Sub(typeCredits))
+
+		report.totalDebits = report.totalDebits.Add(bal.debits)
+		report.totalCredits = report.totalCredits.Add(bal.credits)
+	}
+
+	return &report
+}
+
+// Balance sheet sections and totals.
+type balanceSheetReport struct {
+	assetLines       []*pb.GLReportLine
+	liabilityLines   []*pb.GLReportLine
+	equityLines      []*pb.GLReportLine
+	totalAssets      sdec.Decimal
+	totalLiabilities sdec.Decimal
+	// includes netIncome
+	totalEquity sdec.Decimal
+	netIncome   sdec.Decimal
+}
+
+// Sort account balances into the sections of a balance sheet.
+func newBalanceSheetReport(balances []*accountBalance) *balanceSheetReport {
+	report := balanceSheetReport{totalAssets: sdec.Zero, totalLiabilities: sdec.Zero, totalEquity: sdec.Zero, netIncome: sdec.Zero}
+
+	for _, bal := range balances {
+		amount := bal.reportAmount()
+
+		switch baseCategory(bal.category) {
+		case pb.AccountCategory_ACCOUNT_CATEGORY_ASSET:
+			report.assetLines = append(report.assetLines, convertReportLine(bal))
+			report.totalAssets = report.totalAssets.Add(amount)
+		case pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY:
+			report.liabilityLines = append(report.liabilityLines, convertReportLine(bal))
+			report.totalLiabilities = report.totalLiabilities.Add(amount)
+		case pb.AccountCategory_ACCOUNT_CATEGORY_EQUITY:
+			report.equityLines = append(report.equityLines, convertReportLine(bal))
+			report.totalEquity = report.totalEquity.Add(amount)
+		case pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE:
+			report.netIncome = report.netIncome.Add(amount)
+		case pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE:
+			report.netIncome = report.netIncome.Sub(amount)
+		}
+	}
+
+	// roll revenue and expense not yet closed into equity, so no closing entry is needed to balance
+	report.totalEquity = report.totalEquity.Add(report.netIncome)
+
+	return &report
+}
+
+// Income statement sections and totals.
+type incomeStatementReport struct {
+	revenueLines  []*pb.GLReportLine
+	expenseLines  []*pb.GLReportLine
+	totalRevenue  sdec.Decimal
+	totalExpenses sdec.Decimal
+}
+
+// Sort account balances into the sections of an income statement.
+func newIncomeStatementReport(balances []*accountBalance) *incomeStatementReport {
+	report := incomeStatementReport{totalRevenue: sdec.Zero, totalExpenses: sdec.Zero}
+
+	for _, bal := range balances {
+		switch baseCategory(bal.category) {
+		case pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE:
+			report.revenueLines = append(report.revenueLines, convertReportLine(bal))
+			report.totalRevenue = report.totalRevenue.Add(bal.reportAmount())
+		case pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE:
+			report.expenseLines = append(report.expenseLines, convertReportLine(bal))
+			report.totalExpenses = report.totalExpenses.Add(bal.reportAmount())
+		}
+	}
+
+	return &report
+}
+
+// Get account balances for a financial report, which requires every account type to be classified.
+func (s *glService) getReportBalances(filter *balanceFilter) ([]*accountBalance, *genericResponse) {
+	resp := &genericResponse{}
+
+	balances, err := s.getAccountBalances(filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getAccountBalances", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return nil, resp
+	}
+
+	resp = checkReportCategories(balances)
+	if resp.ErrorCode != 0 {
+		return nil, resp
+	}
+
+	return balances, resp
+}
+
+// Check that the account type of every balance is classified for financial reports.
+func checkReportCategories(balances []*accountBalance) *genericResponse {
+	resp := &genericResponse{}
+
+	for _, bal := range balances {
+		if (bal.category == pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED) ||
+			(bal.normalBalance == pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED) {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = fmt.Sprintf("account type %d has no account_category or normal_balance", bal.accountTypeId)
+			return resp
+		}
+	}
+
+	return resp
+}
+
+// Get the balance of the single account selected by filter.accountId.
+func (s *glService) getSingleAccountBalance(filter *balanceFilter) (*accountBalance, *genericResponse) {
+	resp := &genericResponse{}
+
+	balances, err := s.getAccountBalances(filter)
+	if err != nil {
+		level.Error(s.logger).Log("what", "getAccountBalances", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return nil, resp
+	}
+
+	if len(balances) == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return nil, resp
+	}
+
+	return balances[0], resp
+}
+
+// Queries shared by the database and a database transaction.
+type balanceQuerier interface {
+	Prepare(query string) (*sql.Stmt, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// Accumulate debit and credit totals for every selected account, ordered by account type.
+// Details of deleted transactions are excluded.
+func (s *glService) getAccountBalances(filter *balanceFilter) ([]*accountBalance, error) {
+	return queryAccountBalances(s.db, filter)
+}
+
+// Accumulate account balances through the database or a database transaction.
+func queryAccountBalances(q balanceQuerier, filter *balanceFilter) ([]*accountBalance, error) {
+	organizationId := filter.organizationId
+	accountId := filter.accountId
+
+	// the children of a selected account are needed to roll it up, so select its whole organization
+	if filter.rollup && (accountId != nil) {
+		err := q.QueryRow(`SELECT uidOrganizationId FROM tb_GLAccount WHERE uidGlAccountId = ? AND inbMserviceId = ?`,
+			accountId, filter.mserviceId).Scan(&organizationId)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		accountId = nil
+	}
+
+	sqlstring := `SELECT a.uidGlAccountId, a.uidParentAccountId, a.chvAccountName, a.chvAccountCode, a.intAccountTypeId, y.chvAccountType, y.intAccountCategory, y.intNormalBalance,
+	COALESCE(b.decDebits, 0), COALESCE(b.decCredits, 0)
+	FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	LEFT JOIN (SELECT d.uidGlAccountId,
+		SUM(CASE WHEN d.bitIsDebit = 1 THEN d.decAmount ELSE 0 END) AS decDebits,
+		SUM(CASE WHEN d.bitIsDebit = 0 THEN d.decAmount ELSE 0 END) AS decCredits
+		FROM tb_GLTransaction AS t
+		JOIN tb_GLTransactionDetail AS d
+		ON t.inbGlTransactionId = d.inbGlTransactionId
+		WHERE t.inbMserviceId = ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ? AND (? = 0 OR t.bitIsClosingEntry = 0)
+		AND (? IS NULL OR t.dtmTransactionDate >= ?) AND t.dtmTransactionDate <= ?
+		AND ` + detailDimensionClause + `
+		GROUP BY d.uidGlAccountId) AS b
+	ON a.uidGlAccountId = b.uidGlAccountId
+	WHERE a.inbMserviceId = ? AND (? IS NULL OR a.uidOrganizationId = ?) AND (? IS NULL OR a.uidGlAccountId = ?)
+	AND (a.bitIsDeleted = 0 OR b.uidGlAccountId IS NOT NULL)
+	ORDER BY a.intAccountTypeId, ` + accountCodeOrder + `, a.chvAccountName`
+
+	stmt, err := q.Prepare(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(filter.mserviceId, int32(filter.status), filter.excludeClosing, filter.startDate, filter.startDate, filter.endDate,
+		filter.dimensionName, filter.dimensionName, filter.dimensionValue, filter.mserviceId, organizationId, organizationId, accountId, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var balances []*accountBalance
+
+	for rows.Next() {
+		var bal accountBalance
+		var category int32
+		var normalBalance int32
+		var debits string
+		var credits string
+
+		err := rows.Scan(&bal.accountId, &bal.parentId, &bal.accountName, &bal.accountCode, &bal.accountTypeId, &bal.accountType, &category, &normalBalance,
+			&debits, &credits)
+		if err != nil {
+			return nil, err
+		}
+
+		bal.category = pb.AccountCategory(category)
+		bal.normalBalance = pb.NormalBalance(normalBalance)
+
+		bal.debits, err = sdec.NewFromString(debits)
+		if err != nil {
+			return nil, err
+		}
+
+		bal.credits, err = sdec.NewFromString(credits)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, &bal)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.rollup {
+		balances = rollupBalances(balances, filter.accountId)
+	}
+
+	return balances, nil
+}
+
+// Fold the balances of child accounts into their ancestors. When accountId is set only that account is kept,
+// otherwise only top level accounts are kept, each including the balances of all accounts below it.
+func rollupBalances(balances []*accountBalance, accountId []byte) []*accountBalance {
+	byId := make(map[string]*accountBalance)
+	for _, bal := range balances {
+		byId[string(bal.accountId)] = bal
+	}
+
+	targets := make([]*accountBalance, len(balances))
+	totals := make(map[*accountBalance]*accountBalance)
+
+	for i, bal := range balances {
+		// walk up the tree, bounded in case the stored parents form a cycle
+		current := bal
+		for steps := 0; steps < len(balances); steps++ {
+			if (accountId != nil) && (string(current.accountId) == string(accountId)) {
+				break
+			}
+
+			parent, ok := byId[string(current.parentId)]
+			if !ok {
+				break
+			}
+			current = parent
+		}
+
+		if (accountId != nil) && (string(current.accountId) != string(accountId)) {
+			continue
+		}
+
+		targets[i] = current
+		totals[current] = nil
+	}
+
+	var result []*accountBalance
+
+	// keep the original ordering of the accounts that remain
+	for _, bal := range balances {
+		if _, ok := totals[bal]; ok {
+			total := *bal
+			total.debits = sdec.Zero
+			total.credits = sdec.Zero
+			totals[bal] = &total
+			result = append(result, &total)
+		}
+	}
+
+	for i, bal := range balances {
+		if targets[i] != nil {
+			total := totals[targets[i]]
+			total.debits = total.debits.Add(bal.debits)
+			total.credits = total.credits.Add(bal.credits)
+		}
+	}
+
+	return result
+}
+
+// Convert internal account balance to the api entity.
+func convertAccountBalance(bal *accountBalance) *pb.GLAccountBalance {
+	result := pb.GLAccountBalance{}
+	result.GlAccountId, _ = dml.GuidFromBytes(bal.accountId)
+	result.AccountName = bal.accountName
+	result.AccountCode = bal.accountCode.String
+	result.AccountTypeId = bal.accountTypeId
+	result.AccountType = bal.accountType
+	result.TotalDebits = decimalFromAmount(bal.debits)
+	result.TotalCredits = decimalFromAmount(bal.credits)
+	result.NetBalance = decimalFromAmount(bal.net())
+	result.AccountCategory = bal.category
+	result.NormalBalance = bal.normalBalance
+	result.Balance = decimalFromAmount(bal.balance())
+
+	return &result
+}
+
+// Convert internal account balance to a financial report line.
+func convertReportLine(bal *accountBalance) *pb.GLReportLine {
+	result := pb.GLReportLine{}
+	result.GlAccountId, _ = dml.GuidFromBytes(bal.accountId)
+	result.AccountName = bal.accountName
+	result.AccountCode = bal.accountCode.String
+	result.AccountTypeId = bal.accountTypeId
+	result.AccountType = bal.accountType
+	result.AccountCategory = bal.category
+	result.Amount = decimalFromAmount(bal.reportAmount())
+
+	return &result
+}
+
+// Convert shopspring decimal to dml.Decimal with two decimal places, matching decAmount.
+func decimalFromAmount(d sdec.Decimal) *dml.Decimal {
+	return &dml.Decimal{Plaintext: d.StringFixed(2)}
+}
@@ -0,0 +1,262 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// An asset account balance below a parent account, none when parent is 0.
+func testChildBalance(n byte, parent byte, debits string, credits string) *accountBalance {
+	bal := testBalance(n, pb.AccountCategory_ACCOUNT_CATEGORY_ASSET, debits, credits)
+	if parent != 0 {
+		bal.parentId = testAccountId(parent)
+	}
+
+	return bal
+}
+
+func TestRollupBalances(t *testing.T) {
+	type wantTotal struct {
+		account byte
+		debits  string
+		credits string
+	}
+
+	tree := func() []*accountBalance {
+		return []*accountBalance{
+			testChildBalance(1, 0, "100", "0"),
+			testChildBalance(2, 1, "50", "0"),
+			testChildBalance(3, 2, "0", "20"),
+			testChildBalance(4, 0, "0", "10"),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		balances  []*accountBalance
+		accountId byte
+		want      []wantTotal
+	}{
+		{
+			name:     "top level accounts",
+			balances: tree(),
+			want:     []wantTotal{{1, "150", "20"}, {4, "0", "10"}},
+		},
+		{
+			name:      "one account with its children",
+			balances:  tree(),
+			accountId: 2,
+			want:      []wantTotal{{2, "50", "20"}},
+		},
+		{
+			name:      "leaf account",
+			balances:  tree(),
+			accountId: 3,
+			want:      []wantTotal{{3, "0", "20"}},
+		},
+		{
+			name: "parent outside the selection",
+			balances: []*accountBalance{
+				testChildBalance(2, 1, "50", "0"),
+				testChildBalance(3, 2, "0", "20"),
+			},
+			want: []wantTotal{{2, "50", "20"}},
+		},
+		{
+			name: "parents forming a cycle",
+			balances: []*accountBalance{
+				testChildBalance(5, 6, "30", "0"),
+				testChildBalance(6, 5, "0", "40"),
+			},
+			want: []wantTotal{{5, "30", "0"}, {6, "0", "40"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var accountId []byte
+			if tt.accountId != 0 {
+				accountId = testAccountId(tt.accountId)
+			}
+
+			before := make([]string, len(tt.balances))
+			for i, bal := range tt.balances {
+				before[i] = bal.net().String()
+			}
+
+			got := rollupBalances(tt.balances, accountId)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d balances, want %d", len(got), len(tt.want))
+			}
+
+			for i, bal := range got {
+				want := tt.want[i]
+				if bal.accountId[15] != want.account {
+					t.Errorf("balance %d account %d, want %d", i, bal.accountId[15], want.account)
+				}
+
+				if (bal.debits.String() != want.debits) || (bal.credits.String() != want.credits) {
+					t.Errorf("account %d debits %s credits %s, want %s and %s", want.account, bal.debits, bal.credits,
+						want.debits, want.credits)
+				}
+			}
+
+			// the selected balances are left as they were
+			for i, bal := range tt.balances {
+				if bal.net().String() != before[i] {
+					t.Errorf("input balance %d changed from %s to %s", i, before[i], bal.net())
+				}
+			}
+		})
+	}
+}
+
+func TestBalanceSigns(t *testing.T) {
+	tests := []struct {
+		name       string
+		category   pb.AccountCategory
+		debits     string
+		credits    string
+		wantNet    string
+		wantSigned string
+		wantReport string
+	}{
+		{
+			name:       "asset",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_ASSET,
+			debits:     "100",
+			credits:    "30",
+			wantNet:    "70",
+			wantSigned: "70",
+			wantReport: "70",
+		},
+		{
+			name:       "liability",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY,
+			debits:     "30",
+			credits:    "100",
+			wantNet:    "-70",
+			wantSigned: "70",
+			wantReport: "70",
+		},
+		{
+			name:       "revenue with a debit balance",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE,
+			debits:     "100",
+			credits:    "30",
+			wantNet:    "70",
+			wantSigned: "-70",
+			wantReport: "-70",
+		},
+		{
+			name:       "contra asset reduces the assets",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_ASSET,
+			debits:     "0",
+			credits:    "40",
+			wantNet:    "-40",
+			wantSigned: "40",
+			wantReport: "-40",
+		},
+		{
+			name:       "contra revenue reduces the revenue",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_REVENUE,
+			debits:     "15",
+			credits:    "0",
+			wantNet:    "15",
+			wantSigned: "15",
+			wantReport: "-15",
+		},
+		{
+			name:       "unclassified account",
+			category:   pb.AccountCategory_ACCOUNT_CATEGORY_UNSPECIFIED,
+			debits:     "0",
+			credits:    "25",
+			wantNet:    "-25",
+			wantSigned: "-25",
+			wantReport: "-25",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bal := testBalance(1, tt.category, tt.debits, tt.credits)
+			bal.normalBalance = categoryNormalBalance[tt.category]
+
+			if bal.net().String() != tt.wantNet {
+				t.Errorf("net %s, want %s", bal.net(), tt.wantNet)
+			}
+
+			if bal.balance().String() != tt.wantSigned {
+				t.Errorf("balance %s, want %s", bal.balance(), tt.wantSigned)
+			}
+
+			// the account ledger signs its balances like balance
+			if bal.net().Mul(bal.normalSign()).String() != tt.wantSigned {
+				t.Errorf("ledger balance %s, want %s", bal.net().Mul(bal.normalSign()), tt.wantSigned)
+			}
+
+			if bal.reportAmount().String() != tt.wantReport {
+				t.Errorf("report amount %s, want %s", bal.reportAmount(), tt.wantReport)
+			}
+		})
+	}
+}
+
+func TestSetDimension(t *testing.T) {
+	tests := []struct {
+		name      string
+		dim       *pb.GLDetailDimension
+		wantName  string
+		wantValue string
+		wantValid bool
+	}{
+		{
+			name: "no dimension filter",
+		},
+		{
+			name:      "dimension value",
+			dim:       &pb.GLDetailDimension{DimensionName: "cost_center", DimensionValue: "CC-100"},
+			wantName:  "cost_center",
+			wantValue: "CC-100",
+			wantValid: true,
+		},
+		{
+			name:     "untagged details of a dimension",
+			dim:      &pb.GLDetailDimension{DimensionName: "cost_center"},
+			wantName: "cost_center",
+		},
+		{
+			name: "value without a dimension",
+			dim:  &pb.GLDetailDimension{DimensionValue: "CC-100"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filter balanceFilter
+			filter.setDimension(tt.dim)
+
+			if filter.dimensionName != tt.wantName {
+				t.Errorf("dimension name %q, want %q", filter.dimensionName, tt.wantName)
+			}
+
+			if (filter.dimensionValue.String != tt.wantValue) || (filter.dimensionValue.Valid != tt.wantValid) {
+				t.Errorf("dimension value %v, want %q valid %t", filter.dimensionValue, tt.wantValue, tt.wantValid)
+			}
+		})
+	}
+}
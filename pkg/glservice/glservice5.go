@@ -0,0 +1,1119 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// weeks in each period of a quarter for a 4-4-5 calendar
+var fourFourFiveWeeks = []int{4, 4, 5}
+
+// create general ledger fiscal year with its periods
+func (s *glService) CreateFiscalYear(ctx context.Context, req *pb.CreateFiscalYearRequest) (*pb.CreateFiscalYearResponse, error) {
+	resp := &pb.CreateFiscalYearResponse{}
+
+	if req.GetFiscalYear() <= 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "fiscal_year invalid"
+		return resp, nil
+	}
+
+	if req.GetStartDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "start_date missing"
+		return resp, nil
+	}
+
+	periods, gResp := buildFiscalPeriods(req)
+	if gResp != nil {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	startDate := periods[0].GetStartDate().TimeFromDateTime()
+	endDate := periods[len(periods)-1].GetEndDate().TimeFromDateTime()
+
+	// make sure we are referring to a valid organization
+	sqlstring1 := `SELECT uidOrganizationId FROM tb_GLOrganization WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt1, err := s.db.Prepare(sqlstring1)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt1.Close()
+
+	var orgId []byte
+
+	err = stmt1.QueryRow(req.GetOrganizationId().GetGuid(), req.GetMserviceId()).Scan(&orgId)
+	if err != nil {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "organization not found"
+		return resp, nil
+	}
+
+	// fiscal years of an organization may not overlap
+	sqlstring2 := `SELECT intFiscalYear FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND inbMserviceId = ?
+	AND dtmStartDate <= ? AND dtmEndDate >= ?`
+
+	stmt2, err := s.db.Prepare(sqlstring2)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt2.Close()
+
+	var otherYear int32
+
+	err = stmt2.QueryRow(orgId, req.GetMserviceId(), endDate, startDate).Scan(&otherYear)
+	if err == nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("fiscal year overlaps fiscal year %d", otherYear)
+		return resp, nil
+	} else if err != sql.ErrNoRows {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	err = s.CommitFiscalYear(req, periods)
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = fmt.Sprintf("commit error: %s", err)
+		level.Error(s.logger).Log("what", "CommitFiscalYear", "error", err)
+		return resp, nil
+	}
+
+	resp.Version = 1
+
+	return resp, nil
+}
+
+// delete general ledger fiscal year with its periods
+func (s *glService) DeleteFiscalYear(ctx context.Context, req *pb.DeleteFiscalYearRequest) (*pb.DeleteFiscalYearResponse, error) {
+	resp := &pb.DeleteFiscalYearResponse{}
+
+	// only a fiscal year with every period open may be deleted
+	sqlstring := `SELECT COUNT(*) FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?
+	AND intPeriodStatus <> ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var closedCount int
+
+	err = stmt.QueryRow(req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetMserviceId(),
+		int32(pb.PeriodStatus_PERIOD_STATUS_OPEN)).Scan(&closedCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if closedCount > 0 {
+		resp.ErrorCode = 403
+		resp.ErrorMessage = "fiscal year has closed periods"
+		return resp, nil
+	}
+
+	var budgetCount int
+
+	err = s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLBudget WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`,
+		req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetMserviceId()).Scan(&budgetCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if budgetCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fiscal year has budgets"
+		return resp, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	// unlike other entities the year is removed outright, so that it can be defined again with other dates
+	res, err := tx.Exec(`DELETE FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND intFiscalYear = ? AND intVersion = ? AND inbMserviceId = ?`,
+		req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetVersion(), req.GetMserviceId())
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	_, err = tx.Exec(`DELETE FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?`,
+		req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetMserviceId())
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+
+	return resp, nil
+}
+
+// get general ledger fiscal years by organization
+func (s *glService) GetFiscalYearsByOrganization(ctx context.Context, req *pb.GetFiscalYearsByOrganizationRequest) (*pb.GetFiscalYearsByOrganizationResponse, error) {
+	resp := &pb.GetFiscalYearsByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, intFiscalYear, dtmCreated, dtmModified, intVersion, inbMserviceId, dtmStartDate,
+	dtmEndDate, intCalendarType, bitIsClosed, inbClosingTransactionId
+	FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND inbMserviceId = ?
+	ORDER BY intFiscalYear`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var year pb.GLFiscalYear
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+		var startDate time.Time
+		var endDate time.Time
+		var calendarType int32
+		var closingId sql.NullInt64
+
+		err := rows.Scan(&orgId, &year.FiscalYear, &created, &modified, &year.Version, &year.MserviceId, &startDate,
+			&endDate, &calendarType, &year.IsClosed, &closingId)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		year.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		year.Created = dml.DateTimeFromTime(created)
+		year.Modified = dml.DateTimeFromTime(modified)
+		year.StartDate = dml.DateTimeFromTime(startDate)
+		year.EndDate = dml.DateTimeFromTime(endDate)
+		year.CalendarType = pb.FiscalCalendarType(calendarType)
+		if closingId.Valid {
+			year.ClosingTransactionId = closingId.Int64
+		}
+
+		resp.GlFiscalYears = append(resp.GlFiscalYears, &year)
+	}
+
+	return resp, nil
+}
+
+// get general ledger fiscal periods by fiscal year
+func (s *glService) GetFiscalPeriodsByYear(ctx context.Context, req *pb.GetFiscalPeriodsByYearRequest) (*pb.GetFiscalPeriodsByYearResponse, error) {
+	resp := &pb.GetFiscalPeriodsByYearResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, intFiscalYear, intPeriodNumber, dtmCreated, dtmModified, intVersion, inbMserviceId,
+	chvPeriodName, dtmStartDate, dtmEndDate, intPeriodStatus
+	FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?
+	ORDER BY intPeriodNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var period pb.GLFiscalPeriod
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+		var startDate time.Time
+		var endDate time.Time
+		var status int32
+
+		err := rows.Scan(&orgId, &period.FiscalYear, &period.PeriodNumber, &created, &modified, &period.Version,
+			&period.MserviceId, &period.PeriodName, &startDate, &endDate, &status)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		period.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		period.Created = dml.DateTimeFromTime(created)
+		period.Modified = dml.DateTimeFromTime(modified)
+		period.StartDate = dml.DateTimeFromTime(startDate)
+		period.EndDate = dml.DateTimeFromTime(endDate)
+		period.PeriodStatus = pb.PeriodStatus(status)
+
+		resp.GlFiscalPeriods = append(resp.GlFiscalPeriods, &period)
+	}
+
+	return resp, nil
+}
+
+// update status of general ledger fiscal period
+func (s *glService) UpdateFiscalPeriodStatus(ctx context.Context, req *pb.UpdateFiscalPeriodStatusRequest) (*pb.UpdateFiscalPeriodStatusResponse, error) {
+	resp := &pb.UpdateFiscalPeriodStatusResponse{}
+
+	status := req.GetPeriodStatus()
+	if (status <= pb.PeriodStatus_PERIOD_STATUS_UNSPECIFIED) || (status > pb.PeriodStatus_PERIOD_STATUS_HARD_LOCKED) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "period_status missing or invalid"
+		return resp, nil
+	}
+
+	sqlstring1 := `SELECT intPeriodStatus FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND intFiscalYear = ?
+	AND intPeriodNumber = ? AND inbMserviceId = ?`
+
+	stmt1, err := s.db.Prepare(sqlstring1)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt1.Close()
+
+	var current int32
+
+	err = stmt1.QueryRow(req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetPeriodNumber(), req.GetMserviceId()).Scan(&current)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	// a hard locked period stays fixed
+	if pb.PeriodStatus(current) == pb.PeriodStatus_PERIOD_STATUS_HARD_LOCKED {
+		resp.ErrorCode = 403
+		resp.ErrorMessage = "fiscal period is locked"
+		return resp, nil
+	}
+
+	sqlstring2 := `UPDATE tb_GLFiscalPeriod SET dtmModified = NOW(), intVersion = ?, intPeriodStatus = ?
+	WHERE uidOrganizationId = ? AND intFiscalYear = ? AND intPeriodNumber = ? AND intVersion = ? AND inbMserviceId = ?`
+
+	stmt2, err := s.db.Prepare(sqlstring2)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt2.Close()
+
+	res, err := stmt2.Exec(req.GetVersion()+1, int32(status), req.GetOrganizationId().GetGuid(), req.GetFiscalYear(),
+		req.GetPeriodNumber(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// close general ledger fiscal year to retained earnings
+func (s *glService) CloseFiscalYear(ctx context.Context, req *pb.CloseFiscalYearRequest) (*pb.CloseFiscalYearResponse, error) {
+	resp := &pb.CloseFiscalYearResponse{}
+
+	orgId := req.GetOrganizationId().GetGuid()
+
+	gResp, reAccount := s.getRetainedEarningsAccount(req.GetMserviceId(), orgId)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, year := s.GetFiscalYearHelper(req.GetMserviceId(), orgId, req.GetFiscalYear())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if year.GetIsClosed() {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fiscal year is already closed"
+		return resp, nil
+	}
+
+	// fiscal years are closed in order
+	sqlstring1 := `SELECT COUNT(*) FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND inbMserviceId = ? AND intFiscalYear < ?
+	AND bitIsClosed = 0`
+
+	stmt1, err := s.db.Prepare(sqlstring1)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt1.Close()
+
+	var openCount int
+
+	err = stmt1.QueryRow(orgId, req.GetMserviceId(), req.GetFiscalYear()).Scan(&openCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if openCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "earlier fiscal year is not closed"
+		return resp, nil
+	}
+
+	// make sure we are referring to a valid transaction type
+	sqlstring2 := `SELECT intTransactionTypeId FROM tb_GLTransactionType WHERE intTransactionTypeId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt2, err := s.db.Prepare(sqlstring2)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt2.Close()
+
+	var typeId int32
+
+	err = stmt2.QueryRow(req.GetTransactionTypeId(), req.GetMserviceId()).Scan(&typeId)
+	if err != nil {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "transaction type not found"
+		return resp, nil
+	}
+
+	endDate := year.GetEndDate().TimeFromDateTime()
+
+	entry := yearEndEntry{
+		mserviceId:      req.GetMserviceId(),
+		organizationId:  orgId,
+		fiscalYear:      req.GetFiscalYear(),
+		version:         req.GetVersion(),
+		transactionDate: startOfDay(endDate).Add(12 * time.Hour),
+		description:     fmt.Sprintf("close fiscal year %d", req.GetFiscalYear()),
+		typeId:          req.GetTransactionTypeId(),
+		closing:         true,
+		endDate:         endDate,
+		reAccountId:     reAccount,
+	}
+
+	gResp, tranId := s.CommitYearEndEntry(&entry)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+	resp.GlTransactionId = tranId
+
+	return resp, nil
+}
+
+// reopen closed general ledger fiscal year
+func (s *glService) ReopenFiscalYear(ctx context.Context, req *pb.ReopenFiscalYearRequest) (*pb.ReopenFiscalYearResponse, error) {
+	resp := &pb.ReopenFiscalYearResponse{}
+
+	orgId := req.GetOrganizationId().GetGuid()
+
+	gResp, year := s.GetFiscalYearHelper(req.GetMserviceId(), orgId, req.GetFiscalYear())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if !year.GetIsClosed() {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "fiscal year is not closed"
+		return resp, nil
+	}
+
+	// fiscal years are reopened in reverse order
+	sqlstring := `SELECT COUNT(*) FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND inbMserviceId = ? AND intFiscalYear > ?
+	AND bitIsClosed = 1`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var closedCount int
+
+	err = stmt.QueryRow(orgId, req.GetMserviceId(), req.GetFiscalYear()).Scan(&closedCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if closedCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "later fiscal year is closed"
+		return resp, nil
+	}
+
+	entry := yearEndEntry{
+		mserviceId:     req.GetMserviceId(),
+		organizationId: orgId,
+		fiscalYear:     req.GetFiscalYear(),
+		version:        req.GetVersion(),
+		description:    fmt.Sprintf("reopen fiscal year %d", req.GetFiscalYear()),
+	}
+
+	// the reversing entry mirrors the closing entry, if there was one
+	if year.GetClosingTransactionId() != 0 {
+		gResp, tran := s.GetTransactionHelper(year.GetClosingTransactionId(), req.GetMserviceId())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		gResp, details := s.getTransactionDetails(tran.GetGlTransactionId())
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		for _, detail := range details {
+			detail.IsDebit = !detail.IsDebit
+		}
+
+		entry.transactionDate = tran.GetTransactionDate().TimeFromDateTime()
+		entry.typeId = tran.GetTransactionTypeId()
+		entry.details = details
+		entry.reversesId = tran.GetGlTransactionId()
+	}
+
+	gResp, tranId := s.CommitYearEndEntry(&entry)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+	resp.GlTransactionId = tranId
+
+	return resp, nil
+}
+
+// Insert a fiscal year and its periods in a single database transaction.
+func (s *glService) CommitFiscalYear(req *pb.CreateFiscalYearRequest, periods []*pb.GLFiscalPeriod) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	sqlstring1 := `INSERT INTO tb_GLFiscalYear (uidOrganizationId, intFiscalYear, dtmCreated, dtmModified, intVersion, inbMserviceId,
+	dtmStartDate, dtmEndDate, intCalendarType, bitIsClosed) VALUES (?, ?, NOW(), NOW(), 1, ?, ?, ?, ?, 0)`
+
+	_, err = tx.Exec(sqlstring1, req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), req.GetMserviceId(),
+		periods[0].GetStartDate().TimeFromDateTime(), periods[len(periods)-1].GetEndDate().TimeFromDateTime(),
+		int32(req.GetCalendarType()))
+	if err != nil {
+		return err
+	}
+
+	sqlstring2 := `INSERT INTO tb_GLFiscalPeriod (uidOrganizationId, intFiscalYear, intPeriodNumber, dtmCreated, dtmModified, intVersion,
+	inbMserviceId, chvPeriodName, dtmStartDate, dtmEndDate, intPeriodStatus) VALUES (?, ?, ?, NOW(), NOW(), 1, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(sqlstring2)
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	for _, period := range periods {
+		_, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetFiscalYear(), period.GetPeriodNumber(), req.GetMserviceId(),
+			period.GetPeriodName(), period.GetStartDate().TimeFromDateTime(), period.GetEndDate().TimeFromDateTime(),
+			int32(pb.PeriodStatus_PERIOD_STATUS_OPEN))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+
+	return err
+}
+
+// Year end closing or reopening entry for a fiscal year.
+type yearEndEntry struct {
+	mserviceId      int64
+	organizationId  []byte
+	fiscalYear      int32
+	version         int32
+	transactionDate time.Time
+	description     string
+	typeId          int32
+	details         []*pb.GLTransactionDetail
+	// closing the fiscal year rather than reopening it
+	closing bool
+	// last instant of the fiscal year, closing only
+	endDate time.Time
+	// retained earnings account receiving the net income, closing only
+	reAccountId []byte
+	// closing entry reversed when reopening
+	reversesId int64
+}
+
+// Insert a year end entry with its details and update the status of the fiscal year and its periods
+// in a single database transaction. Closing locks every period; reopening leaves them soft closed.
+// The closing details are computed after the periods are locked, so postings committed before the lock
+// are included. Postings check the period before starting their own transaction, so one that passed the
+// check just before the lock can still commit afterwards.
+// No transaction is inserted for an entry without details.
+func (s *glService) CommitYearEndEntry(entry *yearEndEntry) (*genericResponse, int64) {
+	resp := &genericResponse{}
+
+	var tranId int64
+	var closingId sql.NullInt64
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	periodStatus := pb.PeriodStatus_PERIOD_STATUS_SOFT_CLOSED
+	if entry.closing {
+		periodStatus = pb.PeriodStatus_PERIOD_STATUS_HARD_LOCKED
+	}
+
+	sqlstring4 := `UPDATE tb_GLFiscalPeriod SET dtmModified = NOW(), intVersion = intVersion + 1, intPeriodStatus = ?
+	WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?`
+
+	_, err = tx.Exec(sqlstring4, int32(periodStatus), entry.organizationId, entry.fiscalYear, entry.mserviceId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	if entry.closing {
+		filter := balanceFilter{
+			mserviceId:     entry.mserviceId,
+			organizationId: entry.organizationId,
+			endDate:        entry.endDate,
+			status:         pb.TransactionStatus_TRANSACTION_STATUS_POSTED,
+		}
+
+		balances, err := queryAccountBalances(tx, &filter)
+		if err != nil {
+			level.Error(s.logger).Log("what", "queryAccountBalances", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, 0
+		}
+
+		gResp := checkReportCategories(balances)
+		if gResp.ErrorCode != 0 {
+			return gResp, 0
+		}
+
+		entry.details = closingDetails(balances, entry.reAccountId)
+	}
+
+	if len(entry.details) > 0 {
+		sqlstring1 := `INSERT INTO tb_GLTransaction (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		uidOrganizationId, dtmTransactionDate, chvTransactionDescription, intTransactionTypeId, bitIsClosingEntry, intTransactionStatus,
+		dtmPosted, inbReversesTransactionId, intAutoReverseMode) VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, 1, ?, NOW(), ?, 0)`
+
+		var reverses sql.NullInt64
+		reverses.Int64 = entry.reversesId
+		reverses.Valid = entry.reversesId != 0
+
+		res, err := tx.Exec(sqlstring1, entry.mserviceId, entry.organizationId, entry.transactionDate, entry.description, entry.typeId,
+			int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), reverses)
+		if err == nil {
+			tranId, err = res.LastInsertId()
+		}
+
+		if (err == nil) && reverses.Valid {
+			_, err = tx.Exec(`UPDATE tb_GLTransaction SET dtmModified = NOW(), intVersion = intVersion + 1, inbReversedByTransactionId = ?
+			WHERE inbGlTransactionId = ? AND inbMserviceId = ?`, tranId, entry.reversesId, entry.mserviceId)
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			return resp, 0
+		}
+
+		sqlstring2 := `INSERT INTO tb_GLTransactionDetail (inbGlTransactionId, intSequenceNumber, uidGlAccountId, decAmount, bitIsDebit)
+		VALUES(?, ?, ?, ?, ?)`
+
+		for i, detail := range entry.details {
+			_, err := tx.Exec(sqlstring2, tranId, int32(i+1), detail.GetGlAccountId().GetGuid(), detail.GetAmount().StringFromDecimal(),
+				detail.GetIsDebit())
+			if err != nil {
+				level.Error(s.logger).Log("what", "Exec", "error", err)
+				resp.ErrorCode = 501
+				resp.ErrorMessage = err.Error()
+				return resp, 0
+			}
+		}
+	}
+
+	if entry.closing {
+		closingId.Int64 = tranId
+		closingId.Valid = tranId != 0
+	}
+
+	sqlstring3 := `UPDATE tb_GLFiscalYear SET dtmModified = NOW(), intVersion = ?, bitIsClosed = ?, inbClosingTransactionId = ?
+	WHERE uidOrganizationId = ? AND intFiscalYear = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsClosed = ?`
+
+	res, err := tx.Exec(sqlstring3, entry.version+1, entry.closing, closingId, entry.organizationId, entry.fiscalYear, entry.version,
+		entry.mserviceId, !entry.closing)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, 0
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		return resp, 0
+	}
+
+	return resp, tranId
+}
+
+func (s *glService) GetFiscalYearHelper(mserviceId int64, organizationId []byte, fiscalYear int32) (*genericResponse, *pb.GLFiscalYear) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, intFiscalYear, dtmCreated, dtmModified, intVersion, inbMserviceId, dtmStartDate,
+	dtmEndDate, intCalendarType, bitIsClosed, inbClosingTransactionId
+	FROM tb_GLFiscalYear WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var year pb.GLFiscalYear
+	var orgId []byte
+	var created time.Time
+	var modified time.Time
+	var startDate time.Time
+	var endDate time.Time
+	var calendarType int32
+	var closingId sql.NullInt64
+
+	err = stmt.QueryRow(organizationId, fiscalYear, mserviceId).Scan(&orgId, &year.FiscalYear, &created, &modified, &year.Version,
+		&year.MserviceId, &startDate, &endDate, &calendarType, &year.IsClosed, &closingId)
+
+	if err == nil {
+		year.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		year.Created = dml.DateTimeFromTime(created)
+		year.Modified = dml.DateTimeFromTime(modified)
+		year.StartDate = dml.DateTimeFromTime(startDate)
+		year.EndDate = dml.DateTimeFromTime(endDate)
+		year.CalendarType = pb.FiscalCalendarType(calendarType)
+		if closingId.Valid {
+			year.ClosingTransactionId = closingId.Int64
+		}
+	} else if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+	} else {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, &year
+}
+
+// Get the retained earnings account of an organization, which must still be a valid equity account.
+func (s *glService) getRetainedEarningsAccount(mserviceId int64, organizationId []byte) (*genericResponse, []byte) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT uidRetainedEarningsAccountId FROM tb_GLOrganization WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var reAccount []byte
+
+	err = stmt.QueryRow(organizationId, mserviceId).Scan(&reAccount)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "organization not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if reAccount == nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "organization has no retained earnings account"
+		return resp, nil
+	}
+
+	return s.checkRetainedEarningsAccount(mserviceId, organizationId, reAccount), reAccount
+}
+
+// Check that an account can receive net income at year end close: an equity account of the organization.
+func (s *glService) checkRetainedEarningsAccount(mserviceId int64, organizationId []byte, accountId []byte) *genericResponse {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidGlAccountId = ? AND a.uidOrganizationId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	var category int32
+
+	err = stmt.QueryRow(accountId, organizationId, mserviceId).Scan(&category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "retained earnings account not found"
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	} else if pb.AccountCategory(category) != pb.AccountCategory_ACCOUNT_CATEGORY_EQUITY {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "retained earnings account must be an equity account"
+	}
+
+	return resp
+}
+
+// Zero each revenue and expense account, with the net income going to retained earnings.
+func closingDetails(balances []*accountBalance, reAccountId []byte) []*pb.GLTransactionDetail {
+	var details []*pb.GLTransactionDetail
+	total := sdec.New(0, 1) // zero
+
+	for _, bal := range balances {
+		category := baseCategory(bal.category)
+		if (category != pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE) && (category != pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE) {
+			continue
+		}
+
+		net := bal.net()
+		if net.IsZero() {
+			continue
+		}
+
+		details = append(details, newClosingDetail(bal.accountId, net.Neg()))
+		total = total.Add(net)
+	}
+
+	if !total.IsZero() {
+		details = append(details, newClosingDetail(reAccountId, total))
+	}
+
+	return details
+}
+
+// Create a closing entry detail moving a net amount, debit when positive and credit when negative.
+func newClosingDetail(accountId []byte, net sdec.Decimal) *pb.GLTransactionDetail {
+	detail := pb.GLTransactionDetail{}
+	detail.GlAccountId, _ = dml.GuidFromBytes(accountId)
+	detail.Amount = decimalFromAmount(net.Abs())
+	detail.IsDebit = net.IsPositive()
+
+	return &detail
+}
+
+// Check that general ledger transactions may be written on a date for an organization.
+// Dates not covered by a fiscal period are not restricted; admins may write into a soft closed period.
+func (s *glService) checkPeriodOpen(ctx context.Context, mserviceId int64, organizationId []byte, tranDate time.Time) *genericResponse {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT intPeriodStatus FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND inbMserviceId = ?
+	AND dtmStartDate <= ? AND dtmEndDate >= ?`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	var status int32
+
+	err = stmt.QueryRow(organizationId, mserviceId, tranDate, tranDate).Scan(&status)
+	if err == sql.ErrNoRows {
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	switch pb.PeriodStatus(status) {
+	case pb.PeriodStatus_PERIOD_STATUS_SOFT_CLOSED:
+		if !hasAdminAccess(ctx) {
+			resp.ErrorCode = 403
+			resp.ErrorMessage = "fiscal period is closed"
+		}
+	case pb.PeriodStatus_PERIOD_STATUS_HARD_LOCKED:
+		resp.ErrorCode = 403
+		resp.ErrorMessage = "fiscal period is locked"
+	}
+
+	return resp
+}
+
+// Build the list of fiscal periods for a new fiscal year from its calendar type.
+func buildFiscalPeriods(req *pb.CreateFiscalYearRequest) ([]*pb.GLFiscalPeriod, *genericResponse) {
+	var periods []*pb.GLFiscalPeriod
+
+	start := startOfDay(req.GetStartDate().TimeFromDateTime())
+
+	switch req.GetCalendarType() {
+	case pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_MONTHLY:
+		// a later day does not fall in every month, and AddDate would carry it into the next one
+		if start.Day() > 28 {
+			return nil, &genericResponse{ErrorCode: 510, ErrorMessage: "start_date of a monthly calendar must be on day 1 to 28"}
+		}
+
+		for i := 0; i < 12; i++ {
+			periods = append(periods, newFiscalPeriod(int32(i+1), "", start.AddDate(0, i, 0), start.AddDate(0, i+1, 0)))
+		}
+	case pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_FOUR_FOUR_FIVE:
+		periodStart := start
+		for i := 0; i < 12; i++ {
+			periodEnd := periodStart.AddDate(0, 0, 7*fourFourFiveWeeks[i%3])
+			periods = append(periods, newFiscalPeriod(int32(i+1), "", periodStart, periodEnd))
+			periodStart = periodEnd
+		}
+	case pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM:
+		if len(req.GetGlFiscalPeriods()) == 0 {
+			return nil, &genericResponse{ErrorCode: 510, ErrorMessage: "gl_fiscal_periods missing"}
+		}
+
+		// custom periods must be contiguous, starting on the fiscal year start date
+		periodStart := start
+		for i, custom := range req.GetGlFiscalPeriods() {
+			if len(custom.GetPeriodName()) > 32 {
+				return nil, &genericResponse{ErrorCode: 510, ErrorMessage: "period_name invalid"}
+			}
+
+			if (custom.GetStartDate() == nil) || (custom.GetEndDate() == nil) {
+				return nil, &genericResponse{ErrorCode: 510, ErrorMessage: fmt.Sprintf("period %d dates missing", i+1)}
+			}
+
+			if !startOfDay(custom.GetStartDate().TimeFromDateTime()).Equal(periodStart) {
+				return nil, &genericResponse{ErrorCode: 510, ErrorMessage: fmt.Sprintf("period %d start_date not contiguous", i+1)}
+			}
+
+			periodEnd := startOfDay(custom.GetEndDate().TimeFromDateTime()).AddDate(0, 0, 1)
+			if !periodEnd.After(periodStart) {
+				return nil, &genericResponse{ErrorCode: 510, ErrorMessage: fmt.Sprintf("period %d end_date before start_date", i+1)}
+			}
+
+			periods = append(periods, newFiscalPeriod(int32(i+1), custom.GetPeriodName(), periodStart, periodEnd))
+			periodStart = periodEnd
+		}
+	default:
+		return nil, &genericResponse{ErrorCode: 510, ErrorMessage: "calendar_type missing or invalid"}
+	}
+
+	return periods, nil
+}
+
+// Create a fiscal period covering the days from start up to but not including next.
+func newFiscalPeriod(periodNumber int32, periodName string, start time.Time, next time.Time) *pb.GLFiscalPeriod {
+	if periodName == "" {
+		periodName = fmt.Sprintf("P%02d", periodNumber)
+	}
+
+	period := pb.GLFiscalPeriod{}
+	period.PeriodNumber = periodNumber
+	period.PeriodName = periodName
+	period.StartDate = dml.DateTimeFromTime(start)
+	period.EndDate = dml.DateTimeFromTime(next.Add(-time.Second))
+	period.PeriodStatus = pb.PeriodStatus_PERIOD_STATUS_OPEN
+
+	return &period
+}
+
+// Get midnight at the start of the day of a local time.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,284 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// Midnight at the start of a local date.
+func localDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// A custom fiscal period request from a first to a last day.
+func customPeriod(name string, first time.Time, last time.Time) *pb.GLFiscalPeriod {
+	return &pb.GLFiscalPeriod{
+		PeriodName: name,
+		StartDate:  dml.DateTimeFromTime(first),
+		EndDate:    dml.DateTimeFromTime(last),
+	}
+}
+
+func TestBuildFiscalPeriods(t *testing.T) {
+	tests := []struct {
+		name         string
+		calendarType pb.FiscalCalendarType
+		start        time.Time
+		custom       []*pb.GLFiscalPeriod
+		wantCode     int32
+		wantNames    []string
+		wantStarts   []time.Time
+		wantLastEnd  time.Time
+	}{
+		{
+			name:         "monthly from january",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_MONTHLY,
+			start:        localDate(2021, time.January, 1),
+			wantNames:    []string{"P01", "P02", "P12"},
+			wantStarts:   []time.Time{localDate(2021, time.January, 1), localDate(2021, time.February, 1), localDate(2021, time.December, 1)},
+			wantLastEnd:  localDate(2022, time.January, 1),
+		},
+		{
+			name:         "monthly from the 28th",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_MONTHLY,
+			start:        localDate(2021, time.January, 28),
+			wantNames:    []string{"P01", "P02", "P12"},
+			wantStarts:   []time.Time{localDate(2021, time.January, 28), localDate(2021, time.February, 28), localDate(2021, time.December, 28)},
+			wantLastEnd:  localDate(2022, time.January, 28),
+		},
+		{
+			name:         "monthly from the 29th",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_MONTHLY,
+			start:        localDate(2021, time.January, 29),
+			wantCode:     510,
+		},
+		{
+			name:         "four four five",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_FOUR_FOUR_FIVE,
+			start:        localDate(2021, time.January, 3),
+			wantNames:    []string{"P01", "P02", "P12"},
+			wantStarts:   []time.Time{localDate(2021, time.January, 3), localDate(2021, time.January, 31), localDate(2021, time.November, 28)},
+			wantLastEnd:  localDate(2022, time.January, 2),
+		},
+		{
+			name:         "custom contiguous",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM,
+			start:        localDate(2021, time.July, 1),
+			custom: []*pb.GLFiscalPeriod{
+				customPeriod("H1", localDate(2021, time.July, 1), localDate(2021, time.December, 31)),
+				customPeriod("", localDate(2022, time.January, 1), localDate(2022, time.June, 30)),
+			},
+			wantNames:   []string{"H1", "P02"},
+			wantStarts:  []time.Time{localDate(2021, time.July, 1), localDate(2022, time.January, 1)},
+			wantLastEnd: localDate(2022, time.July, 1),
+		},
+		{
+			name:         "custom with a gap",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM,
+			start:        localDate(2021, time.July, 1),
+			custom: []*pb.GLFiscalPeriod{
+				customPeriod("H1", localDate(2021, time.July, 1), localDate(2021, time.December, 30)),
+				customPeriod("H2", localDate(2022, time.January, 1), localDate(2022, time.June, 30)),
+			},
+			wantCode: 510,
+		},
+		{
+			name:         "custom not starting on the year start",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM,
+			start:        localDate(2021, time.July, 1),
+			custom: []*pb.GLFiscalPeriod{
+				customPeriod("H1", localDate(2021, time.July, 2), localDate(2021, time.December, 31)),
+			},
+			wantCode: 510,
+		},
+		{
+			name:         "custom ending before it starts",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM,
+			start:        localDate(2021, time.July, 1),
+			custom: []*pb.GLFiscalPeriod{
+				customPeriod("H1", localDate(2021, time.July, 1), localDate(2021, time.June, 30)),
+			},
+			wantCode: 510,
+		},
+		{
+			name:         "custom without periods",
+			calendarType: pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM,
+			start:        localDate(2021, time.July, 1),
+			wantCode:     510,
+		},
+		{
+			name:     "calendar type missing",
+			start:    localDate(2021, time.January, 1),
+			wantCode: 510,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.CreateFiscalYearRequest{
+				StartDate:       dml.DateTimeFromTime(tt.start),
+				CalendarType:    tt.calendarType,
+				GlFiscalPeriods: tt.custom,
+			}
+
+			periods, gResp := buildFiscalPeriods(req)
+			if tt.wantCode != 0 {
+				if (gResp == nil) || (gResp.ErrorCode != tt.wantCode) {
+					t.Fatalf("got %v, want error code %d", gResp, tt.wantCode)
+				}
+				return
+			}
+
+			if gResp != nil {
+				t.Fatalf("unexpected error %d %s", gResp.ErrorCode, gResp.ErrorMessage)
+			}
+
+			if (tt.calendarType != pb.FiscalCalendarType_FISCAL_CALENDAR_TYPE_CUSTOM) && (len(periods) != 12) {
+				t.Fatalf("got %d periods, want 12", len(periods))
+			}
+
+			// the first, second and last periods
+			checked := []*pb.GLFiscalPeriod{periods[0], periods[1], periods[len(periods)-1]}
+			if len(periods) == 2 {
+				checked = periods
+			}
+
+			for i, period := range checked {
+				if period.GetPeriodName() != tt.wantNames[i] {
+					t.Errorf("period name %s, want %s", period.GetPeriodName(), tt.wantNames[i])
+				}
+
+				if !period.GetStartDate().TimeFromDateTime().Equal(tt.wantStarts[i]) {
+					t.Errorf("%s starts %v, want %v", period.GetPeriodName(), period.GetStartDate().TimeFromDateTime(), tt.wantStarts[i])
+				}
+
+				if period.GetPeriodStatus() != pb.PeriodStatus_PERIOD_STATUS_OPEN {
+					t.Errorf("%s status %v, want open", period.GetPeriodName(), period.GetPeriodStatus())
+				}
+			}
+
+			// each period ends the second before the next one starts
+			for i := 1; i < len(periods); i++ {
+				end := periods[i-1].GetEndDate().TimeFromDateTime()
+				if !end.Add(time.Second).Equal(periods[i].GetStartDate().TimeFromDateTime()) {
+					t.Errorf("%s ends %v, %s starts %v", periods[i-1].GetPeriodName(), end, periods[i].GetPeriodName(),
+						periods[i].GetStartDate().TimeFromDateTime())
+				}
+			}
+
+			lastEnd := periods[len(periods)-1].GetEndDate().TimeFromDateTime()
+			if !lastEnd.Equal(tt.wantLastEnd.Add(-time.Second)) {
+				t.Errorf("year ends %v, want %v", lastEnd, tt.wantLastEnd.Add(-time.Second))
+			}
+		})
+	}
+}
+
+// A 16 byte account id ending in n.
+func testAccountId(n byte) []byte {
+	id := make([]byte, 16)
+	id[15] = n
+
+	return id
+}
+
+// An account balance with debit and credit totals.
+func testBalance(n byte, category pb.AccountCategory, debits string, credits string) *accountBalance {
+	return &accountBalance{
+		accountId: testAccountId(n),
+		category:  category,
+		debits:    sdec.RequireFromString(debits),
+		credits:   sdec.RequireFromString(credits),
+	}
+}
+
+func TestClosingDetails(t *testing.T) {
+	type wantDetail struct {
+		account byte
+		amount  string
+		isDebit bool
+	}
+
+	tests := []struct {
+		name     string
+		balances []*accountBalance
+		want     []wantDetail
+	}{
+		{
+			name: "net income",
+			balances: []*accountBalance{
+				testBalance(1, pb.AccountCategory_ACCOUNT_CATEGORY_ASSET, "500", "0"),
+				testBalance(2, pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE, "0", "1000"),
+				testBalance(3, pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE, "600", "0"),
+				testBalance(4, pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_REVENUE, "50", "0"),
+			},
+			want: []wantDetail{{2, "1000.00", true}, {3, "600.00", false}, {4, "50.00", false}, {9, "350.00", false}},
+		},
+		{
+			name: "net loss",
+			balances: []*accountBalance{
+				testBalance(2, pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE, "0", "200"),
+				testBalance(3, pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE, "300", "0"),
+			},
+			want: []wantDetail{{2, "200.00", true}, {3, "300.00", false}, {9, "100.00", true}},
+		},
+		{
+			name: "break even",
+			balances: []*accountBalance{
+				testBalance(2, pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE, "0", "300"),
+				testBalance(3, pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE, "300", "0"),
+			},
+			want: []wantDetail{{2, "300.00", true}, {3, "300.00", false}},
+		},
+		{
+			name: "nothing to close",
+			balances: []*accountBalance{
+				testBalance(1, pb.AccountCategory_ACCOUNT_CATEGORY_ASSET, "500", "0"),
+				testBalance(2, pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE, "100", "100"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := closingDetails(tt.balances, testAccountId(9))
+			if len(details) != len(tt.want) {
+				t.Fatalf("got %d details, want %d", len(details), len(tt.want))
+			}
+
+			for i, detail := range details {
+				want := tt.want[i]
+				if detail.GetGlAccountId().GetGuid()[15] != want.account {
+					t.Errorf("detail %d account %d, want %d", i, detail.GetGlAccountId().GetGuid()[15], want.account)
+				}
+
+				if detail.GetAmount().GetPlaintext() != want.amount {
+					t.Errorf("detail %d amount %s, want %s", i, detail.GetAmount().GetPlaintext(), want.amount)
+				}
+
+				if detail.GetIsDebit() != want.isDebit {
+					t.Errorf("detail %d debit %t, want %t", i, detail.GetIsDebit(), want.isDebit)
+				}
+			}
+		})
+	}
+}
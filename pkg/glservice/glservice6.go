@@ -0,0 +1,378 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// account codes are digits, optionally followed by dash separated segments, such as 1000 or 1000-20-300
+var accountCodeValidator = regexp.MustCompile("^[0-9]{1,18}(\\-[0-9]+)*$")
+
+// SQL ordering of accounts by the number in the leading segment of their code, accounts without a code last
+var accountCodeOrder = `a.chvAccountCode IS NULL, CAST(SUBSTRING_INDEX(a.chvAccountCode, '-', 1) AS UNSIGNED), a.chvAccountCode`
+
+// create general ledger account code range for an account category
+func (s *glService) CreateAccountCodeRange(ctx context.Context, req *pb.CreateAccountCodeRangeRequest) (*pb.CreateAccountCodeRangeResponse, error) {
+	resp := &pb.CreateAccountCodeRangeResponse{}
+
+	gResp := s.checkAccountCodeRange(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetAccountCategory(),
+		req.GetRangeStart(), req.GetRangeEnd())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLAccountCodeRange (uidOrganizationId, intAccountCategory, dtmCreated, dtmModified, dtmDeleted,
+	bitIsDeleted, intVersion, inbMserviceId, inbRangeStart, inbRangeEnd) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), int32(req.GetAccountCategory()), req.GetMserviceId(),
+		req.GetRangeStart(), req.GetRangeEnd())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger account code range
+func (s *glService) UpdateAccountCodeRange(ctx context.Context, req *pb.UpdateAccountCodeRangeRequest) (*pb.UpdateAccountCodeRangeResponse, error) {
+	resp := &pb.UpdateAccountCodeRangeResponse{}
+
+	gResp := s.checkAccountCodeRange(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetAccountCategory(),
+		req.GetRangeStart(), req.GetRangeEnd())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLAccountCodeRange SET dtmModified = NOW(), intVersion = ?, inbRangeStart = ?, inbRangeEnd = ?
+	WHERE uidOrganizationId = ? AND intAccountCategory = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetRangeStart(), req.GetRangeEnd(), req.GetOrganizationId().GetGuid(),
+		int32(req.GetAccountCategory()), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger account code range
+func (s *glService) DeleteAccountCodeRange(ctx context.Context, req *pb.DeleteAccountCodeRangeRequest) (*pb.DeleteAccountCodeRangeResponse, error) {
+	resp := &pb.DeleteAccountCodeRangeResponse{}
+
+	sqlstring := `UPDATE tb_GLAccountCodeRange SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND intAccountCategory = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), int32(req.GetAccountCategory()), req.GetVersion(),
+		req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger account code ranges by organization
+func (s *glService) GetAccountCodeRangesByOrganization(ctx context.Context, req *pb.GetAccountCodeRangesByOrganizationRequest) (*pb.GetAccountCodeRangesByOrganizationResponse, error) {
+	resp := &pb.GetAccountCodeRangesByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, intAccountCategory, dtmCreated, dtmModified, intVersion, inbMserviceId,
+	inbRangeStart, inbRangeEnd
+	FROM tb_GLAccountCodeRange WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY inbRangeStart`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var codeRange pb.GLAccountCodeRange
+		var orgId []byte
+		var category int32
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&orgId, &category, &created, &modified, &codeRange.Version, &codeRange.MserviceId,
+			&codeRange.RangeStart, &codeRange.RangeEnd)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		codeRange.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		codeRange.AccountCategory = pb.AccountCategory(category)
+		codeRange.Created = dml.DateTimeFromTime(created)
+		codeRange.Modified = dml.DateTimeFromTime(modified)
+
+		resp.GlAccountCodeRanges = append(resp.GlAccountCodeRanges, &codeRange)
+	}
+
+	return resp, nil
+}
+
+// Get the number in the leading segment of an account code.
+func accountCodeNumber(code string) (int64, error) {
+	return strconv.ParseInt(strings.SplitN(code, "-", 2)[0], 10, 64)
+}
+
+// Check the format of an account code, and that it falls in the code range of the account type's category if the
+// organization has defined one. Contra accounts use the range of the category they offset. An empty code is allowed.
+func (s *glService) checkAccountCode(mserviceId int64, organizationId []byte, code string, accountTypeId int32) *genericResponse {
+	resp := &genericResponse{}
+
+	if code == "" {
+		return resp
+	}
+
+	if (len(code) > 32) || !accountCodeValidator.MatchString(code) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "account_code invalid format"
+		return resp
+	}
+
+	number, err := accountCodeNumber(code)
+	if err != nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "account_code invalid format"
+		return resp
+	}
+
+	var category int32
+	err = s.db.QueryRow(`SELECT intAccountCategory FROM tb_GLAccountType WHERE inbMserviceId = ? AND intAccountTypeId = ?`,
+		mserviceId, accountTypeId).Scan(&category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "account type not found"
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	var rangeStart int64
+	var rangeEnd int64
+
+	err = s.db.QueryRow(`SELECT inbRangeStart, inbRangeEnd FROM tb_GLAccountCodeRange
+	WHERE uidOrganizationId = ? AND intAccountCategory = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		organizationId, int32(baseCategory(pb.AccountCategory(category))), mserviceId).Scan(&rangeStart, &rangeEnd)
+	if err == sql.ErrNoRows {
+		// no range defined for the category
+		return resp
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if (number < rangeStart) || (number > rangeEnd) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "account_code outside range for account category"
+	}
+
+	return resp
+}
+
+// Check a new or changed account code range: it must be for a category other than a contra category, must not
+// overlap the range of another category, and must cover the codes of existing accounts in the category.
+func (s *glService) checkAccountCodeRange(mserviceId int64, organizationId []byte, category pb.AccountCategory,
+	rangeStart int64, rangeEnd int64) *genericResponse {
+	resp := &genericResponse{}
+
+	if _, ok := categoryNormalBalance[category]; !ok || (baseCategory(category) != category) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "account_category invalid, ranges are defined for asset, liability, equity, revenue or expense"
+		return resp
+	}
+
+	if (rangeStart < 0) || (rangeStart > rangeEnd) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "range_start must not be negative or exceed range_end"
+		return resp
+	}
+
+	var overlaps int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLAccountCodeRange WHERE uidOrganizationId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0 AND intAccountCategory <> ? AND inbRangeStart <= ? AND inbRangeEnd >= ?`,
+		organizationId, mserviceId, int32(category), rangeEnd, rangeStart).Scan(&overlaps)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	if overlaps > 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "range overlaps the range of another account category"
+		return resp
+	}
+
+	sqlstring := `SELECT a.chvAccountCode, y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidOrganizationId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0 AND a.chvAccountCode IS NOT NULL`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(organizationId, mserviceId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var code string
+		var acctCategory int32
+
+		err := rows.Scan(&code, &acctCategory)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		if baseCategory(pb.AccountCategory(acctCategory)) != category {
+			continue
+		}
+
+		number, err := accountCodeNumber(code)
+		if (err != nil) || (number < rangeStart) || (number > rangeEnd) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("existing account code %s falls outside range", code)
+			return resp
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,827 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// ISO 4217 currency codes, such as USD or EUR
+var currencyValidator = regexp.MustCompile("^[A-Z]{3}$")
+
+// create general ledger exchange rate for a foreign currency
+func (s *glService) CreateExchangeRate(ctx context.Context, req *pb.CreateExchangeRateRequest) (*pb.CreateExchangeRateResponse, error) {
+	resp := &pb.CreateExchangeRateResponse{}
+
+	gResp := s.checkExchangeRate(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetCurrencyCode(), req.GetEffectiveDate(),
+		req.GetRate())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLExchangeRate (uidOrganizationId, chvCurrencyCode, dtmEffectiveDate, dtmCreated, dtmModified,
+	dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, decRate) VALUES (?, ?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetCurrencyCode(), startOfDay(req.GetEffectiveDate().TimeFromDateTime()),
+		req.GetMserviceId(), req.GetRate().GetPlaintext())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger exchange rate
+func (s *glService) UpdateExchangeRate(ctx context.Context, req *pb.UpdateExchangeRateRequest) (*pb.UpdateExchangeRateResponse, error) {
+	resp := &pb.UpdateExchangeRateResponse{}
+
+	gResp := s.checkExchangeRate(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetCurrencyCode(), req.GetEffectiveDate(),
+		req.GetRate())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLExchangeRate SET dtmModified = NOW(), intVersion = ?, decRate = ?
+	WHERE uidOrganizationId = ? AND chvCurrencyCode = ? AND dtmEffectiveDate = ? AND intVersion = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetRate().GetPlaintext(), req.GetOrganizationId().GetGuid(), req.GetCurrencyCode(),
+		startOfDay(req.GetEffectiveDate().TimeFromDateTime()), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger exchange rate
+func (s *glService) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	resp := &pb.DeleteExchangeRateResponse{}
+
+	if req.GetEffectiveDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "effective_date missing"
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLExchangeRate SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvCurrencyCode = ? AND dtmEffectiveDate = ? AND intVersion = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetCurrencyCode(),
+		startOfDay(req.GetEffectiveDate().TimeFromDateTime()), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger exchange rates by organization
+func (s *glService) GetExchangeRatesByOrganization(ctx context.Context, req *pb.GetExchangeRatesByOrganizationRequest) (*pb.GetExchangeRatesByOrganizationResponse, error) {
+	resp := &pb.GetExchangeRatesByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvCurrencyCode, dtmEffectiveDate, dtmCreated, dtmModified, intVersion, inbMserviceId, decRate
+	FROM tb_GLExchangeRate WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND (? = '' OR chvCurrencyCode = ?)
+	ORDER BY chvCurrencyCode, dtmEffectiveDate`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId(), req.GetCurrencyCode(), req.GetCurrencyCode())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var exchangeRate pb.GLExchangeRate
+		var orgId []byte
+		var effective time.Time
+		var created time.Time
+		var modified time.Time
+		var rate string
+
+		err := rows.Scan(&orgId, &exchangeRate.CurrencyCode, &effective, &created, &modified, &exchangeRate.Version,
+			&exchangeRate.MserviceId, &rate)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		exchangeRate.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		exchangeRate.EffectiveDate = dml.DateTimeFromTime(effective)
+		exchangeRate.Created = dml.DateTimeFromTime(created)
+		exchangeRate.Modified = dml.DateTimeFromTime(modified)
+		exchangeRate.Rate, _ = dml.DecimalFromString(rate)
+
+		resp.GlExchangeRates = append(resp.GlExchangeRates, &exchangeRate)
+	}
+
+	return resp, nil
+}
+
+// revalue foreign currency balances of organization, posting unrealized exchange gains and losses
+func (s *glService) RevalueForeignBalances(ctx context.Context, req *pb.RevalueForeignBalancesRequest) (*pb.RevalueForeignBalancesResponse, error) {
+	resp := &pb.RevalueForeignBalancesResponse{}
+
+	if req.GetRevaluationDate() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "revaluation_date missing"
+		return resp, nil
+	}
+
+	orgId := req.GetOrganizationId().GetGuid()
+	tranDate := startOfDay(req.GetRevaluationDate().TimeFromDateTime())
+
+	gResp, gainAccount, lossAccount := s.getFxAccounts(req.GetMserviceId(), orgId)
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, tranDate)
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	var typeId int32
+	err := s.db.QueryRow(`SELECT intTransactionTypeId FROM tb_GLTransactionType WHERE intTransactionTypeId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		req.GetTransactionTypeId(), req.GetMserviceId()).Scan(&typeId)
+	if err != nil {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "transaction type not found"
+		return resp, nil
+	}
+
+	// the reversal is checked up front so a revaluation is not left behind without it
+	var reverseDate time.Time
+	if req.GetAutoReverse() {
+		gResp, reverseDate = s.nextPeriodStart(req.GetMserviceId(), orgId, tranDate)
+		if gResp.ErrorCode == 0 {
+			gResp = s.checkPeriodOpen(ctx, req.GetMserviceId(), orgId, reverseDate)
+		}
+
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+	}
+
+	// as for other journal entries, the period is checked and the balances are read before the revaluation is posted;
+	// a foreign currency posting committed in between keeps its booked rate until the next run, which revalues
+	// from the adjusted amounts
+	gResp, balances := s.getForeignBalances(req.GetMserviceId(), orgId, tranDate.AddDate(0, 0, 1).Add(-time.Second))
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	rates := make(map[string]sdec.Decimal)
+	if req.GetRateSource() == pb.RateSource_RATE_SOURCE_REQUEST {
+		for _, exchangeRate := range req.GetExchangeRates() {
+			rate, err := sdec.NewFromString(exchangeRate.GetRate().GetPlaintext())
+			if (err != nil) || !rate.IsPositive() {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = "rate missing or not positive"
+				return resp, nil
+			}
+
+			rates[exchangeRate.GetCurrencyCode()] = rate
+		}
+	} else {
+		for _, bal := range balances {
+			if _, ok := rates[bal.currencyCode]; ok {
+				continue
+			}
+
+			gResp, rates[bal.currencyCode] = s.getExchangeRate(req.GetMserviceId(), orgId, bal.currencyCode, tranDate)
+			if gResp.ErrorCode != 0 {
+				resp.ErrorCode = gResp.ErrorCode
+				resp.ErrorMessage = gResp.ErrorMessage
+				return resp, nil
+			}
+		}
+	}
+
+	gResp, details := revaluationDetails(balances, rates, gainAccount, lossAccount)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if len(details) == 0 {
+		return resp, nil
+	}
+
+	for i, detail := range details {
+		detail.SequenceNumber = int32(i + 1)
+	}
+
+	entry := pb.PostJournalEntryRequest{}
+	entry.MserviceId = req.GetMserviceId()
+	entry.OrganizationId = req.GetOrganizationId()
+	entry.TransactionDate = dml.DateTimeFromTime(tranDate)
+	entry.TransactionDescription = fmt.Sprintf("foreign currency revaluation %s", tranDate.Format("2006-01-02"))
+	entry.TransactionTypeId = req.GetTransactionTypeId()
+	entry.GlTransactionDetails = details
+
+	// the reversal is posted together with the revaluation
+	var reverse_date sql.NullTime
+	var reverse_description string
+	if req.GetAutoReverse() {
+		reverse_date.Time = reverseDate
+		reverse_date.Valid = true
+		reverse_description = fmt.Sprintf("reversal of foreign currency revaluation %s", tranDate.Format("2006-01-02"))
+	}
+
+	gResp, transactionId, reversingId := s.CommitJournalEntry(&entry, reverse_date, reverse_description)
+	resp.ReversingTransactionId = reversingId
+
+	if transactionId != 0 {
+		vResp, wrap := s.GetTransactionWrapperHelper(transactionId, req.GetMserviceId())
+		if gResp.ErrorCode == 0 {
+			gResp = vResp
+		}
+
+		resp.GlTransactionWrapper = wrap
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// Get the base currency of an organization, empty if it has not been set.
+func (s *glService) getBaseCurrency(mserviceId int64, organizationId []byte) (*genericResponse, string) {
+	resp := &genericResponse{}
+
+	var baseCurrency sql.NullString
+
+	err := s.db.QueryRow(`SELECT chvBaseCurrency FROM tb_GLOrganization WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		organizationId, mserviceId).Scan(&baseCurrency)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "organization not found"
+		return resp, ""
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, ""
+	}
+
+	return resp, baseCurrency.String
+}
+
+// Check that the base currency of an organization only changes while it has no transactions, since the amounts of
+// existing transactions are in the old base currency.
+func (s *glService) checkBaseCurrencyChange(mserviceId int64, organizationId []byte, baseCurrency string) *genericResponse {
+	gResp, current := s.getBaseCurrency(mserviceId, organizationId)
+	if (gResp.ErrorCode != 0) || (current == baseCurrency) {
+		return gResp
+	}
+
+	var transactions int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLTransaction WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		organizationId, mserviceId).Scan(&transactions)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		gResp.ErrorCode = 500
+		gResp.ErrorMessage = err.Error()
+		return gResp
+	}
+
+	if transactions > 0 {
+		gResp.ErrorCode = 501
+		gResp.ErrorMessage = "base currency cannot change once the organization has transactions"
+	}
+
+	return gResp
+}
+
+// Check a new or changed exchange rate: a positive rate for a valid currency other than the organization's base currency.
+func (s *glService) checkExchangeRate(mserviceId int64, organizationId []byte, currencyCode string, effectiveDate *dml.DateTime,
+	rate *dml.Decimal) *genericResponse {
+	resp := &genericResponse{}
+
+	if !currencyValidator.MatchString(currencyCode) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "currency_code invalid format"
+		return resp
+	}
+
+	if effectiveDate == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "effective_date missing"
+		return resp
+	}
+
+	amt, err := sdec.NewFromString(rate.GetPlaintext())
+	if (err != nil) || !amt.IsPositive() {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "rate missing or not positive"
+		return resp
+	}
+
+	gResp, baseCurrency := s.getBaseCurrency(mserviceId, organizationId)
+	if gResp.ErrorCode != 0 {
+		return gResp
+	}
+
+	if baseCurrency == "" {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "organization base currency not set"
+	} else if baseCurrency == currencyCode {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "currency_code is the organization base currency"
+	}
+
+	return resp
+}
+
+// Get the exchange rate of a currency in effect on a date, the one with the latest effective date on or before it.
+func (s *glService) getExchangeRate(mserviceId int64, organizationId []byte, currencyCode string, rateDate time.Time) (*genericResponse, sdec.Decimal) {
+	resp := &genericResponse{}
+
+	var rate string
+
+	err := s.db.QueryRow(`SELECT decRate FROM tb_GLExchangeRate
+	WHERE uidOrganizationId = ? AND chvCurrencyCode = ? AND inbMserviceId = ? AND dtmEffectiveDate <= ? AND bitIsDeleted = 0
+	ORDER BY dtmEffectiveDate DESC LIMIT 1`,
+		organizationId, currencyCode, mserviceId, rateDate).Scan(&rate)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = fmt.Sprintf("no exchange rate for %s on transaction date", currencyCode)
+		return resp, sdec.Zero
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, sdec.Zero
+	}
+
+	amt, err := sdec.NewFromString(rate)
+	if err != nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, amt
+}
+
+// Prepare transaction details for storage in the base currency of the organization. A detail in a foreign currency
+// needs its foreign amount; when its base currency amount is omitted it is converted at the exchange rate in effect on
+// the transaction date. A detail in the base currency is stored without a currency code.
+func (s *glService) convertTransactionDetails(mserviceId int64, organizationId []byte, tranDate time.Time,
+	details []*pb.GLTransactionDetail) *genericResponse {
+	resp := &genericResponse{}
+
+	var baseCurrency string
+	baseLoaded := false
+
+	for _, detail := range details {
+		code := detail.GetCurrencyCode()
+		if code != "" {
+			if !currencyValidator.MatchString(code) {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = "currency_code invalid format"
+				return resp
+			}
+
+			if !baseLoaded {
+				gResp, base := s.getBaseCurrency(mserviceId, organizationId)
+				if gResp.ErrorCode != 0 {
+					return gResp
+				}
+
+				baseCurrency = base
+				baseLoaded = true
+			}
+
+			if baseCurrency == "" {
+				resp.ErrorCode = 501
+				resp.ErrorMessage = "organization base currency not set"
+				return resp
+			}
+		}
+
+		// the rate is only needed to convert a foreign amount without a base currency amount
+		rate := sdec.Zero
+		if (code != "") && (code != baseCurrency) && (detail.GetAmount().GetPlaintext() == "") {
+			var gResp *genericResponse
+			gResp, rate = s.getExchangeRate(mserviceId, organizationId, code, tranDate)
+			if gResp.ErrorCode != 0 {
+				return gResp
+			}
+		}
+
+		resp = convertDetail(detail, baseCurrency, rate)
+		if resp.ErrorCode != 0 {
+			return resp
+		}
+	}
+
+	return resp
+}
+
+// Convert a transaction detail to the base currency, at the given rate when it has a foreign amount but no
+// base currency amount.
+func convertDetail(detail *pb.GLTransactionDetail, baseCurrency string, rate sdec.Decimal) *genericResponse {
+	resp := &genericResponse{}
+
+	code := detail.GetCurrencyCode()
+	if (code == "") || (code == baseCurrency) {
+		if (detail.GetAmount().GetPlaintext() == "") && (code != "") {
+			detail.Amount = detail.GetForeignAmount()
+		}
+
+		detail.CurrencyCode = ""
+		detail.ForeignAmount = nil
+	} else {
+		foreign, err := sdec.NewFromString(detail.GetForeignAmount().GetPlaintext())
+		if err != nil {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "foreign_amount missing or invalid"
+			return resp
+		}
+
+		if detail.GetAmount().GetPlaintext() == "" {
+			detail.Amount = decimalFromAmount(foreign.Mul(rate).Round(2))
+		}
+
+		detail.ForeignAmount = decimalFromAmount(foreign)
+	}
+
+	if _, err := sdec.NewFromString(detail.GetAmount().GetPlaintext()); err != nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "amount missing or invalid"
+	}
+
+	return resp
+}
+
+// Get the nullable foreign currency columns of a transaction detail.
+func detailForeignColumns(detail *pb.GLTransactionDetail) (sql.NullString, sql.NullString) {
+	var currency sql.NullString
+	var foreign sql.NullString
+
+	if detail.GetCurrencyCode() != "" {
+		currency.String = detail.GetCurrencyCode()
+		currency.Valid = true
+		foreign.String = detail.GetForeignAmount().GetPlaintext()
+		foreign.Valid = true
+	}
+
+	return currency, foreign
+}
+
+// Set the foreign currency fields of a transaction detail from its nullable columns.
+func setDetailForeignColumns(detail *pb.GLTransactionDetail, currency sql.NullString, foreign sql.NullString) {
+	if currency.Valid {
+		detail.CurrencyCode = currency.String
+	}
+
+	if foreign.Valid {
+		detail.ForeignAmount, _ = dml.DecimalFromString(foreign.String)
+	}
+}
+
+// Foreign currency balance of an account, in the foreign currency and in the base currency it was booked at.
+type foreignBalance struct {
+	accountId    []byte
+	currencyCode string
+	foreign      sdec.Decimal
+	base         sdec.Decimal
+}
+
+// Get the net foreign currency balances of the asset and liability accounts of an organization from posted
+// transactions up to a date. Revenue, expense and equity accounts stay at the rates they were booked at.
+func (s *glService) getForeignBalances(mserviceId int64, organizationId []byte, endDate time.Time) (*genericResponse, []*foreignBalance) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT d.uidGlAccountId, d.chvCurrencyCode,
+	SUM(CASE WHEN d.bitIsDebit = 1 THEN d.decForeignAmount ELSE -d.decForeignAmount END),
+	SUM(CASE WHEN d.bitIsDebit = 1 THEN d.decAmount ELSE -d.decAmount END)
+	FROM tb_GLTransaction AS t
+	JOIN tb_GLTransactionDetail AS d
+	ON t.inbGlTransactionId = d.inbGlTransactionId
+	JOIN tb_GLAccount AS a
+	ON d.uidGlAccountId = a.uidGlAccountId
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND t.bitIsDeleted = 0 AND t.intTransactionStatus = ?
+	AND t.dtmTransactionDate <= ? AND d.chvCurrencyCode IS NOT NULL AND y.intAccountCategory IN (?, ?, ?, ?)
+	GROUP BY d.uidGlAccountId, d.chvCurrencyCode
+	ORDER BY d.chvCurrencyCode, d.uidGlAccountId`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(organizationId, mserviceId, int32(pb.TransactionStatus_TRANSACTION_STATUS_POSTED), endDate,
+		int32(pb.AccountCategory_ACCOUNT_CATEGORY_ASSET), int32(pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_ASSET),
+		int32(pb.AccountCategory_ACCOUNT_CATEGORY_LIABILITY), int32(pb.AccountCategory_ACCOUNT_CATEGORY_CONTRA_LIABILITY))
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var balances []*foreignBalance
+
+	for rows.Next() {
+		var bal foreignBalance
+		var foreign string
+		var base string
+
+		err := rows.Scan(&bal.accountId, &bal.currencyCode, &foreign, &base)
+		if err == nil {
+			bal.foreign, err = sdec.NewFromString(foreign)
+		}
+
+		if err == nil {
+			bal.base, err = sdec.NewFromString(base)
+		}
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		balances = append(balances, &bal)
+	}
+
+	return resp, balances
+}
+
+// Build the details of a revaluation from foreign currency balances and the rate of each currency. Each balance is
+// adjusted in its own currency so a later run revalues from the adjusted amount; the total gain and the total loss
+// are offset to their own accounts. No details are returned when nothing needs adjusting.
+func revaluationDetails(balances []*foreignBalance, rates map[string]sdec.Decimal, gainAccount []byte,
+	lossAccount []byte) (*genericResponse, []*pb.GLTransactionDetail) {
+	resp := &genericResponse{}
+
+	var details []*pb.GLTransactionDetail
+	gains := sdec.Zero
+	losses := sdec.Zero
+
+	for _, bal := range balances {
+		rate, ok := rates[bal.currencyCode]
+		if !ok {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("no exchange rate for %s in request", bal.currencyCode)
+			return resp, nil
+		}
+
+		adjustment := bal.foreign.Mul(rate).Round(2).Sub(bal.base)
+		if adjustment.IsZero() {
+			continue
+		}
+
+		detail := newClosingDetail(bal.accountId, adjustment)
+		detail.CurrencyCode = bal.currencyCode
+		detail.ForeignAmount = decimalFromAmount(sdec.Zero)
+		details = append(details, detail)
+
+		if adjustment.IsPositive() {
+			gains = gains.Add(adjustment)
+		} else {
+			losses = losses.Add(adjustment)
+		}
+	}
+
+	if len(details) == 0 {
+		return resp, nil
+	}
+
+	if !gains.IsZero() {
+		details = append(details, newClosingDetail(gainAccount, gains.Neg()))
+	}
+
+	if !losses.IsZero() {
+		details = append(details, newClosingDetail(lossAccount, losses.Neg()))
+	}
+
+	return resp, details
+}
+
+// Get the accounts of an organization receiving unrealized foreign exchange gains and losses.
+func (s *glService) getFxAccounts(mserviceId int64, organizationId []byte) (*genericResponse, []byte, []byte) {
+	resp := &genericResponse{}
+
+	var gainAccount []byte
+	var lossAccount []byte
+
+	err := s.db.QueryRow(`SELECT uidFxGainAccountId, uidFxLossAccountId FROM tb_GLOrganization
+	WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		organizationId, mserviceId).Scan(&gainAccount, &lossAccount)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "organization not found"
+		return resp, nil, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil, nil
+	}
+
+	if (gainAccount == nil) || (lossAccount == nil) {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "organization has no foreign exchange gain and loss accounts"
+		return resp, nil, nil
+	}
+
+	resp = s.checkFxAccount(mserviceId, organizationId, gainAccount)
+	if resp.ErrorCode == 0 {
+		resp = s.checkFxAccount(mserviceId, organizationId, lossAccount)
+	}
+
+	return resp, gainAccount, lossAccount
+}
+
+// Check that an account can receive unrealized foreign exchange gains or losses: a revenue or expense account of the organization.
+func (s *glService) checkFxAccount(mserviceId int64, organizationId []byte, accountId []byte) *genericResponse {
+	resp := &genericResponse{}
+
+	var category int32
+
+	err := s.db.QueryRow(`SELECT y.intAccountCategory FROM tb_GLAccount AS a
+	JOIN tb_GLAccountType AS y
+	ON a.inbMserviceId = y.inbMserviceId AND a.intAccountTypeId = y.intAccountTypeId
+	WHERE a.uidGlAccountId = ? AND a.uidOrganizationId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = 0`,
+		accountId, organizationId, mserviceId).Scan(&category)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "foreign exchange gain or loss account not found"
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	} else if base := baseCategory(pb.AccountCategory(category)); (base != pb.AccountCategory_ACCOUNT_CATEGORY_REVENUE) &&
+		(base != pb.AccountCategory_ACCOUNT_CATEGORY_EXPENSE) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "foreign exchange gain and loss accounts must be revenue or expense accounts"
+	}
+
+	return resp
+}
+
+// Get the first day after the fiscal period containing a date, or the first day of the next month when no
+// fiscal period covers it.
+func (s *glService) nextPeriodStart(mserviceId int64, organizationId []byte, date time.Time) (*genericResponse, time.Time) {
+	resp := &genericResponse{}
+
+	var endDate sql.NullTime
+
+	err := s.db.QueryRow(`SELECT dtmEndDate FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND inbMserviceId = ?
+	AND dtmStartDate <= ? AND dtmEndDate >= ?`,
+		organizationId, mserviceId, date, date).Scan(&endDate)
+	if (err != nil) && (err != sql.ErrNoRows) {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, date
+	}
+
+	return resp, periodFollowing(date, endDate)
+}
+
+// Get the first day after a fiscal period ending at periodEnd, or the first day of the month after date
+// when no fiscal period covers it.
+func periodFollowing(date time.Time, periodEnd sql.NullTime) time.Time {
+	if !periodEnd.Valid {
+		first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.Local)
+		return first.AddDate(0, 1, 0)
+	}
+
+	return startOfDay(periodEnd.Time).AddDate(0, 0, 1)
+}
@@ -0,0 +1,271 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// A decimal amount, absent when empty.
+func testAmount(amount string) *dml.Decimal {
+	if amount == "" {
+		return nil
+	}
+
+	return &dml.Decimal{Plaintext: amount}
+}
+
+func TestConvertDetail(t *testing.T) {
+	tests := []struct {
+		name        string
+		currency    string
+		amount      string
+		foreign     string
+		wantCode    int32
+		wantAmount  string
+		wantCurr    string
+		wantForeign string
+	}{
+		{
+			name:       "base currency without a currency code",
+			amount:     "100.00",
+			wantAmount: "100.00",
+		},
+		{
+			name:       "base currency code takes the foreign amount",
+			currency:   "USD",
+			foreign:    "75.50",
+			wantAmount: "75.50",
+		},
+		{
+			name:        "foreign amount converted at the rate",
+			currency:    "EUR",
+			foreign:     "100.005",
+			wantAmount:  "120.01",
+			wantCurr:    "EUR",
+			wantForeign: "100.01",
+		},
+		{
+			name:        "foreign amount with its base amount given",
+			currency:    "EUR",
+			amount:      "119.00",
+			foreign:     "100",
+			wantAmount:  "119.00",
+			wantCurr:    "EUR",
+			wantForeign: "100.00",
+		},
+		{
+			name:     "foreign amount missing",
+			currency: "EUR",
+			amount:   "119.00",
+			wantCode: 510,
+		},
+		{
+			name:     "amount missing",
+			wantCode: 510,
+		},
+		{
+			name:     "amount invalid",
+			amount:   "ten",
+			wantCode: 510,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := &pb.GLTransactionDetail{
+				CurrencyCode:  tt.currency,
+				Amount:        testAmount(tt.amount),
+				ForeignAmount: testAmount(tt.foreign),
+			}
+
+			gResp := convertDetail(detail, "USD", sdec.RequireFromString("1.2"))
+			if gResp.ErrorCode != tt.wantCode {
+				t.Fatalf("error code %d %s, want %d", gResp.ErrorCode, gResp.ErrorMessage, tt.wantCode)
+			}
+
+			if tt.wantCode != 0 {
+				return
+			}
+
+			if detail.GetAmount().GetPlaintext() != tt.wantAmount {
+				t.Errorf("amount %s, want %s", detail.GetAmount().GetPlaintext(), tt.wantAmount)
+			}
+
+			if detail.GetCurrencyCode() != tt.wantCurr {
+				t.Errorf("currency %q, want %q", detail.GetCurrencyCode(), tt.wantCurr)
+			}
+
+			if detail.GetForeignAmount().GetPlaintext() != tt.wantForeign {
+				t.Errorf("foreign amount %q, want %q", detail.GetForeignAmount().GetPlaintext(), tt.wantForeign)
+			}
+		})
+	}
+}
+
+// A foreign currency balance of an account.
+func testForeignBalance(n byte, currencyCode string, foreign string, base string) *foreignBalance {
+	return &foreignBalance{
+		accountId:    testAccountId(n),
+		currencyCode: currencyCode,
+		foreign:      sdec.RequireFromString(foreign),
+		base:         sdec.RequireFromString(base),
+	}
+}
+
+func TestRevaluationDetails(t *testing.T) {
+	type wantDetail struct {
+		account  byte
+		amount   string
+		isDebit  bool
+		currency string
+	}
+
+	rates := map[string]sdec.Decimal{
+		"EUR": sdec.RequireFromString("1.2"),
+		"GBP": sdec.RequireFromString("1.25"),
+	}
+
+	tests := []struct {
+		name     string
+		balances []*foreignBalance
+		wantCode int32
+		want     []wantDetail
+	}{
+		{
+			name: "gain on an asset and a loss on a liability",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "EUR", "1000", "1100"),
+				testForeignBalance(2, "GBP", "-400", "-480"),
+			},
+			want: []wantDetail{{1, "100.00", true, "EUR"}, {2, "20.00", false, "GBP"}, {8, "100.00", false, ""}, {9, "20.00", true, ""}},
+		},
+		{
+			name: "gains on two accounts",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "EUR", "1000", "1150"),
+				testForeignBalance(2, "EUR", "-100", "-130"),
+			},
+			want: []wantDetail{{1, "50.00", true, "EUR"}, {2, "10.00", true, "EUR"}, {8, "60.00", false, ""}},
+		},
+		{
+			name: "loss on an asset",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "GBP", "100", "130"),
+			},
+			want: []wantDetail{{1, "5.00", false, "GBP"}, {9, "5.00", true, ""}},
+		},
+		{
+			name: "rounded to the cent",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "EUR", "10.01", "12.00"),
+			},
+			want: []wantDetail{{1, "0.01", true, "EUR"}, {8, "0.01", false, ""}},
+		},
+		{
+			name: "already at the rate",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "EUR", "1000", "1200"),
+			},
+		},
+		{
+			name: "currency without a rate",
+			balances: []*foreignBalance{
+				testForeignBalance(1, "JPY", "1000", "9"),
+			},
+			wantCode: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gResp, details := revaluationDetails(tt.balances, rates, testAccountId(8), testAccountId(9))
+			if gResp.ErrorCode != tt.wantCode {
+				t.Fatalf("error code %d, want %d", gResp.ErrorCode, tt.wantCode)
+			}
+
+			if len(details) != len(tt.want) {
+				t.Fatalf("got %d details, want %d", len(details), len(tt.want))
+			}
+
+			for i, detail := range details {
+				want := tt.want[i]
+				if detail.GetGlAccountId().GetGuid()[15] != want.account {
+					t.Errorf("detail %d account %d, want %d", i, detail.GetGlAccountId().GetGuid()[15], want.account)
+				}
+
+				if detail.GetAmount().GetPlaintext() != want.amount {
+					t.Errorf("detail %d amount %s, want %s", i, detail.GetAmount().GetPlaintext(), want.amount)
+				}
+
+				if detail.GetIsDebit() != want.isDebit {
+					t.Errorf("detail %d debit %t, want %t", i, detail.GetIsDebit(), want.isDebit)
+				}
+
+				if detail.GetCurrencyCode() != want.currency {
+					t.Errorf("detail %d currency %q, want %q", i, detail.GetCurrencyCode(), want.currency)
+				}
+			}
+		})
+	}
+}
+
+func TestPeriodFollowing(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      time.Time
+		periodEnd sql.NullTime
+		want      time.Time
+	}{
+		{
+			name:      "monthly period",
+			date:      localDate(2021, time.January, 15),
+			periodEnd: sql.NullTime{Time: localDate(2021, time.February, 1).Add(-time.Second), Valid: true},
+			want:      localDate(2021, time.February, 1),
+		},
+		{
+			name:      "four four five period",
+			date:      localDate(2021, time.January, 29),
+			periodEnd: sql.NullTime{Time: localDate(2021, time.January, 31).Add(-time.Second), Valid: true},
+			want:      localDate(2021, time.January, 31),
+		},
+		{
+			name: "no fiscal period",
+			date: localDate(2021, time.March, 31),
+			want: localDate(2021, time.April, 1),
+		},
+		{
+			name: "no fiscal period at the end of the year",
+			date: localDate(2021, time.December, 31),
+			want: localDate(2022, time.January, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := periodFollowing(tt.date, tt.periodEnd)
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
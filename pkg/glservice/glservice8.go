@@ -0,0 +1,693 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// dimension values such as CC-100 or north_east
+var dimensionValueValidator = regexp.MustCompile("^[A-Za-z0-9_\\-\\.]{1,32}$")
+
+// create general ledger analytical dimension
+func (s *glService) CreateDimension(ctx context.Context, req *pb.CreateDimensionRequest) (*pb.CreateDimensionResponse, error) {
+	resp := &pb.CreateDimensionResponse{}
+
+	if !nameValidator.MatchString(req.GetDimensionName()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "dimension_name invalid format"
+		return resp, nil
+	}
+
+	// make sure the organization exists
+	gResp, _ := s.getBaseCurrency(req.GetMserviceId(), req.GetOrganizationId().GetGuid())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLDimension (uidOrganizationId, chvDimensionName, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+	intVersion, inbMserviceId, chvDescription) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetMserviceId(), req.GetDescription())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger analytical dimension
+func (s *glService) UpdateDimension(ctx context.Context, req *pb.UpdateDimensionRequest) (*pb.UpdateDimensionResponse, error) {
+	resp := &pb.UpdateDimensionResponse{}
+
+	sqlstring := `UPDATE tb_GLDimension SET dtmModified = NOW(), intVersion = ?, chvDescription = ?
+	WHERE uidOrganizationId = ? AND chvDimensionName = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), req.GetOrganizationId().GetGuid(), req.GetDimensionName(),
+		req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger analytical dimension
+func (s *glService) DeleteDimension(ctx context.Context, req *pb.DeleteDimensionRequest) (*pb.DeleteDimensionResponse, error) {
+	resp := &pb.DeleteDimensionResponse{}
+
+	// values are deleted first, which in turn requires them to be unused
+	var valueCount int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLDimensionValue WHERE uidOrganizationId = ? AND chvDimensionName = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`,
+		req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetMserviceId()).Scan(&valueCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if valueCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "dimension has values"
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLDimension SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvDimensionName = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger analytical dimensions with their values by organization
+func (s *glService) GetDimensionsByOrganization(ctx context.Context, req *pb.GetDimensionsByOrganizationRequest) (*pb.GetDimensionsByOrganizationResponse, error) {
+	resp := &pb.GetDimensionsByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvDimensionName, dtmCreated, dtmModified, intVersion, inbMserviceId, chvDescription
+	FROM tb_GLDimension WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY chvDimensionName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	dimMap := make(map[string]*pb.GLDimension)
+
+	for rows.Next() {
+		var dim pb.GLDimension
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&orgId, &dim.DimensionName, &created, &modified, &dim.Version, &dim.MserviceId, &dim.Description)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		dim.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		dim.Created = dml.DateTimeFromTime(created)
+		dim.Modified = dml.DateTimeFromTime(modified)
+
+		dimMap[dim.GetDimensionName()] = &dim
+		resp.GlDimensions = append(resp.GlDimensions, &dim)
+	}
+
+	// now get the values
+	sqlstring2 := `SELECT uidOrganizationId, chvDimensionName, chvDimensionValue, dtmCreated, dtmModified, intVersion, inbMserviceId,
+	chvDescription FROM tb_GLDimensionValue WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY chvDimensionName, chvDimensionValue`
+
+	stmt2, err := s.db.Prepare(sqlstring2)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt2.Close()
+
+	rows2, err := stmt2.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows2.Close()
+
+	for rows2.Next() {
+		var dimValue pb.GLDimensionValue
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+
+		err := rows2.Scan(&orgId, &dimValue.DimensionName, &dimValue.DimensionValue, &created, &modified, &dimValue.Version,
+			&dimValue.MserviceId, &dimValue.Description)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		dim, ok := dimMap[dimValue.GetDimensionName()]
+		if ok {
+			dimValue.OrganizationId, _ = dml.GuidFromBytes(orgId)
+			dimValue.Created = dml.DateTimeFromTime(created)
+			dimValue.Modified = dml.DateTimeFromTime(modified)
+
+			dim.DimensionValues = append(dim.DimensionValues, &dimValue)
+		}
+	}
+
+	return resp, nil
+}
+
+// create allowed value of general ledger analytical dimension
+func (s *glService) CreateDimensionValue(ctx context.Context, req *pb.CreateDimensionValueRequest) (*pb.CreateDimensionValueResponse, error) {
+	resp := &pb.CreateDimensionValueResponse{}
+
+	if !dimensionValueValidator.MatchString(req.GetDimensionValue()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "dimension_value invalid format"
+		return resp, nil
+	}
+
+	var dimName string
+	err := s.db.QueryRow(`SELECT chvDimensionName FROM tb_GLDimension WHERE uidOrganizationId = ? AND chvDimensionName = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`,
+		req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetMserviceId()).Scan(&dimName)
+	if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "dimension not found"
+		return resp, nil
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLDimensionValue (uidOrganizationId, chvDimensionName, chvDimensionValue, dtmCreated, dtmModified,
+	dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvDescription) VALUES (?, ?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetDimensionValue(), req.GetMserviceId(),
+		req.GetDescription())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update allowed value of general ledger analytical dimension
+func (s *glService) UpdateDimensionValue(ctx context.Context, req *pb.UpdateDimensionValueRequest) (*pb.UpdateDimensionValueResponse, error) {
+	resp := &pb.UpdateDimensionValueResponse{}
+
+	sqlstring := `UPDATE tb_GLDimensionValue SET dtmModified = NOW(), intVersion = ?, chvDescription = ?
+	WHERE uidOrganizationId = ? AND chvDimensionName = ? AND chvDimensionValue = ? AND intVersion = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), req.GetOrganizationId().GetGuid(), req.GetDimensionName(),
+		req.GetDimensionValue(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete allowed value of general ledger analytical dimension
+func (s *glService) DeleteDimensionValue(ctx context.Context, req *pb.DeleteDimensionValueRequest) (*pb.DeleteDimensionValueResponse, error) {
+	resp := &pb.DeleteDimensionValueResponse{}
+
+	// a value stays while transaction details or recurring entry templates of the organization refer to it
+	var useCount int
+	err := s.db.QueryRow(`SELECT (SELECT COUNT(*) FROM tb_GLTransactionDetailDimension AS m
+	JOIN tb_GLTransaction AS t
+	ON m.inbGlTransactionId = t.inbGlTransactionId
+	WHERE t.uidOrganizationId = ? AND t.inbMserviceId = ? AND m.chvDimensionName = ? AND m.chvDimensionValue = ?) +
+	(SELECT COUNT(*) FROM tb_GLRecurringEntryDetailDimension AS m
+	JOIN tb_GLRecurringEntry AS e
+	ON m.inbRecurringEntryId = e.inbRecurringEntryId
+	WHERE e.uidOrganizationId = ? AND e.inbMserviceId = ? AND e.bitIsDeleted = 0 AND m.chvDimensionName = ? AND m.chvDimensionValue = ?)`,
+		req.GetOrganizationId().GetGuid(), req.GetMserviceId(), req.GetDimensionName(), req.GetDimensionValue(),
+		req.GetOrganizationId().GetGuid(), req.GetMserviceId(), req.GetDimensionName(), req.GetDimensionValue()).Scan(&useCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	if useCount > 0 {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = "dimension value is in use"
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLDimensionValue SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvDimensionName = ? AND chvDimensionValue = ? AND intVersion = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetDimensionValue(),
+		req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger account balances grouped by value of an analytical dimension
+func (s *glService) GetDimensionBalances(ctx context.Context, req *pb.GetDimensionBalancesRequest) (*pb.GetDimensionBalancesResponse, error) {
+	resp := &pb.GetDimensionBalancesResponse{}
+
+	sqlstring := `SELECT chvDimensionValue FROM tb_GLDimensionValue WHERE uidOrganizationId = ? AND chvDimensionName = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0
+	ORDER BY chvDimensionValue`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetDimensionName(), req.GetMserviceId())
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var values []sql.NullString
+
+	for rows.Next() {
+		var value sql.NullString
+
+		err := rows.Scan(&value)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		values = append(values, value)
+	}
+
+	if len(values) == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "dimension not found or has no values"
+		return resp, nil
+	}
+
+	// details without a value for the dimension are grouped last
+	values = append(values, sql.NullString{})
+
+	for _, value := range values {
+		filter := balanceFilter{
+			mserviceId:     req.GetMserviceId(),
+			organizationId: req.GetOrganizationId().GetGuid(),
+			endDate:        req.GetEndDate().TimeFromDateTime(),
+			status:         reportStatus(req.GetTransactionStatus()),
+			dimensionName:  req.GetDimensionName(),
+			dimensionValue: value,
+		}
+
+		if req.GetStartDate() != nil {
+			filter.startDate.Time = req.GetStartDate().TimeFromDateTime()
+			filter.startDate.Valid = true
+		}
+
+		balances, err := s.getAccountBalances(&filter)
+		if err != nil {
+			level.Error(s.logger).Log("what", "getAccountBalances", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		dimBalance := pb.GLDimensionBalance{}
+		dimBalance.DimensionValue = value.String
+
+		debits := sdec.Zero
+		credits := sdec.Zero
+
+		for _, bal := range balances {
+			if bal.debits.IsZero() && bal.credits.IsZero() {
+				continue
+			}
+
+			dimBalance.GlAccountBalances = append(dimBalance.GlAccountBalances, convertAccountBalance(bal))
+			debits = debits.Add(bal.debits)
+			credits = credits.Add(bal.credits)
+		}
+
+		if !value.Valid && (len(dimBalance.GlAccountBalances) == 0) {
+			continue
+		}
+
+		dimBalance.TotalDebits = decimalFromAmount(debits)
+		dimBalance.TotalCredits = decimalFromAmount(credits)
+		dimBalance.NetBalance = decimalFromAmount(debits.Sub(credits))
+
+		resp.GlDimensionBalances = append(resp.GlDimensionBalances, &dimBalance)
+	}
+
+	return resp, nil
+}
+
+// Check that the dimension values of transaction details are allowed values of the organization's dimensions,
+// with at most one value per dimension on a detail.
+func (s *glService) checkDetailDimensions(mserviceId int64, organizationId []byte, details []*pb.GLTransactionDetail) *genericResponse {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT chvDimensionValue FROM tb_GLDimensionValue
+	WHERE uidOrganizationId = ? AND chvDimensionName = ? AND chvDimensionValue = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	gResp, dims := distinctDetailDimensions(details)
+	if gResp.ErrorCode != 0 {
+		return gResp
+	}
+
+	for _, dim := range dims {
+		var value string
+		err := stmt.QueryRow(organizationId, dim.GetDimensionName(), dim.GetDimensionValue(), mserviceId).Scan(&value)
+		if err == sql.ErrNoRows {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("dimension value %s=%s not found", dim.GetDimensionName(), dim.GetDimensionValue())
+			return resp
+		} else if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+	}
+
+	return resp
+}
+
+// Get the distinct dimension values of transaction details in order of first use, checking that no detail has
+// more than one value for a dimension.
+func distinctDetailDimensions(details []*pb.GLTransactionDetail) (*genericResponse, []*pb.GLDetailDimension) {
+	resp := &genericResponse{}
+
+	var dims []*pb.GLDetailDimension
+	used := make(map[string]bool)
+
+	for _, detail := range details {
+		seen := make(map[string]bool)
+
+		for _, dim := range detail.GetDimensions() {
+			if seen[dim.GetDimensionName()] {
+				resp.ErrorCode = 510
+				resp.ErrorMessage = fmt.Sprintf("dimension %s given more than once on a detail", dim.GetDimensionName())
+				return resp, nil
+			}
+
+			seen[dim.GetDimensionName()] = true
+
+			key := dim.GetDimensionName() + "=" + dim.GetDimensionValue()
+			if !used[key] {
+				used[key] = true
+				dims = append(dims, dim)
+			}
+		}
+	}
+
+	return resp, dims
+}
+
+// Insert the dimension values of a transaction detail as part of a database transaction.
+func commitDetailDimensions(tx *sql.Tx, transactionId int64, sequenceNumber int32, detail *pb.GLTransactionDetail) error {
+	for _, dim := range detail.GetDimensions() {
+		_, err := tx.Exec(`INSERT INTO tb_GLTransactionDetailDimension (inbGlTransactionId, intSequenceNumber, chvDimensionName,
+		chvDimensionValue) VALUES (?, ?, ?, ?)`, transactionId, sequenceNumber, dim.GetDimensionName(), dim.GetDimensionValue())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Attach dimension values to the details of transactions, looking details up by transaction id and sequence number.
+// The query selects transaction id, sequence number, dimension name and dimension value.
+func (s *glService) loadDetailDimensions(details map[string]*pb.GLTransactionDetail, sqlstring string, args ...interface{}) *genericResponse {
+	resp := &genericResponse{}
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var transactionId int64
+		var sequenceNumber int32
+		var dim pb.GLDetailDimension
+
+		err := rows.Scan(&transactionId, &sequenceNumber, &dim.DimensionName, &dim.DimensionValue)
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		detail, ok := details[detailKey(transactionId, sequenceNumber)]
+		if ok {
+			detail.Dimensions = append(detail.Dimensions, &dim)
+		}
+	}
+
+	return resp
+}
+
+// Key of a transaction detail in a map of details.
+func detailKey(transactionId int64, sequenceNumber int32) string {
+	return fmt.Sprintf("%d:%d", transactionId, sequenceNumber)
+}
@@ -0,0 +1,111 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+)
+
+// A transaction detail tagged with dimension values given as name and value pairs.
+func testTaggedDetail(pairs ...string) *pb.GLTransactionDetail {
+	detail := &pb.GLTransactionDetail{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		detail.Dimensions = append(detail.Dimensions, &pb.GLDetailDimension{DimensionName: pairs[i], DimensionValue: pairs[i+1]})
+	}
+
+	return detail
+}
+
+func TestDistinctDetailDimensions(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  []*pb.GLTransactionDetail
+		wantCode int32
+		want     []string
+	}{
+		{
+			name: "values in order of first use",
+			details: []*pb.GLTransactionDetail{
+				testTaggedDetail("cost_center", "CC-100", "region", "north"),
+				testTaggedDetail("cost_center", "CC-200", "region", "north"),
+				testTaggedDetail(),
+			},
+			want: []string{"cost_center=CC-100", "region=north", "cost_center=CC-200"},
+		},
+		{
+			name: "same dimension on different details",
+			details: []*pb.GLTransactionDetail{
+				testTaggedDetail("cost_center", "CC-100"),
+				testTaggedDetail("cost_center", "CC-100"),
+			},
+			want: []string{"cost_center=CC-100"},
+		},
+		{
+			name: "dimension given twice on a detail",
+			details: []*pb.GLTransactionDetail{
+				testTaggedDetail("cost_center", "CC-100", "cost_center", "CC-200"),
+			},
+			wantCode: 510,
+		},
+		{
+			name: "untagged details",
+			details: []*pb.GLTransactionDetail{
+				testTaggedDetail(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gResp, dims := distinctDetailDimensions(tt.details)
+			if gResp.ErrorCode != tt.wantCode {
+				t.Fatalf("error code %d %s, want %d", gResp.ErrorCode, gResp.ErrorMessage, tt.wantCode)
+			}
+
+			if len(dims) != len(tt.want) {
+				t.Fatalf("got %d dimension values, want %d", len(dims), len(tt.want))
+			}
+
+			for i, dim := range dims {
+				got := dim.GetDimensionName() + "=" + dim.GetDimensionValue()
+				if got != tt.want[i] {
+					t.Errorf("dimension value %d is %s, want %s", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDimensionValueValidator(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"CC-100", true},
+		{"north_east", true},
+		{"v1.2", true},
+		{"", false},
+		{"north east", false},
+		{"a/b", false},
+		{"abcdefghijklmnopqrstuvwxyz0123456", false},
+	}
+
+	for _, tt := range tests {
+		if got := dimensionValueValidator.MatchString(tt.value); got != tt.want {
+			t.Errorf("%q valid %t, want %t", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,795 @@
+// Copyright 2020-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	pb "github.com/gaterace/mledger/pkg/mserviceledger"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// create general ledger budget for a fiscal year
+func (s *glService) CreateBudget(ctx context.Context, req *pb.CreateBudgetRequest) (*pb.CreateBudgetResponse, error) {
+	resp := &pb.CreateBudgetResponse{}
+
+	if !nameValidator.MatchString(req.GetBudgetName()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "budget_name invalid format"
+		return resp, nil
+	}
+
+	gResp, _ := s.GetFiscalYearHelper(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetFiscalYear())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		if gResp.ErrorCode == 404 {
+			resp.ErrorMessage = "fiscal year not found"
+		}
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLBudget (uidOrganizationId, chvBudgetName, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted,
+	intVersion, inbMserviceId, intFiscalYear, chvDescription) VALUES (?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetMserviceId(), req.GetFiscalYear(),
+		req.GetDescription())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger budget
+func (s *glService) UpdateBudget(ctx context.Context, req *pb.UpdateBudgetRequest) (*pb.UpdateBudgetResponse, error) {
+	resp := &pb.UpdateBudgetResponse{}
+
+	sqlstring := `UPDATE tb_GLBudget SET dtmModified = NOW(), intVersion = ?, chvDescription = ?
+	WHERE uidOrganizationId = ? AND chvBudgetName = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetDescription(), req.GetOrganizationId().GetGuid(), req.GetBudgetName(),
+		req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger budget with its amounts
+func (s *glService) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	resp := &pb.DeleteBudgetResponse{}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	res, err := tx.Exec(`UPDATE tb_GLBudget SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvBudgetName = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetVersion(), req.GetMserviceId())
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected != 1 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+		return resp, nil
+	}
+
+	_, err = tx.Exec(`UPDATE tb_GLBudgetAmount SET dtmDeleted = NOW(), intVersion = intVersion + 1, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvBudgetName = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+		req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetMserviceId())
+	if err == nil {
+		err = tx.Commit()
+	}
+
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		return resp, nil
+	}
+
+	resp.Version = req.GetVersion() + 1
+
+	return resp, nil
+}
+
+// get general ledger budgets by organization
+func (s *glService) GetBudgetsByOrganization(ctx context.Context, req *pb.GetBudgetsByOrganizationRequest) (*pb.GetBudgetsByOrganizationResponse, error) {
+	resp := &pb.GetBudgetsByOrganizationResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvBudgetName, dtmCreated, dtmModified, intVersion, inbMserviceId, intFiscalYear, chvDescription
+	FROM tb_GLBudget WHERE uidOrganizationId = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY intFiscalYear, chvBudgetName`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(req.GetOrganizationId().GetGuid(), req.GetMserviceId())
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var budget pb.GLBudget
+		var orgId []byte
+		var created time.Time
+		var modified time.Time
+
+		err := rows.Scan(&orgId, &budget.BudgetName, &created, &modified, &budget.Version, &budget.MserviceId, &budget.FiscalYear,
+			&budget.Description)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		budget.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		budget.Created = dml.DateTimeFromTime(created)
+		budget.Modified = dml.DateTimeFromTime(modified)
+
+		resp.GlBudgets = append(resp.GlBudgets, &budget)
+	}
+
+	return resp, nil
+}
+
+// create general ledger budget amount for account and fiscal period
+func (s *glService) CreateBudgetAmount(ctx context.Context, req *pb.CreateBudgetAmountRequest) (*pb.CreateBudgetAmountResponse, error) {
+	resp := &pb.CreateBudgetAmountResponse{}
+
+	amount := pb.GLBudgetAmount{
+		GlAccountId:  req.GetGlAccountId(),
+		PeriodNumber: req.GetPeriodNumber(),
+		Amount:       req.GetAmount(),
+	}
+
+	gResp, budget := s.GetBudgetHelper(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkBudgetAmounts(budget, []*pb.GLBudgetAmount{&amount})
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `INSERT INTO tb_GLBudgetAmount (uidOrganizationId, chvBudgetName, uidGlAccountId, intPeriodNumber, dtmCreated,
+	dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, decAmount) VALUES (?, ?, ?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetGlAccountId().GetGuid(),
+		req.GetPeriodNumber(), req.GetMserviceId(), req.GetAmount().StringFromDecimal())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// update general ledger budget amount
+func (s *glService) UpdateBudgetAmount(ctx context.Context, req *pb.UpdateBudgetAmountRequest) (*pb.UpdateBudgetAmountResponse, error) {
+	resp := &pb.UpdateBudgetAmountResponse{}
+
+	amount := pb.GLBudgetAmount{
+		GlAccountId:  req.GetGlAccountId(),
+		PeriodNumber: req.GetPeriodNumber(),
+		Amount:       req.GetAmount(),
+	}
+
+	gResp, budget := s.GetBudgetHelper(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkBudgetAmounts(budget, []*pb.GLBudgetAmount{&amount})
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	sqlstring := `UPDATE tb_GLBudgetAmount SET dtmModified = NOW(), intVersion = ?, decAmount = ?
+	WHERE uidOrganizationId = ? AND chvBudgetName = ? AND uidGlAccountId = ? AND intPeriodNumber = ? AND intVersion = ?
+	AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetAmount().StringFromDecimal(), req.GetOrganizationId().GetGuid(),
+		req.GetBudgetName(), req.GetGlAccountId().GetGuid(), req.GetPeriodNumber(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// delete general ledger budget amount
+func (s *glService) DeleteBudgetAmount(ctx context.Context, req *pb.DeleteBudgetAmountRequest) (*pb.DeleteBudgetAmountResponse, error) {
+	resp := &pb.DeleteBudgetAmountResponse{}
+
+	sqlstring := `UPDATE tb_GLBudgetAmount SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
+	WHERE uidOrganizationId = ? AND chvBudgetName = ? AND uidGlAccountId = ? AND intPeriodNumber = ? AND intVersion = ?
+	AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.Exec(req.GetVersion()+1, req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetGlAccountId().GetGuid(),
+		req.GetPeriodNumber(), req.GetVersion(), req.GetMserviceId())
+
+	if err == nil {
+		rowsAffected, _ := res.RowsAffected()
+		if rowsAffected == 1 {
+			resp.Version = req.GetVersion() + 1
+		} else {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		}
+	} else {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Exec", "error", err)
+		err = nil
+	}
+
+	return resp, nil
+}
+
+// get general ledger budget amounts of budget
+func (s *glService) GetBudgetAmounts(ctx context.Context, req *pb.GetBudgetAmountsRequest) (*pb.GetBudgetAmountsResponse, error) {
+	resp := &pb.GetBudgetAmountsResponse{}
+
+	gResp, amounts := s.getBudgetAmounts(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.GlBudgetAmounts = amounts
+
+	return resp, nil
+}
+
+// create or update general ledger budget amounts in bulk
+func (s *glService) LoadBudgetAmounts(ctx context.Context, req *pb.LoadBudgetAmountsRequest) (*pb.LoadBudgetAmountsResponse, error) {
+	resp := &pb.LoadBudgetAmountsResponse{}
+
+	gResp, budget := s.GetBudgetHelper(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode == 0 {
+		gResp = s.checkBudgetAmounts(budget, req.GetGlBudgetAmounts())
+	}
+
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		level.Error(s.logger).Log("what", "Begin", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer tx.Rollback() // The rollback will be ignored if the tx has been committed later in the function.
+
+	if req.GetReplaceExisting() {
+		_, err = tx.Exec(`UPDATE tb_GLBudgetAmount SET dtmDeleted = NOW(), intVersion = intVersion + 1, bitIsDeleted = 1
+		WHERE uidOrganizationId = ? AND chvBudgetName = ? AND inbMserviceId = ? AND bitIsDeleted = 0`,
+			req.GetOrganizationId().GetGuid(), req.GetBudgetName(), req.GetMserviceId())
+		if err != nil {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			return resp, nil
+		}
+	}
+
+	sqlstring := `INSERT INTO tb_GLBudgetAmount (uidOrganizationId, chvBudgetName, uidGlAccountId, intPeriodNumber, dtmCreated,
+	dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, decAmount) VALUES (?, ?, ?, ?, NOW(), NOW(), NOW(), 0, 1, ?, ?)
+	ON DUPLICATE KEY UPDATE dtmModified = NOW(), intVersion = intVersion + 1, decAmount = VALUES(decAmount), bitIsDeleted = 0`
+
+	for _, amount := range req.GetGlBudgetAmounts() {
+		_, err = tx.Exec(sqlstring, req.GetOrganizationId().GetGuid(), req.GetBudgetName(), amount.GetGlAccountId().GetGuid(),
+			amount.GetPeriodNumber(), req.GetMserviceId(), amount.GetAmount().StringFromDecimal())
+		if err != nil {
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+			level.Error(s.logger).Log("what", "Exec", "error", err)
+			return resp, nil
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.ErrorCode = 501
+		resp.ErrorMessage = err.Error()
+		level.Error(s.logger).Log("what", "Commit", "error", err)
+		return resp, nil
+	}
+
+	resp.AmountCount = int32(len(req.GetGlBudgetAmounts()))
+
+	return resp, nil
+}
+
+// get general ledger budget versus actual amounts with variances
+func (s *glService) GetBudgetVsActual(ctx context.Context, req *pb.GetBudgetVsActualRequest) (*pb.GetBudgetVsActualResponse, error) {
+	resp := &pb.GetBudgetVsActualResponse{}
+
+	gResp, budget := s.GetBudgetHelper(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	pReq := pb.GetFiscalPeriodsByYearRequest{
+		MserviceId:     req.GetMserviceId(),
+		OrganizationId: req.GetOrganizationId(),
+		FiscalYear:     budget.GetFiscalYear(),
+	}
+
+	pResp, _ := s.GetFiscalPeriodsByYear(ctx, &pReq)
+	if pResp.GetErrorCode() != 0 {
+		resp.ErrorCode = pResp.GetErrorCode()
+		resp.ErrorMessage = pResp.GetErrorMessage()
+		return resp, nil
+	}
+
+	var periods []*pb.GLFiscalPeriod
+
+	for _, period := range pResp.GetGlFiscalPeriods() {
+		if (req.GetStartPeriod() != 0) && (period.GetPeriodNumber() < req.GetStartPeriod()) {
+			continue
+		}
+
+		if (req.GetEndPeriod() != 0) && (period.GetPeriodNumber() > req.GetEndPeriod()) {
+			continue
+		}
+
+		periods = append(periods, period)
+	}
+
+	if len(periods) == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "no fiscal periods selected"
+		return resp, nil
+	}
+
+	gResp, amounts := s.getBudgetAmounts(req.GetMserviceId(), req.GetOrganizationId().GetGuid(), req.GetBudgetName())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// budgeted amounts by account, then by period number
+	budgeted := make(map[string]map[int32]sdec.Decimal)
+	for _, amount := range amounts {
+		key := string(amount.GetGlAccountId().GetGuid())
+		if budgeted[key] == nil {
+			budgeted[key] = make(map[int32]sdec.Decimal)
+		}
+
+		budgeted[key][amount.GetPeriodNumber()], _ = sdec.NewFromString(amount.GetAmount().StringFromDecimal())
+	}
+
+	// without by_period the selected periods form a single range
+	ranges := [][]*pb.GLFiscalPeriod{periods}
+	if req.GetByPeriod() {
+		ranges = nil
+		for _, period := range periods {
+			ranges = append(ranges, []*pb.GLFiscalPeriod{period})
+		}
+	}
+
+	for _, rangePeriods := range ranges {
+		first := rangePeriods[0]
+		last := rangePeriods[len(rangePeriods)-1]
+
+		filter := balanceFilter{
+			mserviceId:     req.GetMserviceId(),
+			organizationId: req.GetOrganizationId().GetGuid(),
+			endDate:        last.GetEndDate().TimeFromDateTime(),
+			excludeClosing: true,
+			status:         reportStatus(req.GetTransactionStatus()),
+		}
+
+		filter.startDate.Time = first.GetStartDate().TimeFromDateTime()
+		filter.startDate.Valid = true
+
+		balances, gResp := s.getReportBalances(&filter)
+		if gResp.ErrorCode != 0 {
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+
+		for _, bal := range balances {
+			budgetAmount := sdec.Zero
+			hasBudget := false
+
+			for _, period := range rangePeriods {
+				amount, ok := budgeted[string(bal.accountId)][period.GetPeriodNumber()]
+				if ok {
+					budgetAmount = budgetAmount.Add(amount)
+					hasBudget = true
+				}
+			}
+
+			// accounts neither budgeted nor used are left out
+			if !hasBudget && bal.debits.IsZero() && bal.credits.IsZero() {
+				continue
+			}
+
+			line := newBudgetVarianceLine(bal, budgetAmount)
+			if req.GetByPeriod() {
+				line.PeriodNumber = first.GetPeriodNumber()
+			}
+
+			resp.GlBudgetVarianceLines = append(resp.GlBudgetVarianceLines, line)
+		}
+	}
+
+	return resp, nil
+}
+
+// Get a general ledger budget by organization and name.
+func (s *glService) GetBudgetHelper(mserviceId int64, organizationId []byte, budgetName string) (*genericResponse, *pb.GLBudget) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvBudgetName, dtmCreated, dtmModified, intVersion, inbMserviceId, intFiscalYear, chvDescription
+	FROM tb_GLBudget WHERE uidOrganizationId = ? AND chvBudgetName = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	var budget pb.GLBudget
+	var orgId []byte
+	var created time.Time
+	var modified time.Time
+
+	err = stmt.QueryRow(organizationId, budgetName, mserviceId).Scan(&orgId, &budget.BudgetName, &created, &modified, &budget.Version,
+		&budget.MserviceId, &budget.FiscalYear, &budget.Description)
+
+	if err == nil {
+		budget.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		budget.Created = dml.DateTimeFromTime(created)
+		budget.Modified = dml.DateTimeFromTime(modified)
+	} else if err == sql.ErrNoRows {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "budget not found"
+	} else {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	}
+
+	return resp, &budget
+}
+
+// Get the amounts of a general ledger budget, ordered by account and period.
+func (s *glService) getBudgetAmounts(mserviceId int64, organizationId []byte, budgetName string) (*genericResponse, []*pb.GLBudgetAmount) {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT uidOrganizationId, chvBudgetName, uidGlAccountId, intPeriodNumber, dtmCreated, dtmModified, intVersion,
+	inbMserviceId, decAmount
+	FROM tb_GLBudgetAmount WHERE uidOrganizationId = ? AND chvBudgetName = ? AND inbMserviceId = ? AND bitIsDeleted = 0
+	ORDER BY uidGlAccountId, intPeriodNumber`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp, nil
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(organizationId, budgetName, mserviceId)
+
+	if err != nil {
+		level.Error(s.logger).Log("what", "Query", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+
+	defer rows.Close()
+
+	var amounts []*pb.GLBudgetAmount
+
+	for rows.Next() {
+		var amount pb.GLBudgetAmount
+		var orgId []byte
+		var accountId []byte
+		var created time.Time
+		var modified time.Time
+		var amt string
+
+		err := rows.Scan(&orgId, &amount.BudgetName, &accountId, &amount.PeriodNumber, &created, &modified, &amount.Version,
+			&amount.MserviceId, &amt)
+
+		if err != nil {
+			level.Error(s.logger).Log("what", "Scan", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp, nil
+		}
+
+		amount.OrganizationId, _ = dml.GuidFromBytes(orgId)
+		amount.GlAccountId, _ = dml.GuidFromBytes(accountId)
+		amount.Created = dml.DateTimeFromTime(created)
+		amount.Modified = dml.DateTimeFromTime(modified)
+		amount.Amount, _ = dml.DecimalFromString(amt)
+
+		amounts = append(amounts, &amount)
+	}
+
+	return resp, amounts
+}
+
+// Check budget amounts before they are written: each amount must be a valid decimal, for an account of the
+// organization and a period of the budget's fiscal year, and given only once.
+func (s *glService) checkBudgetAmounts(budget *pb.GLBudget, amounts []*pb.GLBudgetAmount) *genericResponse {
+	resp := &genericResponse{}
+
+	sqlstring := `SELECT COUNT(*) FROM tb_GLAccount WHERE uidGlAccountId = ? AND uidOrganizationId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = 0`
+
+	stmt, err := s.db.Prepare(sqlstring)
+	if err != nil {
+		level.Error(s.logger).Log("what", "Prepare", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "db.Prepare failed"
+		return resp
+	}
+
+	defer stmt.Close()
+
+	var periodCount int32
+	err = s.db.QueryRow(`SELECT COUNT(*) FROM tb_GLFiscalPeriod WHERE uidOrganizationId = ? AND intFiscalYear = ? AND inbMserviceId = ?`,
+		budget.GetOrganizationId().GetGuid(), budget.GetFiscalYear(), budget.GetMserviceId()).Scan(&periodCount)
+	if err != nil {
+		level.Error(s.logger).Log("what", "QueryRow", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+		return resp
+	}
+
+	accounts := make(map[string]bool)
+	seen := make(map[string]bool)
+
+	for _, amount := range amounts {
+		if amount.GetAmount() == nil {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "amount missing or invalid"
+			return resp
+		}
+
+		_, err := sdec.NewFromString(amount.GetAmount().StringFromDecimal())
+		if err != nil {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "amount missing or invalid"
+			return resp
+		}
+
+		// fiscal periods are numbered from one
+		if (amount.GetPeriodNumber() < 1) || (amount.GetPeriodNumber() > periodCount) {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("fiscal period %d not found in fiscal year %d", amount.GetPeriodNumber(), budget.GetFiscalYear())
+			return resp
+		}
+
+		accountKey := string(amount.GetGlAccountId().GetGuid())
+		key := fmt.Sprintf("%x:%d", amount.GetGlAccountId().GetGuid(), amount.GetPeriodNumber())
+		if seen[key] {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = fmt.Sprintf("budget amount for account %x and period %d given more than once",
+				amount.GetGlAccountId().GetGuid(), amount.GetPeriodNumber())
+			return resp
+		}
+
+		seen[key] = true
+
+		if accounts[accountKey] {
+			continue
+		}
+
+		var count int
+		err = stmt.QueryRow(amount.GetGlAccountId().GetGuid(), budget.GetOrganizationId().GetGuid(), budget.GetMserviceId()).Scan(&count)
+		if err != nil {
+			level.Error(s.logger).Log("what", "QueryRow", "error", err)
+			resp.ErrorCode = 500
+			resp.ErrorMessage = err.Error()
+			return resp
+		}
+
+		if count == 0 {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = fmt.Sprintf("account %x not found in organization", amount.GetGlAccountId().GetGuid())
+			return resp
+		}
+
+		accounts[accountKey] = true
+	}
+
+	return resp
+}
+
+// Build a budget versus actual line, the actual amount taken on the normal side of the account category.
+func newBudgetVarianceLine(bal *accountBalance, budgetAmount sdec.Decimal) *pb.GLBudgetVarianceLine {
+	actual := bal.reportAmount()
+	variance := actual.Sub(budgetAmount)
+
+	line := pb.GLBudgetVarianceLine{}
+	line.GlAccountId, _ = dml.GuidFromBytes(bal.accountId)
+	line.AccountName = bal.accountName
+	line.AccountCode = bal.accountCode.String
+	line.AccountTypeId = bal.accountTypeId
+	line.AccountType = bal.accountType
+	line.AccountCategory = bal.category
+	line.BudgetAmount = decimalFromAmount(budgetAmount)
+	line.ActualAmount = decimalFromAmount(actual)
+	line.Variance = decimalFromAmount(variance)
+
+	if !budgetAmount.IsZero() {
+		line.VariancePercent = decimalFromAmount(variance.Mul(sdec.NewFromInt(100)).Div(budgetAmount.Abs()))
+	}
+
+	return &line
+}
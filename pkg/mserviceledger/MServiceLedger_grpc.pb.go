@@ -82,6 +82,214 @@ type MServiceLedgerClient interface {
 	GetTransactionWrappersByDate(ctx context.Context, in *GetTransactionWrappersByDateRequest, opts ...grpc.CallOption) (*GetTransactionWrappersByDateResponse, error)
 	// add transaction details
 	AddTransactionDetails(ctx context.Context, in *AddTransactionDetailsRequest, opts ...grpc.CallOption) (*AddTransactionDetailsResponse, error)
+	// get general ledger trial balance for organization as of date
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	// get general ledger account balance as of date
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	// get general ledger account statement with running balance between dates
+	GetAccountLedger(ctx context.Context, in *GetAccountLedgerRequest, opts ...grpc.CallOption) (*GetAccountLedgerResponse, error)
+	// get general ledger balance sheet for organization as of date
+	GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error)
+	// get general ledger income statement for organization between dates
+	GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error)
+	// create general ledger fiscal year with its periods
+	CreateFiscalYear(ctx context.Context, in *CreateFiscalYearRequest, opts ...grpc.CallOption) (*CreateFiscalYearResponse, error)
+	// delete general ledger fiscal year with its periods
+	DeleteFiscalYear(ctx context.Context, in *DeleteFiscalYearRequest, opts ...grpc.CallOption) (*DeleteFiscalYearResponse, error)
+	// get general ledger fiscal years by organization
+	GetFiscalYearsByOrganization(ctx context.Context, in *GetFiscalYearsByOrganizationRequest, opts ...grpc.CallOption) (*GetFiscalYearsByOrganizationResponse, error)
+	// get general ledger fiscal periods by fiscal year
+	GetFiscalPeriodsByYear(ctx context.Context, in *GetFiscalPeriodsByYearRequest, opts ...grpc.CallOption) (*GetFiscalPeriodsByYearResponse, error)
+	// update status of general ledger fiscal period
+	UpdateFiscalPeriodStatus(ctx context.Context, in *UpdateFiscalPeriodStatusRequest, opts ...grpc.CallOption) (*UpdateFiscalPeriodStatusResponse, error)
+	// close general ledger fiscal year to retained earnings
+	CloseFiscalYear(ctx context.Context, in *CloseFiscalYearRequest, opts ...grpc.CallOption) (*CloseFiscalYearResponse, error)
+	// reopen closed general ledger fiscal year
+	ReopenFiscalYear(ctx context.Context, in *ReopenFiscalYearRequest, opts ...grpc.CallOption) (*ReopenFiscalYearResponse, error)
+	// reverse general ledger transaction with a mirror image transaction
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error)
+	// void general ledger transaction
+	VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error)
+	// post draft general ledger transaction
+	PostTransaction(ctx context.Context, in *PostTransactionRequest, opts ...grpc.CallOption) (*PostTransactionResponse, error)
+	// create and post general ledger transaction with its details in one step
+	PostJournalEntry(ctx context.Context, in *PostJournalEntryRequest, opts ...grpc.CallOption) (*PostJournalEntryResponse, error)
+	// replace all details of a draft general ledger transaction
+	ReplaceTransactionDetails(ctx context.Context, in *ReplaceTransactionDetailsRequest, opts ...grpc.CallOption) (*ReplaceTransactionDetailsResponse, error)
+	// get general ledger accounts of organization as a tree
+	GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error)
+	// create general ledger account code range for an account category
+	CreateAccountCodeRange(ctx context.Context, in *CreateAccountCodeRangeRequest, opts ...grpc.CallOption) (*CreateAccountCodeRangeResponse, error)
+	// update general ledger account code range
+	UpdateAccountCodeRange(ctx context.Context, in *UpdateAccountCodeRangeRequest, opts ...grpc.CallOption) (*UpdateAccountCodeRangeResponse, error)
+	// delete general ledger account code range
+	DeleteAccountCodeRange(ctx context.Context, in *DeleteAccountCodeRangeRequest, opts ...grpc.CallOption) (*DeleteAccountCodeRangeResponse, error)
+	// get general ledger account code ranges by organization
+	GetAccountCodeRangesByOrganization(ctx context.Context, in *GetAccountCodeRangesByOrganizationRequest, opts ...grpc.CallOption) (*GetAccountCodeRangesByOrganizationResponse, error)
+	// create general ledger exchange rate for a foreign currency
+	CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error)
+	// update general ledger exchange rate
+	UpdateExchangeRate(ctx context.Context, in *UpdateExchangeRateRequest, opts ...grpc.CallOption) (*UpdateExchangeRateResponse, error)
+	// delete general ledger exchange rate
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	// get general ledger exchange rates by organization
+	GetExchangeRatesByOrganization(ctx context.Context, in *GetExchangeRatesByOrganizationRequest, opts ...grpc.CallOption) (*GetExchangeRatesByOrganizationResponse, error)
+	// revalue foreign currency balances of organization, posting unrealized exchange gains and losses
+	RevalueForeignBalances(ctx context.Context, in *RevalueForeignBalancesRequest, opts ...grpc.CallOption) (*RevalueForeignBalancesResponse, error)
+	// create general ledger analytical dimension
+	CreateDimension(ctx context.Context, in *CreateDimensionRequest, opts ...grpc.CallOption) (*CreateDimensionResponse, error)
+	// update general ledger analytical dimension
+	UpdateDimension(ctx context.Context, in *UpdateDimensionRequest, opts ...grpc.CallOption) (*UpdateDimensionResponse, error)
+	// delete general ledger analytical dimension
+	DeleteDimension(ctx context.Context, in *DeleteDimensionRequest, opts ...grpc.CallOption) (*DeleteDimensionResponse, error)
+	// get general ledger analytical dimensions with their values by organization
+	GetDimensionsByOrganization(ctx context.Context, in *GetDimensionsByOrganizationRequest, opts ...grpc.CallOption) (*GetDimensionsByOrganizationResponse, error)
+	// create allowed value of general ledger analytical dimension
+	CreateDimensionValue(ctx context.Context, in *CreateDimensionValueRequest, opts ...grpc.CallOption) (*CreateDimensionValueResponse, error)
+	// update allowed value of general ledger analytical dimension
+	UpdateDimensionValue(ctx context.Context, in *UpdateDimensionValueRequest, opts ...grpc.CallOption) (*UpdateDimensionValueResponse, error)
+	// delete allowed value of general ledger analytical dimension
+	DeleteDimensionValue(ctx context.Context, in *DeleteDimensionValueRequest, opts ...grpc.CallOption) (*DeleteDimensionValueResponse, error)
+	// get general ledger account balances grouped by value of an analytical dimension
+	GetDimensionBalances(ctx context.Context, in *GetDimensionBalancesRequest, opts ...grpc.CallOption) (*GetDimensionBalancesResponse, error)
+	// create general ledger budget for a fiscal year
+	CreateBudget(ctx context.Context, in *CreateBudgetRequest, opts ...grpc.CallOption) (*CreateBudgetResponse, error)
+	// update general ledger budget
+	UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*UpdateBudgetResponse, error)
+	// delete general ledger budget with its amounts
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	// get general ledger budgets by organization
+	GetBudgetsByOrganization(ctx context.Context, in *GetBudgetsByOrganizationRequest, opts ...grpc.CallOption) (*GetBudgetsByOrganizationResponse, error)
+	// create general ledger budget amount for account and fiscal period
+	CreateBudgetAmount(ctx context.Context, in *CreateBudgetAmountRequest, opts ...grpc.CallOption) (*CreateBudgetAmountResponse, error)
+	// update general ledger budget amount
+	UpdateBudgetAmount(ctx context.Context, in *UpdateBudgetAmountRequest, opts ...grpc.CallOption) (*UpdateBudgetAmountResponse, error)
+	// delete general ledger budget amount
+	DeleteBudgetAmount(ctx context.Context, in *DeleteBudgetAmountRequest, opts ...grpc.CallOption) (*DeleteBudgetAmountResponse, error)
+	// get general ledger budget amounts of budget
+	GetBudgetAmounts(ctx context.Context, in *GetBudgetAmountsRequest, opts ...grpc.CallOption) (*GetBudgetAmountsResponse, error)
+	// create or update general ledger budget amounts in bulk
+	LoadBudgetAmounts(ctx context.Context, in *LoadBudgetAmountsRequest, opts ...grpc.CallOption) (*LoadBudgetAmountsResponse, error)
+	// get general ledger budget versus actual amounts with variances
+	GetBudgetVsActual(ctx context.Context, in *GetBudgetVsActualRequest, opts ...grpc.CallOption) (*GetBudgetVsActualResponse, error)
+	// create general ledger recurring journal entry
+	CreateRecurringEntry(ctx context.Context, in *CreateRecurringEntryRequest, opts ...grpc.CallOption) (*CreateRecurringEntryResponse, error)
+	// update general ledger recurring journal entry
+	UpdateRecurringEntry(ctx context.Context, in *UpdateRecurringEntryRequest, opts ...grpc.CallOption) (*UpdateRecurringEntryResponse, error)
+	// delete general ledger recurring journal entry
+	DeleteRecurringEntry(ctx context.Context, in *DeleteRecurringEntryRequest, opts ...grpc.CallOption) (*DeleteRecurringEntryResponse, error)
+	// get general ledger recurring journal entry by id
+	GetRecurringEntryById(ctx context.Context, in *GetRecurringEntryByIdRequest, opts ...grpc.CallOption) (*GetRecurringEntryByIdResponse, error)
+	// get general ledger recurring journal entries by organization
+	GetRecurringEntriesByOrganization(ctx context.Context, in *GetRecurringEntriesByOrganizationRequest, opts ...grpc.CallOption) (*GetRecurringEntriesByOrganizationResponse, error)
+	// get generated and upcoming occurrences of general ledger recurring journal entry
+	GetRecurringInstances(ctx context.Context, in *GetRecurringInstancesRequest, opts ...grpc.CallOption) (*GetRecurringInstancesResponse, error)
+	// create general ledger intercompany due to and due from accounts for partner organization
+	CreateIntercompanyAccount(ctx context.Context, in *CreateIntercompanyAccountRequest, opts ...grpc.CallOption) (*CreateIntercompanyAccountResponse, error)
+	// update general ledger intercompany due to and due from accounts
+	UpdateIntercompanyAccount(ctx context.Context, in *UpdateIntercompanyAccountRequest, opts ...grpc.CallOption) (*UpdateIntercompanyAccountResponse, error)
+	// delete general ledger intercompany due to and due from accounts
+	DeleteIntercompanyAccount(ctx context.Context, in *DeleteIntercompanyAccountRequest, opts ...grpc.CallOption) (*DeleteIntercompanyAccountResponse, error)
+	// get general ledger intercompany accounts by organization
+	GetIntercompanyAccountsByOrganization(ctx context.Context, in *GetIntercompanyAccountsByOrganizationRequest, opts ...grpc.CallOption) (*GetIntercompanyAccountsByOrganizationResponse, error)
+	// post general ledger transactions for one business event in two organizations
+	PostIntercompanyEntry(ctx context.Context, in *PostIntercompanyEntryRequest, opts ...grpc.CallOption) (*PostIntercompanyEntryResponse, error)
+	// create general ledger organization group for consolidation
+	CreateOrganizationGroup(ctx context.Context, in *CreateOrganizationGroupRequest, opts ...grpc.CallOption) (*CreateOrganizationGroupResponse, error)
+	// update general ledger organization group
+	UpdateOrganizationGroup(ctx context.Context, in *UpdateOrganizationGroupRequest, opts ...grpc.CallOption) (*UpdateOrganizationGroupResponse, error)
+	// delete general ledger organization group with its members, account mappings and elimination rules
+	DeleteOrganizationGroup(ctx context.Context, in *DeleteOrganizationGroupRequest, opts ...grpc.CallOption) (*DeleteOrganizationGroupResponse, error)
+	// get general ledger organization groups
+	GetOrganizationGroups(ctx context.Context, in *GetOrganizationGroupsRequest, opts ...grpc.CallOption) (*GetOrganizationGroupsResponse, error)
+	// add organization to general ledger organization group
+	CreateOrganizationGroupMember(ctx context.Context, in *CreateOrganizationGroupMemberRequest, opts ...grpc.CallOption) (*CreateOrganizationGroupMemberResponse, error)
+	// remove organization from general ledger organization group
+	DeleteOrganizationGroupMember(ctx context.Context, in *DeleteOrganizationGroupMemberRequest, opts ...grpc.CallOption) (*DeleteOrganizationGroupMemberResponse, error)
+	// get members of general ledger organization group
+	GetOrganizationGroupMembers(ctx context.Context, in *GetOrganizationGroupMembersRequest, opts ...grpc.CallOption) (*GetOrganizationGroupMembersResponse, error)
+	// map general ledger account onto the group chart of accounts
+	CreateGroupAccountMap(ctx context.Context, in *CreateGroupAccountMapRequest, opts ...grpc.CallOption) (*CreateGroupAccountMapResponse, error)
+	// update general ledger account mapping onto the group chart of accounts
+	UpdateGroupAccountMap(ctx context.Context, in *UpdateGroupAccountMapRequest, opts ...grpc.CallOption) (*UpdateGroupAccountMapResponse, error)
+	// delete general ledger account mapping onto the group chart of accounts
+	DeleteGroupAccountMap(ctx context.Context, in *DeleteGroupAccountMapRequest, opts ...grpc.CallOption) (*DeleteGroupAccountMapResponse, error)
+	// get general ledger account mappings of organization group
+	GetGroupAccountMaps(ctx context.Context, in *GetGroupAccountMapsRequest, opts ...grpc.CallOption) (*GetGroupAccountMapsResponse, error)
+	// create general ledger consolidation elimination rule for a pair of intercompany accounts
+	CreateEliminationRule(ctx context.Context, in *CreateEliminationRuleRequest, opts ...grpc.CallOption) (*CreateEliminationRuleResponse, error)
+	// update general ledger consolidation elimination rule
+	UpdateEliminationRule(ctx context.Context, in *UpdateEliminationRuleRequest, opts ...grpc.CallOption) (*UpdateEliminationRuleResponse, error)
+	// delete general ledger consolidation elimination rule
+	DeleteEliminationRule(ctx context.Context, in *DeleteEliminationRuleRequest, opts ...grpc.CallOption) (*DeleteEliminationRuleResponse, error)
+	// get general ledger consolidation elimination rules of organization group
+	GetEliminationRules(ctx context.Context, in *GetEliminationRulesRequest, opts ...grpc.CallOption) (*GetEliminationRulesResponse, error)
+	// get general ledger trial balance consolidated over organization group as of date
+	GetConsolidatedTrialBalance(ctx context.Context, in *GetConsolidatedTrialBalanceRequest, opts ...grpc.CallOption) (*GetConsolidatedTrialBalanceResponse, error)
+	// get general ledger balance sheet consolidated over organization group as of date
+	GetConsolidatedBalanceSheet(ctx context.Context, in *GetConsolidatedBalanceSheetRequest, opts ...grpc.CallOption) (*GetConsolidatedBalanceSheetResponse, error)
+	// get general ledger income statement consolidated over organization group between dates
+	GetConsolidatedIncomeStatement(ctx context.Context, in *GetConsolidatedIncomeStatementRequest, opts ...grpc.CallOption) (*GetConsolidatedIncomeStatementResponse, error)
+	// import bank statement lines for a general ledger cash account and match them to transaction details
+	ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error)
+	// match unmatched bank statement lines of a general ledger account to transaction details
+	AutoMatchBankStatement(ctx context.Context, in *AutoMatchBankStatementRequest, opts ...grpc.CallOption) (*AutoMatchBankStatementResponse, error)
+	// confirm the match of a bank statement line, or match it to a given transaction detail
+	ConfirmBankMatch(ctx context.Context, in *ConfirmBankMatchRequest, opts ...grpc.CallOption) (*ConfirmBankMatchResponse, error)
+	// remove the match of a bank statement line
+	UnmatchBankStatementLine(ctx context.Context, in *UnmatchBankStatementLineRequest, opts ...grpc.CallOption) (*UnmatchBankStatementLineResponse, error)
+	// delete an unreconciled bank statement line
+	DeleteBankStatementLine(ctx context.Context, in *DeleteBankStatementLineRequest, opts ...grpc.CallOption) (*DeleteBankStatementLineResponse, error)
+	// get bank statement lines of a general ledger account between dates
+	GetBankStatementLines(ctx context.Context, in *GetBankStatementLinesRequest, opts ...grpc.CallOption) (*GetBankStatementLinesResponse, error)
+	// get bank reconciliation of a general ledger account between dates
+	GetBankReconciliation(ctx context.Context, in *GetBankReconciliationRequest, opts ...grpc.CallOption) (*GetBankReconciliationResponse, error)
+	// create general ledger control account for receivable or payable open items
+	CreateControlAccount(ctx context.Context, in *CreateControlAccountRequest, opts ...grpc.CallOption) (*CreateControlAccountResponse, error)
+	// update general ledger control account
+	UpdateControlAccount(ctx context.Context, in *UpdateControlAccountRequest, opts ...grpc.CallOption) (*UpdateControlAccountResponse, error)
+	// delete general ledger control account
+	DeleteControlAccount(ctx context.Context, in *DeleteControlAccountRequest, opts ...grpc.CallOption) (*DeleteControlAccountResponse, error)
+	// get general ledger control accounts for organization
+	GetControlAccountsByOrganization(ctx context.Context, in *GetControlAccountsByOrganizationRequest, opts ...grpc.CallOption) (*GetControlAccountsByOrganizationResponse, error)
+	// get receivable or payable open items of an organization
+	GetOpenItems(ctx context.Context, in *GetOpenItemsRequest, opts ...grpc.CallOption) (*GetOpenItemsResponse, error)
+	// apply a credit open item against a charge open item, or against the oldest open charges of its party
+	ApplyOpenItem(ctx context.Context, in *ApplyOpenItemRequest, opts ...grpc.CallOption) (*ApplyOpenItemResponse, error)
+	// delete application of a credit open item against a charge open item
+	DeleteOpenItemApplication(ctx context.Context, in *DeleteOpenItemApplicationRequest, opts ...grpc.CallOption) (*DeleteOpenItemApplicationResponse, error)
+	// get open item applications of a transaction
+	GetOpenItemApplications(ctx context.Context, in *GetOpenItemApplicationsRequest, opts ...grpc.CallOption) (*GetOpenItemApplicationsResponse, error)
+	// get open receivable or payable balances of parties
+	GetPartyBalances(ctx context.Context, in *GetPartyBalancesRequest, opts ...grpc.CallOption) (*GetPartyBalancesResponse, error)
+	// get open receivable or payable balances of parties aged by days past due
+	GetPartyAging(ctx context.Context, in *GetPartyAgingRequest, opts ...grpc.CallOption) (*GetPartyAgingResponse, error)
+	// create general ledger tax code
+	CreateTaxCode(ctx context.Context, in *CreateTaxCodeRequest, opts ...grpc.CallOption) (*CreateTaxCodeResponse, error)
+	// update general ledger tax code
+	UpdateTaxCode(ctx context.Context, in *UpdateTaxCodeRequest, opts ...grpc.CallOption) (*UpdateTaxCodeResponse, error)
+	// delete general ledger tax code
+	DeleteTaxCode(ctx context.Context, in *DeleteTaxCodeRequest, opts ...grpc.CallOption) (*DeleteTaxCodeResponse, error)
+	// get general ledger tax codes for organization
+	GetTaxCodesByOrganization(ctx context.Context, in *GetTaxCodesByOrganizationRequest, opts ...grpc.CallOption) (*GetTaxCodesByOrganizationResponse, error)
+	// get taxable amounts and tax of an organization per tax code for a period
+	GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error)
+	// create general ledger fixed asset
+	CreateFixedAsset(ctx context.Context, in *CreateFixedAssetRequest, opts ...grpc.CallOption) (*CreateFixedAssetResponse, error)
+	// update general ledger fixed asset
+	UpdateFixedAsset(ctx context.Context, in *UpdateFixedAssetRequest, opts ...grpc.CallOption) (*UpdateFixedAssetResponse, error)
+	// delete general ledger fixed asset
+	DeleteFixedAsset(ctx context.Context, in *DeleteFixedAssetRequest, opts ...grpc.CallOption) (*DeleteFixedAssetResponse, error)
+	// get general ledger fixed asset by id
+	GetFixedAssetById(ctx context.Context, in *GetFixedAssetByIdRequest, opts ...grpc.CallOption) (*GetFixedAssetByIdResponse, error)
+	// get general ledger fixed assets for organization
+	GetFixedAssetsByOrganization(ctx context.Context, in *GetFixedAssetsByOrganizationRequest, opts ...grpc.CallOption) (*GetFixedAssetsByOrganizationResponse, error)
+	// post the depreciation of the fixed assets of an organization for a fiscal period
+	RunDepreciation(ctx context.Context, in *RunDepreciationRequest, opts ...grpc.CallOption) (*RunDepreciationResponse, error)
+	// dispose of a general ledger fixed asset, posting its depreciation to date and the gain or loss
+	DisposeFixedAsset(ctx context.Context, in *DisposeFixedAssetRequest, opts ...grpc.CallOption) (*DisposeFixedAssetResponse, error)
+	// get depreciation posted for a general ledger fixed asset
+	GetDepreciationEntries(ctx context.Context, in *GetDepreciationEntriesRequest, opts ...grpc.CallOption) (*GetDepreciationEntriesResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error)
 }
@@ -382,776 +590,4104 @@ func (c *mServiceLedgerClient) AddTransactionDetails(ctx context.Context, in *Ad
 	return out, nil
 }
 
-func (c *mServiceLedgerClient) GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error) {
-	out := new(GetServerVersionResponse)
-	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_server_version", in, out, opts...)
+func (c *mServiceLedgerClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_trial_balance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MServiceLedgerServer is the server API for MServiceLedger service.
-// All implementations must embed UnimplementedMServiceLedgerServer
-// for forward compatibility
-type MServiceLedgerServer interface {
-	// create a new general ledger organization
-	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
-	// update an existing general ledger organization
-	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
-	// delete an existing general ledger organization
-	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
-	// get general ledger organization by id
-	GetOrganizationById(context.Context, *GetOrganizationByIdRequest) (*GetOrganizationByIdResponse, error)
-	// get general ledger organizations by mservice
-	GetOrganizationsByMservice(context.Context, *GetOrganizationsByMserviceRequest) (*GetOrganizationsByMserviceResponse, error)
-	// create general ledger account type
-	CreateAccountType(context.Context, *CreateAccountTypeRequest) (*CreateAccountTypeResponse, error)
-	// update general ledger account type
-	UpdateAccountType(context.Context, *UpdateAccountTypeRequest) (*UpdateAccountTypeResponse, error)
-	// delete general ledger account type
-	DeleteAccountType(context.Context, *DeleteAccountTypeRequest) (*DeleteAccountTypeResponse, error)
-	// get general ledger account type by id
-	GetAccountTypeById(context.Context, *GetAccountTypeByIdRequest) (*GetAccountTypeByIdResponse, error)
-	// get general ledger account types by mservice
-	GetAccountTypesByMservice(context.Context, *GetAccountTypesByMserviceRequest) (*GetAccountTypesByMserviceResponse, error)
-	// create general ledger transaction type
-	CreateTransactionType(context.Context, *CreateTransactionTypeRequest) (*CreateTransactionTypeResponse, error)
-	// update general ledger transaction type
-	UpdateTransactionType(context.Context, *UpdateTransactionTypeRequest) (*UpdateTransactionTypeResponse, error)
-	// delete general ledger transaction type
-	DeleteTransactionType(context.Context, *DeleteTransactionTypeRequest) (*DeleteTransactionTypeResponse, error)
-	// get general ledger transaction type by id
-	GetTransactionTypeById(context.Context, *GetTransactionTypeByIdRequest) (*GetTransactionTypeByIdResponse, error)
-	// get general ledger transaction types by mservice
-	GetTransactionTypesByMservice(context.Context, *GetTransactionTypesByMserviceRequest) (*GetTransactionTypesByMserviceResponse, error)
-	// create general ledger party
-	CreateParty(context.Context, *CreatePartyRequest) (*CreatePartyResponse, error)
-	// update general ledger party
-	UpdateParty(context.Context, *UpdatePartyRequest) (*UpdatePartyResponse, error)
-	// delete general ledger party
-	DeleteParty(context.Context, *DeletePartyRequest) (*DeletePartyResponse, error)
-	// get general ledger party by id
-	GetPartyById(context.Context, *GetPartyByIdRequest) (*GetPartyByIdResponse, error)
-	// get general ledger parties by mservice
-	GetPartiesByMservice(context.Context, *GetPartiesByMserviceRequest) (*GetPartiesByMserviceResponse, error)
-	// create general ledger account
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	// update general ledger account
-	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
-	// delete general ledger account
-	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
-	// get general ledger account by id
-	GetAccountById(context.Context, *GetAccountByIdRequest) (*GetAccountByIdResponse, error)
-	// get general ledger accounts by organization
-	GetAccountsByOrganization(context.Context, *GetAccountsByOrganizationRequest) (*GetAccountsByOrganizationResponse, error)
-	// create general ledger transaction
-	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
-	// update general ledger transaction
-	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
-	// delete general ledger transaction
-	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
-	// get general ledger transaction by id
-	GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error)
-	// get general ledger transaction wrapper by id
-	GetTransactionWrapperById(context.Context, *GetTransactionWrapperByIdRequest) (*GetTransactionWrapperByIdResponse, error)
-	// get general ledger transaction wrappers by date
-	GetTransactionWrappersByDate(context.Context, *GetTransactionWrappersByDateRequest) (*GetTransactionWrappersByDateResponse, error)
-	// add transaction details
-	AddTransactionDetails(context.Context, *AddTransactionDetailsRequest) (*AddTransactionDetailsResponse, error)
-	// get current server version and uptime - health check
-	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
-	mustEmbedUnimplementedMServiceLedgerServer()
+func (c *mServiceLedgerClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
+	out := new(GetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_account_balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMServiceLedgerServer must be embedded to have forward compatible implementations.
-type UnimplementedMServiceLedgerServer struct {
+func (c *mServiceLedgerClient) GetAccountLedger(ctx context.Context, in *GetAccountLedgerRequest, opts ...grpc.CallOption) (*GetAccountLedgerResponse, error) {
+	out := new(GetAccountLedgerResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_account_ledger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedMServiceLedgerServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+func (c *mServiceLedgerClient) GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error) {
+	out := new(GetBalanceSheetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_balance_sheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
+
+func (c *mServiceLedgerClient) GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error) {
+	out := new(GetIncomeStatementResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_income_statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
+
+func (c *mServiceLedgerClient) CreateFiscalYear(ctx context.Context, in *CreateFiscalYearRequest, opts ...grpc.CallOption) (*CreateFiscalYearResponse, error) {
+	out := new(CreateFiscalYearResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_fiscal_year", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetOrganizationById(context.Context, *GetOrganizationByIdRequest) (*GetOrganizationByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationById not implemented")
+
+func (c *mServiceLedgerClient) DeleteFiscalYear(ctx context.Context, in *DeleteFiscalYearRequest, opts ...grpc.CallOption) (*DeleteFiscalYearResponse, error) {
+	out := new(DeleteFiscalYearResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_fiscal_year", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetOrganizationsByMservice(context.Context, *GetOrganizationsByMserviceRequest) (*GetOrganizationsByMserviceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationsByMservice not implemented")
+
+func (c *mServiceLedgerClient) GetFiscalYearsByOrganization(ctx context.Context, in *GetFiscalYearsByOrganizationRequest, opts ...grpc.CallOption) (*GetFiscalYearsByOrganizationResponse, error) {
+	out := new(GetFiscalYearsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_fiscal_years_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) CreateAccountType(context.Context, *CreateAccountTypeRequest) (*CreateAccountTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountType not implemented")
+
+func (c *mServiceLedgerClient) GetFiscalPeriodsByYear(ctx context.Context, in *GetFiscalPeriodsByYearRequest, opts ...grpc.CallOption) (*GetFiscalPeriodsByYearResponse, error) {
+	out := new(GetFiscalPeriodsByYearResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_fiscal_periods_by_year", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) UpdateAccountType(context.Context, *UpdateAccountTypeRequest) (*UpdateAccountTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountType not implemented")
+
+func (c *mServiceLedgerClient) UpdateFiscalPeriodStatus(ctx context.Context, in *UpdateFiscalPeriodStatusRequest, opts ...grpc.CallOption) (*UpdateFiscalPeriodStatusResponse, error) {
+	out := new(UpdateFiscalPeriodStatusResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_fiscal_period_status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) DeleteAccountType(context.Context, *DeleteAccountTypeRequest) (*DeleteAccountTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccountType not implemented")
+
+func (c *mServiceLedgerClient) CloseFiscalYear(ctx context.Context, in *CloseFiscalYearRequest, opts ...grpc.CallOption) (*CloseFiscalYearResponse, error) {
+	out := new(CloseFiscalYearResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/close_fiscal_year", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetAccountTypeById(context.Context, *GetAccountTypeByIdRequest) (*GetAccountTypeByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTypeById not implemented")
+
+func (c *mServiceLedgerClient) ReopenFiscalYear(ctx context.Context, in *ReopenFiscalYearRequest, opts ...grpc.CallOption) (*ReopenFiscalYearResponse, error) {
+	out := new(ReopenFiscalYearResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/reopen_fiscal_year", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetAccountTypesByMservice(context.Context, *GetAccountTypesByMserviceRequest) (*GetAccountTypesByMserviceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTypesByMservice not implemented")
+
+func (c *mServiceLedgerClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error) {
+	out := new(ReverseTransactionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/reverse_transaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) CreateTransactionType(context.Context, *CreateTransactionTypeRequest) (*CreateTransactionTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactionType not implemented")
+
+func (c *mServiceLedgerClient) VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error) {
+	out := new(VoidTransactionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/void_transaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) UpdateTransactionType(context.Context, *UpdateTransactionTypeRequest) (*UpdateTransactionTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransactionType not implemented")
+
+func (c *mServiceLedgerClient) PostTransaction(ctx context.Context, in *PostTransactionRequest, opts ...grpc.CallOption) (*PostTransactionResponse, error) {
+	out := new(PostTransactionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/post_transaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) DeleteTransactionType(context.Context, *DeleteTransactionTypeRequest) (*DeleteTransactionTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransactionType not implemented")
+
+func (c *mServiceLedgerClient) PostJournalEntry(ctx context.Context, in *PostJournalEntryRequest, opts ...grpc.CallOption) (*PostJournalEntryResponse, error) {
+	out := new(PostJournalEntryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/post_journal_entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetTransactionTypeById(context.Context, *GetTransactionTypeByIdRequest) (*GetTransactionTypeByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTypeById not implemented")
+
+func (c *mServiceLedgerClient) ReplaceTransactionDetails(ctx context.Context, in *ReplaceTransactionDetailsRequest, opts ...grpc.CallOption) (*ReplaceTransactionDetailsResponse, error) {
+	out := new(ReplaceTransactionDetailsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/replace_transaction_details", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetTransactionTypesByMservice(context.Context, *GetTransactionTypesByMserviceRequest) (*GetTransactionTypesByMserviceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTypesByMservice not implemented")
+
+func (c *mServiceLedgerClient) GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error) {
+	out := new(GetAccountTreeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_account_tree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) CreateParty(context.Context, *CreatePartyRequest) (*CreatePartyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateParty not implemented")
+
+func (c *mServiceLedgerClient) CreateAccountCodeRange(ctx context.Context, in *CreateAccountCodeRangeRequest, opts ...grpc.CallOption) (*CreateAccountCodeRangeResponse, error) {
+	out := new(CreateAccountCodeRangeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_account_code_range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) UpdateParty(context.Context, *UpdatePartyRequest) (*UpdatePartyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParty not implemented")
+
+func (c *mServiceLedgerClient) UpdateAccountCodeRange(ctx context.Context, in *UpdateAccountCodeRangeRequest, opts ...grpc.CallOption) (*UpdateAccountCodeRangeResponse, error) {
+	out := new(UpdateAccountCodeRangeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_account_code_range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) DeleteParty(context.Context, *DeletePartyRequest) (*DeletePartyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteParty not implemented")
+
+func (c *mServiceLedgerClient) DeleteAccountCodeRange(ctx context.Context, in *DeleteAccountCodeRangeRequest, opts ...grpc.CallOption) (*DeleteAccountCodeRangeResponse, error) {
+	out := new(DeleteAccountCodeRangeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_account_code_range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetPartyById(context.Context, *GetPartyByIdRequest) (*GetPartyByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartyById not implemented")
+
+func (c *mServiceLedgerClient) GetAccountCodeRangesByOrganization(ctx context.Context, in *GetAccountCodeRangesByOrganizationRequest, opts ...grpc.CallOption) (*GetAccountCodeRangesByOrganizationResponse, error) {
+	out := new(GetAccountCodeRangesByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_account_code_ranges_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetPartiesByMservice(context.Context, *GetPartiesByMserviceRequest) (*GetPartiesByMserviceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPartiesByMservice not implemented")
+
+func (c *mServiceLedgerClient) CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error) {
+	out := new(CreateExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_exchange_rate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+
+func (c *mServiceLedgerClient) UpdateExchangeRate(ctx context.Context, in *UpdateExchangeRateRequest, opts ...grpc.CallOption) (*UpdateExchangeRateResponse, error) {
+	out := new(UpdateExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_exchange_rate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+
+func (c *mServiceLedgerClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_exchange_rate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+
+func (c *mServiceLedgerClient) GetExchangeRatesByOrganization(ctx context.Context, in *GetExchangeRatesByOrganizationRequest, opts ...grpc.CallOption) (*GetExchangeRatesByOrganizationResponse, error) {
+	out := new(GetExchangeRatesByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_exchange_rates_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetAccountById(context.Context, *GetAccountByIdRequest) (*GetAccountByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountById not implemented")
+
+func (c *mServiceLedgerClient) RevalueForeignBalances(ctx context.Context, in *RevalueForeignBalancesRequest, opts ...grpc.CallOption) (*RevalueForeignBalancesResponse, error) {
+	out := new(RevalueForeignBalancesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/revalue_foreign_balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMServiceLedgerServer) GetAccountsByOrganization(context.Context, *GetAccountsByOrganizationRequest) (*GetAccountsByOrganizationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountsByOrganization not implemented")
+
+func (c *mServiceLedgerClient) CreateDimension(ctx context.Context, in *CreateDimensionRequest, opts ...grpc.CallOption) (*CreateDimensionResponse, error) {
+	out := new(CreateDimensionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_dimension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateDimension(ctx context.Context, in *UpdateDimensionRequest, opts ...grpc.CallOption) (*UpdateDimensionResponse, error) {
+	out := new(UpdateDimensionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_dimension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteDimension(ctx context.Context, in *DeleteDimensionRequest, opts ...grpc.CallOption) (*DeleteDimensionResponse, error) {
+	out := new(DeleteDimensionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_dimension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetDimensionsByOrganization(ctx context.Context, in *GetDimensionsByOrganizationRequest, opts ...grpc.CallOption) (*GetDimensionsByOrganizationResponse, error) {
+	out := new(GetDimensionsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_dimensions_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateDimensionValue(ctx context.Context, in *CreateDimensionValueRequest, opts ...grpc.CallOption) (*CreateDimensionValueResponse, error) {
+	out := new(CreateDimensionValueResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_dimension_value", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateDimensionValue(ctx context.Context, in *UpdateDimensionValueRequest, opts ...grpc.CallOption) (*UpdateDimensionValueResponse, error) {
+	out := new(UpdateDimensionValueResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_dimension_value", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteDimensionValue(ctx context.Context, in *DeleteDimensionValueRequest, opts ...grpc.CallOption) (*DeleteDimensionValueResponse, error) {
+	out := new(DeleteDimensionValueResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_dimension_value", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetDimensionBalances(ctx context.Context, in *GetDimensionBalancesRequest, opts ...grpc.CallOption) (*GetDimensionBalancesResponse, error) {
+	out := new(GetDimensionBalancesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_dimension_balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateBudget(ctx context.Context, in *CreateBudgetRequest, opts ...grpc.CallOption) (*CreateBudgetResponse, error) {
+	out := new(CreateBudgetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_budget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateBudget(ctx context.Context, in *UpdateBudgetRequest, opts ...grpc.CallOption) (*UpdateBudgetResponse, error) {
+	out := new(UpdateBudgetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_budget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_budget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetBudgetsByOrganization(ctx context.Context, in *GetBudgetsByOrganizationRequest, opts ...grpc.CallOption) (*GetBudgetsByOrganizationResponse, error) {
+	out := new(GetBudgetsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_budgets_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateBudgetAmount(ctx context.Context, in *CreateBudgetAmountRequest, opts ...grpc.CallOption) (*CreateBudgetAmountResponse, error) {
+	out := new(CreateBudgetAmountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_budget_amount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateBudgetAmount(ctx context.Context, in *UpdateBudgetAmountRequest, opts ...grpc.CallOption) (*UpdateBudgetAmountResponse, error) {
+	out := new(UpdateBudgetAmountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_budget_amount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteBudgetAmount(ctx context.Context, in *DeleteBudgetAmountRequest, opts ...grpc.CallOption) (*DeleteBudgetAmountResponse, error) {
+	out := new(DeleteBudgetAmountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_budget_amount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetBudgetAmounts(ctx context.Context, in *GetBudgetAmountsRequest, opts ...grpc.CallOption) (*GetBudgetAmountsResponse, error) {
+	out := new(GetBudgetAmountsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_budget_amounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) LoadBudgetAmounts(ctx context.Context, in *LoadBudgetAmountsRequest, opts ...grpc.CallOption) (*LoadBudgetAmountsResponse, error) {
+	out := new(LoadBudgetAmountsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/load_budget_amounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetBudgetVsActual(ctx context.Context, in *GetBudgetVsActualRequest, opts ...grpc.CallOption) (*GetBudgetVsActualResponse, error) {
+	out := new(GetBudgetVsActualResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_budget_vs_actual", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateRecurringEntry(ctx context.Context, in *CreateRecurringEntryRequest, opts ...grpc.CallOption) (*CreateRecurringEntryResponse, error) {
+	out := new(CreateRecurringEntryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_recurring_entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateRecurringEntry(ctx context.Context, in *UpdateRecurringEntryRequest, opts ...grpc.CallOption) (*UpdateRecurringEntryResponse, error) {
+	out := new(UpdateRecurringEntryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_recurring_entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteRecurringEntry(ctx context.Context, in *DeleteRecurringEntryRequest, opts ...grpc.CallOption) (*DeleteRecurringEntryResponse, error) {
+	out := new(DeleteRecurringEntryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_recurring_entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetRecurringEntryById(ctx context.Context, in *GetRecurringEntryByIdRequest, opts ...grpc.CallOption) (*GetRecurringEntryByIdResponse, error) {
+	out := new(GetRecurringEntryByIdResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_entry_by_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetRecurringEntriesByOrganization(ctx context.Context, in *GetRecurringEntriesByOrganizationRequest, opts ...grpc.CallOption) (*GetRecurringEntriesByOrganizationResponse, error) {
+	out := new(GetRecurringEntriesByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_entries_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetRecurringInstances(ctx context.Context, in *GetRecurringInstancesRequest, opts ...grpc.CallOption) (*GetRecurringInstancesResponse, error) {
+	out := new(GetRecurringInstancesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_instances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateIntercompanyAccount(ctx context.Context, in *CreateIntercompanyAccountRequest, opts ...grpc.CallOption) (*CreateIntercompanyAccountResponse, error) {
+	out := new(CreateIntercompanyAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_intercompany_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateIntercompanyAccount(ctx context.Context, in *UpdateIntercompanyAccountRequest, opts ...grpc.CallOption) (*UpdateIntercompanyAccountResponse, error) {
+	out := new(UpdateIntercompanyAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_intercompany_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteIntercompanyAccount(ctx context.Context, in *DeleteIntercompanyAccountRequest, opts ...grpc.CallOption) (*DeleteIntercompanyAccountResponse, error) {
+	out := new(DeleteIntercompanyAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_intercompany_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetIntercompanyAccountsByOrganization(ctx context.Context, in *GetIntercompanyAccountsByOrganizationRequest, opts ...grpc.CallOption) (*GetIntercompanyAccountsByOrganizationResponse, error) {
+	out := new(GetIntercompanyAccountsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_intercompany_accounts_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) PostIntercompanyEntry(ctx context.Context, in *PostIntercompanyEntryRequest, opts ...grpc.CallOption) (*PostIntercompanyEntryResponse, error) {
+	out := new(PostIntercompanyEntryResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/post_intercompany_entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateOrganizationGroup(ctx context.Context, in *CreateOrganizationGroupRequest, opts ...grpc.CallOption) (*CreateOrganizationGroupResponse, error) {
+	out := new(CreateOrganizationGroupResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_organization_group", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateOrganizationGroup(ctx context.Context, in *UpdateOrganizationGroupRequest, opts ...grpc.CallOption) (*UpdateOrganizationGroupResponse, error) {
+	out := new(UpdateOrganizationGroupResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_organization_group", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteOrganizationGroup(ctx context.Context, in *DeleteOrganizationGroupRequest, opts ...grpc.CallOption) (*DeleteOrganizationGroupResponse, error) {
+	out := new(DeleteOrganizationGroupResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization_group", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetOrganizationGroups(ctx context.Context, in *GetOrganizationGroupsRequest, opts ...grpc.CallOption) (*GetOrganizationGroupsResponse, error) {
+	out := new(GetOrganizationGroupsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_groups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateOrganizationGroupMember(ctx context.Context, in *CreateOrganizationGroupMemberRequest, opts ...grpc.CallOption) (*CreateOrganizationGroupMemberResponse, error) {
+	out := new(CreateOrganizationGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_organization_group_member", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteOrganizationGroupMember(ctx context.Context, in *DeleteOrganizationGroupMemberRequest, opts ...grpc.CallOption) (*DeleteOrganizationGroupMemberResponse, error) {
+	out := new(DeleteOrganizationGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization_group_member", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetOrganizationGroupMembers(ctx context.Context, in *GetOrganizationGroupMembersRequest, opts ...grpc.CallOption) (*GetOrganizationGroupMembersResponse, error) {
+	out := new(GetOrganizationGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_group_members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateGroupAccountMap(ctx context.Context, in *CreateGroupAccountMapRequest, opts ...grpc.CallOption) (*CreateGroupAccountMapResponse, error) {
+	out := new(CreateGroupAccountMapResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_group_account_map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateGroupAccountMap(ctx context.Context, in *UpdateGroupAccountMapRequest, opts ...grpc.CallOption) (*UpdateGroupAccountMapResponse, error) {
+	out := new(UpdateGroupAccountMapResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_group_account_map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteGroupAccountMap(ctx context.Context, in *DeleteGroupAccountMapRequest, opts ...grpc.CallOption) (*DeleteGroupAccountMapResponse, error) {
+	out := new(DeleteGroupAccountMapResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_group_account_map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetGroupAccountMaps(ctx context.Context, in *GetGroupAccountMapsRequest, opts ...grpc.CallOption) (*GetGroupAccountMapsResponse, error) {
+	out := new(GetGroupAccountMapsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_group_account_maps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateEliminationRule(ctx context.Context, in *CreateEliminationRuleRequest, opts ...grpc.CallOption) (*CreateEliminationRuleResponse, error) {
+	out := new(CreateEliminationRuleResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_elimination_rule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateEliminationRule(ctx context.Context, in *UpdateEliminationRuleRequest, opts ...grpc.CallOption) (*UpdateEliminationRuleResponse, error) {
+	out := new(UpdateEliminationRuleResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_elimination_rule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteEliminationRule(ctx context.Context, in *DeleteEliminationRuleRequest, opts ...grpc.CallOption) (*DeleteEliminationRuleResponse, error) {
+	out := new(DeleteEliminationRuleResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_elimination_rule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetEliminationRules(ctx context.Context, in *GetEliminationRulesRequest, opts ...grpc.CallOption) (*GetEliminationRulesResponse, error) {
+	out := new(GetEliminationRulesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_elimination_rules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetConsolidatedTrialBalance(ctx context.Context, in *GetConsolidatedTrialBalanceRequest, opts ...grpc.CallOption) (*GetConsolidatedTrialBalanceResponse, error) {
+	out := new(GetConsolidatedTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_trial_balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetConsolidatedBalanceSheet(ctx context.Context, in *GetConsolidatedBalanceSheetRequest, opts ...grpc.CallOption) (*GetConsolidatedBalanceSheetResponse, error) {
+	out := new(GetConsolidatedBalanceSheetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_balance_sheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetConsolidatedIncomeStatement(ctx context.Context, in *GetConsolidatedIncomeStatementRequest, opts ...grpc.CallOption) (*GetConsolidatedIncomeStatementResponse, error) {
+	out := new(GetConsolidatedIncomeStatementResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_income_statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) ImportBankStatement(ctx context.Context, in *ImportBankStatementRequest, opts ...grpc.CallOption) (*ImportBankStatementResponse, error) {
+	out := new(ImportBankStatementResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/import_bank_statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) AutoMatchBankStatement(ctx context.Context, in *AutoMatchBankStatementRequest, opts ...grpc.CallOption) (*AutoMatchBankStatementResponse, error) {
+	out := new(AutoMatchBankStatementResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/auto_match_bank_statement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) ConfirmBankMatch(ctx context.Context, in *ConfirmBankMatchRequest, opts ...grpc.CallOption) (*ConfirmBankMatchResponse, error) {
+	out := new(ConfirmBankMatchResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/confirm_bank_match", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UnmatchBankStatementLine(ctx context.Context, in *UnmatchBankStatementLineRequest, opts ...grpc.CallOption) (*UnmatchBankStatementLineResponse, error) {
+	out := new(UnmatchBankStatementLineResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/unmatch_bank_statement_line", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteBankStatementLine(ctx context.Context, in *DeleteBankStatementLineRequest, opts ...grpc.CallOption) (*DeleteBankStatementLineResponse, error) {
+	out := new(DeleteBankStatementLineResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_bank_statement_line", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetBankStatementLines(ctx context.Context, in *GetBankStatementLinesRequest, opts ...grpc.CallOption) (*GetBankStatementLinesResponse, error) {
+	out := new(GetBankStatementLinesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_bank_statement_lines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetBankReconciliation(ctx context.Context, in *GetBankReconciliationRequest, opts ...grpc.CallOption) (*GetBankReconciliationResponse, error) {
+	out := new(GetBankReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_bank_reconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateControlAccount(ctx context.Context, in *CreateControlAccountRequest, opts ...grpc.CallOption) (*CreateControlAccountResponse, error) {
+	out := new(CreateControlAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_control_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateControlAccount(ctx context.Context, in *UpdateControlAccountRequest, opts ...grpc.CallOption) (*UpdateControlAccountResponse, error) {
+	out := new(UpdateControlAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_control_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteControlAccount(ctx context.Context, in *DeleteControlAccountRequest, opts ...grpc.CallOption) (*DeleteControlAccountResponse, error) {
+	out := new(DeleteControlAccountResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_control_account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetControlAccountsByOrganization(ctx context.Context, in *GetControlAccountsByOrganizationRequest, opts ...grpc.CallOption) (*GetControlAccountsByOrganizationResponse, error) {
+	out := new(GetControlAccountsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_control_accounts_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetOpenItems(ctx context.Context, in *GetOpenItemsRequest, opts ...grpc.CallOption) (*GetOpenItemsResponse, error) {
+	out := new(GetOpenItemsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_open_items", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) ApplyOpenItem(ctx context.Context, in *ApplyOpenItemRequest, opts ...grpc.CallOption) (*ApplyOpenItemResponse, error) {
+	out := new(ApplyOpenItemResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/apply_open_item", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteOpenItemApplication(ctx context.Context, in *DeleteOpenItemApplicationRequest, opts ...grpc.CallOption) (*DeleteOpenItemApplicationResponse, error) {
+	out := new(DeleteOpenItemApplicationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_open_item_application", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetOpenItemApplications(ctx context.Context, in *GetOpenItemApplicationsRequest, opts ...grpc.CallOption) (*GetOpenItemApplicationsResponse, error) {
+	out := new(GetOpenItemApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_open_item_applications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetPartyBalances(ctx context.Context, in *GetPartyBalancesRequest, opts ...grpc.CallOption) (*GetPartyBalancesResponse, error) {
+	out := new(GetPartyBalancesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_party_balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetPartyAging(ctx context.Context, in *GetPartyAgingRequest, opts ...grpc.CallOption) (*GetPartyAgingResponse, error) {
+	out := new(GetPartyAgingResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_party_aging", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateTaxCode(ctx context.Context, in *CreateTaxCodeRequest, opts ...grpc.CallOption) (*CreateTaxCodeResponse, error) {
+	out := new(CreateTaxCodeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_tax_code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateTaxCode(ctx context.Context, in *UpdateTaxCodeRequest, opts ...grpc.CallOption) (*UpdateTaxCodeResponse, error) {
+	out := new(UpdateTaxCodeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_tax_code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteTaxCode(ctx context.Context, in *DeleteTaxCodeRequest, opts ...grpc.CallOption) (*DeleteTaxCodeResponse, error) {
+	out := new(DeleteTaxCodeResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_tax_code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetTaxCodesByOrganization(ctx context.Context, in *GetTaxCodesByOrganizationRequest, opts ...grpc.CallOption) (*GetTaxCodesByOrganizationResponse, error) {
+	out := new(GetTaxCodesByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_tax_codes_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetTaxReport(ctx context.Context, in *GetTaxReportRequest, opts ...grpc.CallOption) (*GetTaxReportResponse, error) {
+	out := new(GetTaxReportResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_tax_report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) CreateFixedAsset(ctx context.Context, in *CreateFixedAssetRequest, opts ...grpc.CallOption) (*CreateFixedAssetResponse, error) {
+	out := new(CreateFixedAssetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/create_fixed_asset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) UpdateFixedAsset(ctx context.Context, in *UpdateFixedAssetRequest, opts ...grpc.CallOption) (*UpdateFixedAssetResponse, error) {
+	out := new(UpdateFixedAssetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/update_fixed_asset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DeleteFixedAsset(ctx context.Context, in *DeleteFixedAssetRequest, opts ...grpc.CallOption) (*DeleteFixedAssetResponse, error) {
+	out := new(DeleteFixedAssetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/delete_fixed_asset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetFixedAssetById(ctx context.Context, in *GetFixedAssetByIdRequest, opts ...grpc.CallOption) (*GetFixedAssetByIdResponse, error) {
+	out := new(GetFixedAssetByIdResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_fixed_asset_by_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetFixedAssetsByOrganization(ctx context.Context, in *GetFixedAssetsByOrganizationRequest, opts ...grpc.CallOption) (*GetFixedAssetsByOrganizationResponse, error) {
+	out := new(GetFixedAssetsByOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_fixed_assets_by_organization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) RunDepreciation(ctx context.Context, in *RunDepreciationRequest, opts ...grpc.CallOption) (*RunDepreciationResponse, error) {
+	out := new(RunDepreciationResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/run_depreciation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) DisposeFixedAsset(ctx context.Context, in *DisposeFixedAssetRequest, opts ...grpc.CallOption) (*DisposeFixedAssetResponse, error) {
+	out := new(DisposeFixedAssetResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/dispose_fixed_asset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetDepreciationEntries(ctx context.Context, in *GetDepreciationEntriesRequest, opts ...grpc.CallOption) (*GetDepreciationEntriesResponse, error) {
+	out := new(GetDepreciationEntriesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_depreciation_entries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceLedgerClient) GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error) {
+	out := new(GetServerVersionResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.ledger.MServiceLedger/get_server_version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MServiceLedgerServer is the server API for MServiceLedger service.
+// All implementations must embed UnimplementedMServiceLedgerServer
+// for forward compatibility
+type MServiceLedgerServer interface {
+	// create a new general ledger organization
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// update an existing general ledger organization
+	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+	// delete an existing general ledger organization
+	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
+	// get general ledger organization by id
+	GetOrganizationById(context.Context, *GetOrganizationByIdRequest) (*GetOrganizationByIdResponse, error)
+	// get general ledger organizations by mservice
+	GetOrganizationsByMservice(context.Context, *GetOrganizationsByMserviceRequest) (*GetOrganizationsByMserviceResponse, error)
+	// create general ledger account type
+	CreateAccountType(context.Context, *CreateAccountTypeRequest) (*CreateAccountTypeResponse, error)
+	// update general ledger account type
+	UpdateAccountType(context.Context, *UpdateAccountTypeRequest) (*UpdateAccountTypeResponse, error)
+	// delete general ledger account type
+	DeleteAccountType(context.Context, *DeleteAccountTypeRequest) (*DeleteAccountTypeResponse, error)
+	// get general ledger account type by id
+	GetAccountTypeById(context.Context, *GetAccountTypeByIdRequest) (*GetAccountTypeByIdResponse, error)
+	// get general ledger account types by mservice
+	GetAccountTypesByMservice(context.Context, *GetAccountTypesByMserviceRequest) (*GetAccountTypesByMserviceResponse, error)
+	// create general ledger transaction type
+	CreateTransactionType(context.Context, *CreateTransactionTypeRequest) (*CreateTransactionTypeResponse, error)
+	// update general ledger transaction type
+	UpdateTransactionType(context.Context, *UpdateTransactionTypeRequest) (*UpdateTransactionTypeResponse, error)
+	// delete general ledger transaction type
+	DeleteTransactionType(context.Context, *DeleteTransactionTypeRequest) (*DeleteTransactionTypeResponse, error)
+	// get general ledger transaction type by id
+	GetTransactionTypeById(context.Context, *GetTransactionTypeByIdRequest) (*GetTransactionTypeByIdResponse, error)
+	// get general ledger transaction types by mservice
+	GetTransactionTypesByMservice(context.Context, *GetTransactionTypesByMserviceRequest) (*GetTransactionTypesByMserviceResponse, error)
+	// create general ledger party
+	CreateParty(context.Context, *CreatePartyRequest) (*CreatePartyResponse, error)
+	// update general ledger party
+	UpdateParty(context.Context, *UpdatePartyRequest) (*UpdatePartyResponse, error)
+	// delete general ledger party
+	DeleteParty(context.Context, *DeletePartyRequest) (*DeletePartyResponse, error)
+	// get general ledger party by id
+	GetPartyById(context.Context, *GetPartyByIdRequest) (*GetPartyByIdResponse, error)
+	// get general ledger parties by mservice
+	GetPartiesByMservice(context.Context, *GetPartiesByMserviceRequest) (*GetPartiesByMserviceResponse, error)
+	// create general ledger account
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	// update general ledger account
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	// delete general ledger account
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	// get general ledger account by id
+	GetAccountById(context.Context, *GetAccountByIdRequest) (*GetAccountByIdResponse, error)
+	// get general ledger accounts by organization
+	GetAccountsByOrganization(context.Context, *GetAccountsByOrganizationRequest) (*GetAccountsByOrganizationResponse, error)
+	// create general ledger transaction
+	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	// update general ledger transaction
+	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
+	// delete general ledger transaction
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	// get general ledger transaction by id
+	GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error)
+	// get general ledger transaction wrapper by id
+	GetTransactionWrapperById(context.Context, *GetTransactionWrapperByIdRequest) (*GetTransactionWrapperByIdResponse, error)
+	// get general ledger transaction wrappers by date
+	GetTransactionWrappersByDate(context.Context, *GetTransactionWrappersByDateRequest) (*GetTransactionWrappersByDateResponse, error)
+	// add transaction details
+	AddTransactionDetails(context.Context, *AddTransactionDetailsRequest) (*AddTransactionDetailsResponse, error)
+	// get general ledger trial balance for organization as of date
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	// get general ledger account balance as of date
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	// get general ledger account statement with running balance between dates
+	GetAccountLedger(context.Context, *GetAccountLedgerRequest) (*GetAccountLedgerResponse, error)
+	// get general ledger balance sheet for organization as of date
+	GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error)
+	// get general ledger income statement for organization between dates
+	GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error)
+	// create general ledger fiscal year with its periods
+	CreateFiscalYear(context.Context, *CreateFiscalYearRequest) (*CreateFiscalYearResponse, error)
+	// delete general ledger fiscal year with its periods
+	DeleteFiscalYear(context.Context, *DeleteFiscalYearRequest) (*DeleteFiscalYearResponse, error)
+	// get general ledger fiscal years by organization
+	GetFiscalYearsByOrganization(context.Context, *GetFiscalYearsByOrganizationRequest) (*GetFiscalYearsByOrganizationResponse, error)
+	// get general ledger fiscal periods by fiscal year
+	GetFiscalPeriodsByYear(context.Context, *GetFiscalPeriodsByYearRequest) (*GetFiscalPeriodsByYearResponse, error)
+	// update status of general ledger fiscal period
+	UpdateFiscalPeriodStatus(context.Context, *UpdateFiscalPeriodStatusRequest) (*UpdateFiscalPeriodStatusResponse, error)
+	// close general ledger fiscal year to retained earnings
+	CloseFiscalYear(context.Context, *CloseFiscalYearRequest) (*CloseFiscalYearResponse, error)
+	// reopen closed general ledger fiscal year
+	ReopenFiscalYear(context.Context, *ReopenFiscalYearRequest) (*ReopenFiscalYearResponse, error)
+	// reverse general ledger transaction with a mirror image transaction
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error)
+	// void general ledger transaction
+	VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error)
+	// post draft general ledger transaction
+	PostTransaction(context.Context, *PostTransactionRequest) (*PostTransactionResponse, error)
+	// create and post general ledger transaction with its details in one step
+	PostJournalEntry(context.Context, *PostJournalEntryRequest) (*PostJournalEntryResponse, error)
+	// replace all details of a draft general ledger transaction
+	ReplaceTransactionDetails(context.Context, *ReplaceTransactionDetailsRequest) (*ReplaceTransactionDetailsResponse, error)
+	// get general ledger accounts of organization as a tree
+	GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error)
+	// create general ledger account code range for an account category
+	CreateAccountCodeRange(context.Context, *CreateAccountCodeRangeRequest) (*CreateAccountCodeRangeResponse, error)
+	// update general ledger account code range
+	UpdateAccountCodeRange(context.Context, *UpdateAccountCodeRangeRequest) (*UpdateAccountCodeRangeResponse, error)
+	// delete general ledger account code range
+	DeleteAccountCodeRange(context.Context, *DeleteAccountCodeRangeRequest) (*DeleteAccountCodeRangeResponse, error)
+	// get general ledger account code ranges by organization
+	GetAccountCodeRangesByOrganization(context.Context, *GetAccountCodeRangesByOrganizationRequest) (*GetAccountCodeRangesByOrganizationResponse, error)
+	// create general ledger exchange rate for a foreign currency
+	CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error)
+	// update general ledger exchange rate
+	UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*UpdateExchangeRateResponse, error)
+	// delete general ledger exchange rate
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	// get general ledger exchange rates by organization
+	GetExchangeRatesByOrganization(context.Context, *GetExchangeRatesByOrganizationRequest) (*GetExchangeRatesByOrganizationResponse, error)
+	// revalue foreign currency balances of organization, posting unrealized exchange gains and losses
+	RevalueForeignBalances(context.Context, *RevalueForeignBalancesRequest) (*RevalueForeignBalancesResponse, error)
+	// create general ledger analytical dimension
+	CreateDimension(context.Context, *CreateDimensionRequest) (*CreateDimensionResponse, error)
+	// update general ledger analytical dimension
+	UpdateDimension(context.Context, *UpdateDimensionRequest) (*UpdateDimensionResponse, error)
+	// delete general ledger analytical dimension
+	DeleteDimension(context.Context, *DeleteDimensionRequest) (*DeleteDimensionResponse, error)
+	// get general ledger analytical dimensions with their values by organization
+	GetDimensionsByOrganization(context.Context, *GetDimensionsByOrganizationRequest) (*GetDimensionsByOrganizationResponse, error)
+	// create allowed value of general ledger analytical dimension
+	CreateDimensionValue(context.Context, *CreateDimensionValueRequest) (*CreateDimensionValueResponse, error)
+	// update allowed value of general ledger analytical dimension
+	UpdateDimensionValue(context.Context, *UpdateDimensionValueRequest) (*UpdateDimensionValueResponse, error)
+	// delete allowed value of general ledger analytical dimension
+	DeleteDimensionValue(context.Context, *DeleteDimensionValueRequest) (*DeleteDimensionValueResponse, error)
+	// get general ledger account balances grouped by value of an analytical dimension
+	GetDimensionBalances(context.Context, *GetDimensionBalancesRequest) (*GetDimensionBalancesResponse, error)
+	// create general ledger budget for a fiscal year
+	CreateBudget(context.Context, *CreateBudgetRequest) (*CreateBudgetResponse, error)
+	// update general ledger budget
+	UpdateBudget(context.Context, *UpdateBudgetRequest) (*UpdateBudgetResponse, error)
+	// delete general ledger budget with its amounts
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	// get general ledger budgets by organization
+	GetBudgetsByOrganization(context.Context, *GetBudgetsByOrganizationRequest) (*GetBudgetsByOrganizationResponse, error)
+	// create general ledger budget amount for account and fiscal period
+	CreateBudgetAmount(context.Context, *CreateBudgetAmountRequest) (*CreateBudgetAmountResponse, error)
+	// update general ledger budget amount
+	UpdateBudgetAmount(context.Context, *UpdateBudgetAmountRequest) (*UpdateBudgetAmountResponse, error)
+	// delete general ledger budget amount
+	DeleteBudgetAmount(context.Context, *DeleteBudgetAmountRequest) (*DeleteBudgetAmountResponse, error)
+	// get general ledger budget amounts of budget
+	GetBudgetAmounts(context.Context, *GetBudgetAmountsRequest) (*GetBudgetAmountsResponse, error)
+	// create or update general ledger budget amounts in bulk
+	LoadBudgetAmounts(context.Context, *LoadBudgetAmountsRequest) (*LoadBudgetAmountsResponse, error)
+	// get general ledger budget versus actual amounts with variances
+	GetBudgetVsActual(context.Context, *GetBudgetVsActualRequest) (*GetBudgetVsActualResponse, error)
+	// create general ledger recurring journal entry
+	CreateRecurringEntry(context.Context, *CreateRecurringEntryRequest) (*CreateRecurringEntryResponse, error)
+	// update general ledger recurring journal entry
+	UpdateRecurringEntry(context.Context, *UpdateRecurringEntryRequest) (*UpdateRecurringEntryResponse, error)
+	// delete general ledger recurring journal entry
+	DeleteRecurringEntry(context.Context, *DeleteRecurringEntryRequest) (*DeleteRecurringEntryResponse, error)
+	// get general ledger recurring journal entry by id
+	GetRecurringEntryById(context.Context, *GetRecurringEntryByIdRequest) (*GetRecurringEntryByIdResponse, error)
+	// get general ledger recurring journal entries by organization
+	GetRecurringEntriesByOrganization(context.Context, *GetRecurringEntriesByOrganizationRequest) (*GetRecurringEntriesByOrganizationResponse, error)
+	// get generated and upcoming occurrences of general ledger recurring journal entry
+	GetRecurringInstances(context.Context, *GetRecurringInstancesRequest) (*GetRecurringInstancesResponse, error)
+	// create general ledger intercompany due to and due from accounts for partner organization
+	CreateIntercompanyAccount(context.Context, *CreateIntercompanyAccountRequest) (*CreateIntercompanyAccountResponse, error)
+	// update general ledger intercompany due to and due from accounts
+	UpdateIntercompanyAccount(context.Context, *UpdateIntercompanyAccountRequest) (*UpdateIntercompanyAccountResponse, error)
+	// delete general ledger intercompany due to and due from accounts
+	DeleteIntercompanyAccount(context.Context, *DeleteIntercompanyAccountRequest) (*DeleteIntercompanyAccountResponse, error)
+	// get general ledger intercompany accounts by organization
+	GetIntercompanyAccountsByOrganization(context.Context, *GetIntercompanyAccountsByOrganizationRequest) (*GetIntercompanyAccountsByOrganizationResponse, error)
+	// post general ledger transactions for one business event in two organizations
+	PostIntercompanyEntry(context.Context, *PostIntercompanyEntryRequest) (*PostIntercompanyEntryResponse, error)
+	// create general ledger organization group for consolidation
+	CreateOrganizationGroup(context.Context, *CreateOrganizationGroupRequest) (*CreateOrganizationGroupResponse, error)
+	// update general ledger organization group
+	UpdateOrganizationGroup(context.Context, *UpdateOrganizationGroupRequest) (*UpdateOrganizationGroupResponse, error)
+	// delete general ledger organization group with its members, account mappings and elimination rules
+	DeleteOrganizationGroup(context.Context, *DeleteOrganizationGroupRequest) (*DeleteOrganizationGroupResponse, error)
+	// get general ledger organization groups
+	GetOrganizationGroups(context.Context, *GetOrganizationGroupsRequest) (*GetOrganizationGroupsResponse, error)
+	// add organization to general ledger organization group
+	CreateOrganizationGroupMember(context.Context, *CreateOrganizationGroupMemberRequest) (*CreateOrganizationGroupMemberResponse, error)
+	// remove organization from general ledger organization group
+	DeleteOrganizationGroupMember(context.Context, *DeleteOrganizationGroupMemberRequest) (*DeleteOrganizationGroupMemberResponse, error)
+	// get members of general ledger organization group
+	GetOrganizationGroupMembers(context.Context, *GetOrganizationGroupMembersRequest) (*GetOrganizationGroupMembersResponse, error)
+	// map general ledger account onto the group chart of accounts
+	CreateGroupAccountMap(context.Context, *CreateGroupAccountMapRequest) (*CreateGroupAccountMapResponse, error)
+	// update general ledger account mapping onto the group chart of accounts
+	UpdateGroupAccountMap(context.Context, *UpdateGroupAccountMapRequest) (*UpdateGroupAccountMapResponse, error)
+	// delete general ledger account mapping onto the group chart of accounts
+	DeleteGroupAccountMap(context.Context, *DeleteGroupAccountMapRequest) (*DeleteGroupAccountMapResponse, error)
+	// get general ledger account mappings of organization group
+	GetGroupAccountMaps(context.Context, *GetGroupAccountMapsRequest) (*GetGroupAccountMapsResponse, error)
+	// create general ledger consolidation elimination rule for a pair of intercompany accounts
+	CreateEliminationRule(context.Context, *CreateEliminationRuleRequest) (*CreateEliminationRuleResponse, error)
+	// update general ledger consolidation elimination rule
+	UpdateEliminationRule(context.Context, *UpdateEliminationRuleRequest) (*UpdateEliminationRuleResponse, error)
+	// delete general ledger consolidation elimination rule
+	DeleteEliminationRule(context.Context, *DeleteEliminationRuleRequest) (*DeleteEliminationRuleResponse, error)
+	// get general ledger consolidation elimination rules of organization group
+	GetEliminationRules(context.Context, *GetEliminationRulesRequest) (*GetEliminationRulesResponse, error)
+	// get general ledger trial balance consolidated over organization group as of date
+	GetConsolidatedTrialBalance(context.Context, *GetConsolidatedTrialBalanceRequest) (*GetConsolidatedTrialBalanceResponse, error)
+	// get general ledger balance sheet consolidated over organization group as of date
+	GetConsolidatedBalanceSheet(context.Context, *GetConsolidatedBalanceSheetRequest) (*GetConsolidatedBalanceSheetResponse, error)
+	// get general ledger income statement consolidated over organization group between dates
+	GetConsolidatedIncomeStatement(context.Context, *GetConsolidatedIncomeStatementRequest) (*GetConsolidatedIncomeStatementResponse, error)
+	// import bank statement lines for a general ledger cash account and match them to transaction details
+	ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error)
+	// match unmatched bank statement lines of a general ledger account to transaction details
+	AutoMatchBankStatement(context.Context, *AutoMatchBankStatementRequest) (*AutoMatchBankStatementResponse, error)
+	// confirm the match of a bank statement line, or match it to a given transaction detail
+	ConfirmBankMatch(context.Context, *ConfirmBankMatchRequest) (*ConfirmBankMatchResponse, error)
+	// remove the match of a bank statement line
+	UnmatchBankStatementLine(context.Context, *UnmatchBankStatementLineRequest) (*UnmatchBankStatementLineResponse, error)
+	// delete an unreconciled bank statement line
+	DeleteBankStatementLine(context.Context, *DeleteBankStatementLineRequest) (*DeleteBankStatementLineResponse, error)
+	// get bank statement lines of a general ledger account between dates
+	GetBankStatementLines(context.Context, *GetBankStatementLinesRequest) (*GetBankStatementLinesResponse, error)
+	// get bank reconciliation of a general ledger account between dates
+	GetBankReconciliation(context.Context, *GetBankReconciliationRequest) (*GetBankReconciliationResponse, error)
+	// create general ledger control account for receivable or payable open items
+	CreateControlAccount(context.Context, *CreateControlAccountRequest) (*CreateControlAccountResponse, error)
+	// update general ledger control account
+	UpdateControlAccount(context.Context, *UpdateControlAccountRequest) (*UpdateControlAccountResponse, error)
+	// delete general ledger control account
+	DeleteControlAccount(context.Context, *DeleteControlAccountRequest) (*DeleteControlAccountResponse, error)
+	// get general ledger control accounts for organization
+	GetControlAccountsByOrganization(context.Context, *GetControlAccountsByOrganizationRequest) (*GetControlAccountsByOrganizationResponse, error)
+	// get receivable or payable open items of an organization
+	GetOpenItems(context.Context, *GetOpenItemsRequest) (*GetOpenItemsResponse, error)
+	// apply a credit open item against a charge open item, or against the oldest open charges of its party
+	ApplyOpenItem(context.Context, *ApplyOpenItemRequest) (*ApplyOpenItemResponse, error)
+	// delete application of a credit open item against a charge open item
+	DeleteOpenItemApplication(context.Context, *DeleteOpenItemApplicationRequest) (*DeleteOpenItemApplicationResponse, error)
+	// get open item applications of a transaction
+	GetOpenItemApplications(context.Context, *GetOpenItemApplicationsRequest) (*GetOpenItemApplicationsResponse, error)
+	// get open receivable or payable balances of parties
+	GetPartyBalances(context.Context, *GetPartyBalancesRequest) (*GetPartyBalancesResponse, error)
+	// get open receivable or payable balances of parties aged by days past due
+	GetPartyAging(context.Context, *GetPartyAgingRequest) (*GetPartyAgingResponse, error)
+	// create general ledger tax code
+	CreateTaxCode(context.Context, *CreateTaxCodeRequest) (*CreateTaxCodeResponse, error)
+	// update general ledger tax code
+	UpdateTaxCode(context.Context, *UpdateTaxCodeRequest) (*UpdateTaxCodeResponse, error)
+	// delete general ledger tax code
+	DeleteTaxCode(context.Context, *DeleteTaxCodeRequest) (*DeleteTaxCodeResponse, error)
+	// get general ledger tax codes for organization
+	GetTaxCodesByOrganization(context.Context, *GetTaxCodesByOrganizationRequest) (*GetTaxCodesByOrganizationResponse, error)
+	// get taxable amounts and tax of an organization per tax code for a period
+	GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error)
+	// create general ledger fixed asset
+	CreateFixedAsset(context.Context, *CreateFixedAssetRequest) (*CreateFixedAssetResponse, error)
+	// update general ledger fixed asset
+	UpdateFixedAsset(context.Context, *UpdateFixedAssetRequest) (*UpdateFixedAssetResponse, error)
+	// delete general ledger fixed asset
+	DeleteFixedAsset(context.Context, *DeleteFixedAssetRequest) (*DeleteFixedAssetResponse, error)
+	// get general ledger fixed asset by id
+	GetFixedAssetById(context.Context, *GetFixedAssetByIdRequest) (*GetFixedAssetByIdResponse, error)
+	// get general ledger fixed assets for organization
+	GetFixedAssetsByOrganization(context.Context, *GetFixedAssetsByOrganizationRequest) (*GetFixedAssetsByOrganizationResponse, error)
+	// post the depreciation of the fixed assets of an organization for a fiscal period
+	RunDepreciation(context.Context, *RunDepreciationRequest) (*RunDepreciationResponse, error)
+	// dispose of a general ledger fixed asset, posting its depreciation to date and the gain or loss
+	DisposeFixedAsset(context.Context, *DisposeFixedAssetRequest) (*DisposeFixedAssetResponse, error)
+	// get depreciation posted for a general ledger fixed asset
+	GetDepreciationEntries(context.Context, *GetDepreciationEntriesRequest) (*GetDepreciationEntriesResponse, error)
+	// get current server version and uptime - health check
+	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
+	mustEmbedUnimplementedMServiceLedgerServer()
+}
+
+// UnimplementedMServiceLedgerServer must be embedded to have forward compatible implementations.
+type UnimplementedMServiceLedgerServer struct {
+}
+
+func (UnimplementedMServiceLedgerServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOrganizationById(context.Context, *GetOrganizationByIdRequest) (*GetOrganizationByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOrganizationsByMservice(context.Context, *GetOrganizationsByMserviceRequest) (*GetOrganizationsByMserviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationsByMservice not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateAccountType(context.Context, *CreateAccountTypeRequest) (*CreateAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateAccountType(context.Context, *UpdateAccountTypeRequest) (*UpdateAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteAccountType(context.Context, *DeleteAccountTypeRequest) (*DeleteAccountTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccountType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountTypeById(context.Context, *GetAccountTypeByIdRequest) (*GetAccountTypeByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTypeById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountTypesByMservice(context.Context, *GetAccountTypesByMserviceRequest) (*GetAccountTypesByMserviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTypesByMservice not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateTransactionType(context.Context, *CreateTransactionTypeRequest) (*CreateTransactionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactionType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateTransactionType(context.Context, *UpdateTransactionTypeRequest) (*UpdateTransactionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransactionType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteTransactionType(context.Context, *DeleteTransactionTypeRequest) (*DeleteTransactionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransactionType not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTransactionTypeById(context.Context, *GetTransactionTypeByIdRequest) (*GetTransactionTypeByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTypeById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTransactionTypesByMservice(context.Context, *GetTransactionTypesByMserviceRequest) (*GetTransactionTypesByMserviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionTypesByMservice not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateParty(context.Context, *CreatePartyRequest) (*CreatePartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateParty not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateParty(context.Context, *UpdatePartyRequest) (*UpdatePartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParty not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteParty(context.Context, *DeletePartyRequest) (*DeletePartyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteParty not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetPartyById(context.Context, *GetPartyByIdRequest) (*GetPartyByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartyById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetPartiesByMservice(context.Context, *GetPartiesByMserviceRequest) (*GetPartiesByMserviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartiesByMservice not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountById(context.Context, *GetAccountByIdRequest) (*GetAccountByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountsByOrganization(context.Context, *GetAccountsByOrganizationRequest) (*GetAccountsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTransactionWrapperById(context.Context, *GetTransactionWrapperByIdRequest) (*GetTransactionWrapperByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionWrapperById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTransactionWrappersByDate(context.Context, *GetTransactionWrappersByDateRequest) (*GetTransactionWrappersByDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionWrappersByDate not implemented")
+}
+func (UnimplementedMServiceLedgerServer) AddTransactionDetails(context.Context, *AddTransactionDetailsRequest) (*AddTransactionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransactionDetails not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountLedger(context.Context, *GetAccountLedgerRequest) (*GetAccountLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountLedger not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSheet not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeStatement not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateFiscalYear(context.Context, *CreateFiscalYearRequest) (*CreateFiscalYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFiscalYear not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteFiscalYear(context.Context, *DeleteFiscalYearRequest) (*DeleteFiscalYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFiscalYear not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetFiscalYearsByOrganization(context.Context, *GetFiscalYearsByOrganizationRequest) (*GetFiscalYearsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiscalYearsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetFiscalPeriodsByYear(context.Context, *GetFiscalPeriodsByYearRequest) (*GetFiscalPeriodsByYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiscalPeriodsByYear not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateFiscalPeriodStatus(context.Context, *UpdateFiscalPeriodStatusRequest) (*UpdateFiscalPeriodStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFiscalPeriodStatus not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CloseFiscalYear(context.Context, *CloseFiscalYearRequest) (*CloseFiscalYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFiscalYear not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ReopenFiscalYear(context.Context, *ReopenFiscalYearRequest) (*ReopenFiscalYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenFiscalYear not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) PostTransaction(context.Context, *PostTransactionRequest) (*PostTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTransaction not implemented")
+}
+func (UnimplementedMServiceLedgerServer) PostJournalEntry(context.Context, *PostJournalEntryRequest) (*PostJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostJournalEntry not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ReplaceTransactionDetails(context.Context, *ReplaceTransactionDetailsRequest) (*ReplaceTransactionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceTransactionDetails not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTree not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateAccountCodeRange(context.Context, *CreateAccountCodeRangeRequest) (*CreateAccountCodeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountCodeRange not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateAccountCodeRange(context.Context, *UpdateAccountCodeRangeRequest) (*UpdateAccountCodeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountCodeRange not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteAccountCodeRange(context.Context, *DeleteAccountCodeRangeRequest) (*DeleteAccountCodeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccountCodeRange not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetAccountCodeRangesByOrganization(context.Context, *GetAccountCodeRangesByOrganizationRequest) (*GetAccountCodeRangesByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCodeRangesByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExchangeRate not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateExchangeRate(context.Context, *UpdateExchangeRateRequest) (*UpdateExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeRate not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetExchangeRatesByOrganization(context.Context, *GetExchangeRatesByOrganizationRequest) (*GetExchangeRatesByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRatesByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) RevalueForeignBalances(context.Context, *RevalueForeignBalancesRequest) (*RevalueForeignBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevalueForeignBalances not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateDimension(context.Context, *CreateDimensionRequest) (*CreateDimensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDimension not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateDimension(context.Context, *UpdateDimensionRequest) (*UpdateDimensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDimension not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteDimension(context.Context, *DeleteDimensionRequest) (*DeleteDimensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDimension not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetDimensionsByOrganization(context.Context, *GetDimensionsByOrganizationRequest) (*GetDimensionsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDimensionsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateDimensionValue(context.Context, *CreateDimensionValueRequest) (*CreateDimensionValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDimensionValue not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateDimensionValue(context.Context, *UpdateDimensionValueRequest) (*UpdateDimensionValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDimensionValue not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteDimensionValue(context.Context, *DeleteDimensionValueRequest) (*DeleteDimensionValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDimensionValue not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetDimensionBalances(context.Context, *GetDimensionBalancesRequest) (*GetDimensionBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDimensionBalances not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateBudget(context.Context, *CreateBudgetRequest) (*CreateBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudget not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateBudget(context.Context, *UpdateBudgetRequest) (*UpdateBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBudget not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBudgetsByOrganization(context.Context, *GetBudgetsByOrganizationRequest) (*GetBudgetsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateBudgetAmount(context.Context, *CreateBudgetAmountRequest) (*CreateBudgetAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBudgetAmount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateBudgetAmount(context.Context, *UpdateBudgetAmountRequest) (*UpdateBudgetAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBudgetAmount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteBudgetAmount(context.Context, *DeleteBudgetAmountRequest) (*DeleteBudgetAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudgetAmount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBudgetAmounts(context.Context, *GetBudgetAmountsRequest) (*GetBudgetAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetAmounts not implemented")
+}
+func (UnimplementedMServiceLedgerServer) LoadBudgetAmounts(context.Context, *LoadBudgetAmountsRequest) (*LoadBudgetAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadBudgetAmounts not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBudgetVsActual(context.Context, *GetBudgetVsActualRequest) (*GetBudgetVsActualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetVsActual not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateRecurringEntry(context.Context, *CreateRecurringEntryRequest) (*CreateRecurringEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringEntry not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateRecurringEntry(context.Context, *UpdateRecurringEntryRequest) (*UpdateRecurringEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringEntry not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteRecurringEntry(context.Context, *DeleteRecurringEntryRequest) (*DeleteRecurringEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringEntry not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetRecurringEntryById(context.Context, *GetRecurringEntryByIdRequest) (*GetRecurringEntryByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringEntryById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetRecurringEntriesByOrganization(context.Context, *GetRecurringEntriesByOrganizationRequest) (*GetRecurringEntriesByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringEntriesByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetRecurringInstances(context.Context, *GetRecurringInstancesRequest) (*GetRecurringInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringInstances not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateIntercompanyAccount(context.Context, *CreateIntercompanyAccountRequest) (*CreateIntercompanyAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIntercompanyAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateIntercompanyAccount(context.Context, *UpdateIntercompanyAccountRequest) (*UpdateIntercompanyAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIntercompanyAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteIntercompanyAccount(context.Context, *DeleteIntercompanyAccountRequest) (*DeleteIntercompanyAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIntercompanyAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetIntercompanyAccountsByOrganization(context.Context, *GetIntercompanyAccountsByOrganizationRequest) (*GetIntercompanyAccountsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIntercompanyAccountsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) PostIntercompanyEntry(context.Context, *PostIntercompanyEntryRequest) (*PostIntercompanyEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostIntercompanyEntry not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateOrganizationGroup(context.Context, *CreateOrganizationGroupRequest) (*CreateOrganizationGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationGroup not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateOrganizationGroup(context.Context, *UpdateOrganizationGroupRequest) (*UpdateOrganizationGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationGroup not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteOrganizationGroup(context.Context, *DeleteOrganizationGroupRequest) (*DeleteOrganizationGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationGroup not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOrganizationGroups(context.Context, *GetOrganizationGroupsRequest) (*GetOrganizationGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationGroups not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateOrganizationGroupMember(context.Context, *CreateOrganizationGroupMemberRequest) (*CreateOrganizationGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganizationGroupMember not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteOrganizationGroupMember(context.Context, *DeleteOrganizationGroupMemberRequest) (*DeleteOrganizationGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationGroupMember not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOrganizationGroupMembers(context.Context, *GetOrganizationGroupMembersRequest) (*GetOrganizationGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationGroupMembers not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateGroupAccountMap(context.Context, *CreateGroupAccountMapRequest) (*CreateGroupAccountMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupAccountMap not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateGroupAccountMap(context.Context, *UpdateGroupAccountMapRequest) (*UpdateGroupAccountMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountMap not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteGroupAccountMap(context.Context, *DeleteGroupAccountMapRequest) (*DeleteGroupAccountMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupAccountMap not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetGroupAccountMaps(context.Context, *GetGroupAccountMapsRequest) (*GetGroupAccountMapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupAccountMaps not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateEliminationRule(context.Context, *CreateEliminationRuleRequest) (*CreateEliminationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEliminationRule not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateEliminationRule(context.Context, *UpdateEliminationRuleRequest) (*UpdateEliminationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEliminationRule not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteEliminationRule(context.Context, *DeleteEliminationRuleRequest) (*DeleteEliminationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEliminationRule not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetEliminationRules(context.Context, *GetEliminationRulesRequest) (*GetEliminationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEliminationRules not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetConsolidatedTrialBalance(context.Context, *GetConsolidatedTrialBalanceRequest) (*GetConsolidatedTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedTrialBalance not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetConsolidatedBalanceSheet(context.Context, *GetConsolidatedBalanceSheetRequest) (*GetConsolidatedBalanceSheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedBalanceSheet not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetConsolidatedIncomeStatement(context.Context, *GetConsolidatedIncomeStatementRequest) (*GetConsolidatedIncomeStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedIncomeStatement not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ImportBankStatement(context.Context, *ImportBankStatementRequest) (*ImportBankStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBankStatement not implemented")
+}
+func (UnimplementedMServiceLedgerServer) AutoMatchBankStatement(context.Context, *AutoMatchBankStatementRequest) (*AutoMatchBankStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoMatchBankStatement not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ConfirmBankMatch(context.Context, *ConfirmBankMatchRequest) (*ConfirmBankMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBankMatch not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UnmatchBankStatementLine(context.Context, *UnmatchBankStatementLineRequest) (*UnmatchBankStatementLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmatchBankStatementLine not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteBankStatementLine(context.Context, *DeleteBankStatementLineRequest) (*DeleteBankStatementLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankStatementLine not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBankStatementLines(context.Context, *GetBankStatementLinesRequest) (*GetBankStatementLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankStatementLines not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetBankReconciliation(context.Context, *GetBankReconciliationRequest) (*GetBankReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankReconciliation not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateControlAccount(context.Context, *CreateControlAccountRequest) (*CreateControlAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateControlAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateControlAccount(context.Context, *UpdateControlAccountRequest) (*UpdateControlAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateControlAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteControlAccount(context.Context, *DeleteControlAccountRequest) (*DeleteControlAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteControlAccount not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetControlAccountsByOrganization(context.Context, *GetControlAccountsByOrganizationRequest) (*GetControlAccountsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetControlAccountsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOpenItems(context.Context, *GetOpenItemsRequest) (*GetOpenItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenItems not implemented")
+}
+func (UnimplementedMServiceLedgerServer) ApplyOpenItem(context.Context, *ApplyOpenItemRequest) (*ApplyOpenItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyOpenItem not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteOpenItemApplication(context.Context, *DeleteOpenItemApplicationRequest) (*DeleteOpenItemApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOpenItemApplication not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetOpenItemApplications(context.Context, *GetOpenItemApplicationsRequest) (*GetOpenItemApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenItemApplications not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetPartyBalances(context.Context, *GetPartyBalancesRequest) (*GetPartyBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartyBalances not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetPartyAging(context.Context, *GetPartyAgingRequest) (*GetPartyAgingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartyAging not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateTaxCode(context.Context, *CreateTaxCodeRequest) (*CreateTaxCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxCode not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateTaxCode(context.Context, *UpdateTaxCodeRequest) (*UpdateTaxCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaxCode not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteTaxCode(context.Context, *DeleteTaxCodeRequest) (*DeleteTaxCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxCode not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTaxCodesByOrganization(context.Context, *GetTaxCodesByOrganizationRequest) (*GetTaxCodesByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxCodesByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetTaxReport(context.Context, *GetTaxReportRequest) (*GetTaxReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxReport not implemented")
+}
+func (UnimplementedMServiceLedgerServer) CreateFixedAsset(context.Context, *CreateFixedAssetRequest) (*CreateFixedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFixedAsset not implemented")
+}
+func (UnimplementedMServiceLedgerServer) UpdateFixedAsset(context.Context, *UpdateFixedAssetRequest) (*UpdateFixedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFixedAsset not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DeleteFixedAsset(context.Context, *DeleteFixedAssetRequest) (*DeleteFixedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFixedAsset not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetFixedAssetById(context.Context, *GetFixedAssetByIdRequest) (*GetFixedAssetByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixedAssetById not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetFixedAssetsByOrganization(context.Context, *GetFixedAssetsByOrganizationRequest) (*GetFixedAssetsByOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixedAssetsByOrganization not implemented")
+}
+func (UnimplementedMServiceLedgerServer) RunDepreciation(context.Context, *RunDepreciationRequest) (*RunDepreciationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDepreciation not implemented")
+}
+func (UnimplementedMServiceLedgerServer) DisposeFixedAsset(context.Context, *DisposeFixedAssetRequest) (*DisposeFixedAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisposeFixedAsset not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetDepreciationEntries(context.Context, *GetDepreciationEntriesRequest) (*GetDepreciationEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepreciationEntries not implemented")
+}
+func (UnimplementedMServiceLedgerServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
+}
+func (UnimplementedMServiceLedgerServer) mustEmbedUnimplementedMServiceLedgerServer() {}
+
+// UnsafeMServiceLedgerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MServiceLedgerServer will
+// result in compilation errors.
+type UnsafeMServiceLedgerServer interface {
+	mustEmbedUnimplementedMServiceLedgerServer()
+}
+
+func RegisterMServiceLedgerServer(s grpc.ServiceRegistrar, srv MServiceLedgerServer) {
+	s.RegisterService(&MServiceLedger_ServiceDesc, srv)
+}
+
+func _MServiceLedger_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateOrganization(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetOrganizationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetOrganizationById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetOrganizationById(ctx, req.(*GetOrganizationByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetOrganizationsByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationsByMserviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetOrganizationsByMservice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organizations_by_mservice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetOrganizationsByMservice(ctx, req.(*GetOrganizationsByMserviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_account_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateAccountType(ctx, req.(*CreateAccountTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_account_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateAccountType(ctx, req.(*UpdateAccountTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteAccountType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_account_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteAccountType(ctx, req.(*DeleteAccountTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTypeByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountTypeById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_type_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountTypeById(ctx, req.(*GetAccountTypeByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountTypesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTypesByMserviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountTypesByMservice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_types_by_mservice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountTypesByMservice(ctx, req.(*GetAccountTypesByMserviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateTransactionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_transaction_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateTransactionType(ctx, req.(*CreateTransactionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateTransactionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_transaction_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateTransactionType(ctx, req.(*UpdateTransactionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteTransactionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_transaction_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteTransactionType(ctx, req.(*DeleteTransactionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTransactionTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionTypeByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTransactionTypeById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_type_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTransactionTypeById(ctx, req.(*GetTransactionTypeByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTransactionTypesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionTypesByMserviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTransactionTypesByMservice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_types_by_mservice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTransactionTypesByMservice(ctx, req.(*GetTransactionTypesByMserviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_party",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateParty(ctx, req.(*CreatePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_party",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateParty(ctx, req.(*UpdatePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteParty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_party",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteParty(ctx, req.(*DeletePartyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetPartyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetPartyById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_party_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetPartyById(ctx, req.(*GetPartyByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetPartiesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartiesByMserviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetPartiesByMservice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_parties_by_mservice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetPartiesByMservice(ctx, req.(*GetPartiesByMserviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountById(ctx, req.(*GetAccountByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountsByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_accounts_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountsByOrganization(ctx, req.(*GetAccountsByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTransactionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTransactionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTransactionById(ctx, req.(*GetTransactionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTransactionWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionWrapperByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTransactionWrapperById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_wrapper_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTransactionWrapperById(ctx, req.(*GetTransactionWrapperByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTransactionWrappersByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionWrappersByDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTransactionWrappersByDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_wrappers_by_date",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTransactionWrappersByDate(ctx, req.(*GetTransactionWrappersByDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_AddTransactionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTransactionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).AddTransactionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/add_transaction_details",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).AddTransactionDetails(ctx, req.(*AddTransactionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_trial_balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_ledger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountLedger(ctx, req.(*GetAccountLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetBalanceSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetBalanceSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_balance_sheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetBalanceSheet(ctx, req.(*GetBalanceSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetIncomeStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomeStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetIncomeStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_income_statement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetIncomeStatement(ctx, req.(*GetIncomeStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFiscalYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateFiscalYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_fiscal_year",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateFiscalYear(ctx, req.(*CreateFiscalYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFiscalYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteFiscalYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_fiscal_year",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteFiscalYear(ctx, req.(*DeleteFiscalYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetFiscalYearsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFiscalYearsByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetFiscalYearsByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_fiscal_years_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetFiscalYearsByOrganization(ctx, req.(*GetFiscalYearsByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetFiscalPeriodsByYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFiscalPeriodsByYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetFiscalPeriodsByYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_fiscal_periods_by_year",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetFiscalPeriodsByYear(ctx, req.(*GetFiscalPeriodsByYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateFiscalPeriodStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFiscalPeriodStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateFiscalPeriodStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_fiscal_period_status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateFiscalPeriodStatus(ctx, req.(*UpdateFiscalPeriodStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CloseFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFiscalYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CloseFiscalYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/close_fiscal_year",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CloseFiscalYear(ctx, req.(*CloseFiscalYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_ReopenFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenFiscalYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).ReopenFiscalYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/reopen_fiscal_year",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).ReopenFiscalYear(ctx, req.(*ReopenFiscalYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/reverse_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_VoidTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).VoidTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/void_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).VoidTransaction(ctx, req.(*VoidTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_PostTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).PostTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/post_transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).PostTransaction(ctx, req.(*PostTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_PostJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).PostJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/post_journal_entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).PostJournalEntry(ctx, req.(*PostJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_ReplaceTransactionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceTransactionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).ReplaceTransactionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/replace_transaction_details",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).ReplaceTransactionDetails(ctx, req.(*ReplaceTransactionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_tree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountTree(ctx, req.(*GetAccountTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateAccountCodeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountCodeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateAccountCodeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_account_code_range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateAccountCodeRange(ctx, req.(*CreateAccountCodeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateAccountCodeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountCodeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateAccountCodeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_account_code_range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateAccountCodeRange(ctx, req.(*UpdateAccountCodeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteAccountCodeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountCodeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteAccountCodeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_account_code_range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteAccountCodeRange(ctx, req.(*DeleteAccountCodeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetAccountCodeRangesByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountCodeRangesByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetAccountCodeRangesByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_code_ranges_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetAccountCodeRangesByOrganization(ctx, req.(*GetAccountCodeRangesByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_exchange_rate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateExchangeRate(ctx, req.(*CreateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_exchange_rate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateExchangeRate(ctx, req.(*UpdateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_exchange_rate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetExchangeRatesByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetExchangeRatesByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_exchange_rates_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetExchangeRatesByOrganization(ctx, req.(*GetExchangeRatesByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_RevalueForeignBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevalueForeignBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).RevalueForeignBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/revalue_foreign_balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).RevalueForeignBalances(ctx, req.(*RevalueForeignBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateDimension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDimensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateDimension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_dimension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateDimension(ctx, req.(*CreateDimensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateDimension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDimensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateDimension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_dimension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateDimension(ctx, req.(*UpdateDimensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteDimension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDimensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteDimension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_dimension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteDimension(ctx, req.(*DeleteDimensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetDimensionsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDimensionsByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetDimensionsByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_dimensions_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetDimensionsByOrganization(ctx, req.(*GetDimensionsByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateDimensionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDimensionValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateDimensionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_dimension_value",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateDimensionValue(ctx, req.(*CreateDimensionValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateDimensionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDimensionValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateDimensionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_dimension_value",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateDimensionValue(ctx, req.(*UpdateDimensionValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteDimensionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDimensionValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteDimensionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_dimension_value",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteDimensionValue(ctx, req.(*DeleteDimensionValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetDimensionBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDimensionBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetDimensionBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_dimension_balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetDimensionBalances(ctx, req.(*GetDimensionBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_budget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateBudget(ctx, req.(*CreateBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_budget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateBudget(ctx, req.(*UpdateBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_budget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetBudgetsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetsByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetBudgetsByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_budgets_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetBudgetsByOrganization(ctx, req.(*GetBudgetsByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateBudgetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBudgetAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateBudgetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_budget_amount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateBudgetAmount(ctx, req.(*CreateBudgetAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateBudgetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBudgetAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateBudgetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_budget_amount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateBudgetAmount(ctx, req.(*UpdateBudgetAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteBudgetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteBudgetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_budget_amount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteBudgetAmount(ctx, req.(*DeleteBudgetAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetBudgetAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetBudgetAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_budget_amounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetBudgetAmounts(ctx, req.(*GetBudgetAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_LoadBudgetAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadBudgetAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).LoadBudgetAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/load_budget_amounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).LoadBudgetAmounts(ctx, req.(*LoadBudgetAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetBudgetVsActual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetVsActualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetBudgetVsActual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_budget_vs_actual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetBudgetVsActual(ctx, req.(*GetBudgetVsActualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateRecurringEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateRecurringEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_recurring_entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateRecurringEntry(ctx, req.(*CreateRecurringEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateRecurringEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateRecurringEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_recurring_entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateRecurringEntry(ctx, req.(*UpdateRecurringEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteRecurringEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteRecurringEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_recurring_entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteRecurringEntry(ctx, req.(*DeleteRecurringEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetRecurringEntryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringEntryByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetRecurringEntryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_entry_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetRecurringEntryById(ctx, req.(*GetRecurringEntryByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetRecurringEntriesByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringEntriesByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetRecurringEntriesByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_entries_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetRecurringEntriesByOrganization(ctx, req.(*GetRecurringEntriesByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetRecurringInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetRecurringInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_recurring_instances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetRecurringInstances(ctx, req.(*GetRecurringInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateIntercompanyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIntercompanyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateIntercompanyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_intercompany_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateIntercompanyAccount(ctx, req.(*CreateIntercompanyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateIntercompanyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIntercompanyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateIntercompanyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_intercompany_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateIntercompanyAccount(ctx, req.(*UpdateIntercompanyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteIntercompanyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIntercompanyAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteIntercompanyAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_intercompany_account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteIntercompanyAccount(ctx, req.(*DeleteIntercompanyAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetIntercompanyAccountsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIntercompanyAccountsByOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetIntercompanyAccountsByOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_intercompany_accounts_by_organization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetIntercompanyAccountsByOrganization(ctx, req.(*GetIntercompanyAccountsByOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_PostIntercompanyEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostIntercompanyEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).PostIntercompanyEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/post_intercompany_entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).PostIntercompanyEntry(ctx, req.(*PostIntercompanyEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateOrganizationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateOrganizationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_organization_group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateOrganizationGroup(ctx, req.(*CreateOrganizationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_UpdateOrganizationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateOrganizationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_organization_group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateOrganizationGroup(ctx, req.(*UpdateOrganizationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteOrganizationGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteOrganizationGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization_group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteOrganizationGroup(ctx, req.(*DeleteOrganizationGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_GetOrganizationGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetOrganizationGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_groups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetOrganizationGroups(ctx, req.(*GetOrganizationGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_CreateOrganizationGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateOrganizationGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_organization_group_member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateOrganizationGroupMember(ctx, req.(*CreateOrganizationGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceLedger_DeleteOrganizationGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteOrganizationGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization_group_member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteOrganizationGroupMember(ctx, req.(*DeleteOrganizationGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+
+func _MServiceLedger_GetOrganizationGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetOrganizationGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_group_members",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetOrganizationGroupMembers(ctx, req.(*GetOrganizationGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
+
+func _MServiceLedger_CreateGroupAccountMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupAccountMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateGroupAccountMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_group_account_map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateGroupAccountMap(ctx, req.(*CreateGroupAccountMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+
+func _MServiceLedger_UpdateGroupAccountMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupAccountMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateGroupAccountMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_group_account_map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateGroupAccountMap(ctx, req.(*UpdateGroupAccountMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) GetTransactionById(context.Context, *GetTransactionByIdRequest) (*GetTransactionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionById not implemented")
+
+func _MServiceLedger_DeleteGroupAccountMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupAccountMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteGroupAccountMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_group_account_map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteGroupAccountMap(ctx, req.(*DeleteGroupAccountMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) GetTransactionWrapperById(context.Context, *GetTransactionWrapperByIdRequest) (*GetTransactionWrapperByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionWrapperById not implemented")
+
+func _MServiceLedger_GetGroupAccountMaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupAccountMapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetGroupAccountMaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_group_account_maps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetGroupAccountMaps(ctx, req.(*GetGroupAccountMapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) GetTransactionWrappersByDate(context.Context, *GetTransactionWrappersByDateRequest) (*GetTransactionWrappersByDateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionWrappersByDate not implemented")
+
+func _MServiceLedger_CreateEliminationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEliminationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).CreateEliminationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_elimination_rule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).CreateEliminationRule(ctx, req.(*CreateEliminationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) AddTransactionDetails(context.Context, *AddTransactionDetailsRequest) (*AddTransactionDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTransactionDetails not implemented")
+
+func _MServiceLedger_UpdateEliminationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEliminationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).UpdateEliminationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_elimination_rule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).UpdateEliminationRule(ctx, req.(*UpdateEliminationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
+
+func _MServiceLedger_DeleteEliminationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEliminationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).DeleteEliminationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_elimination_rule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).DeleteEliminationRule(ctx, req.(*DeleteEliminationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMServiceLedgerServer) mustEmbedUnimplementedMServiceLedgerServer() {}
 
-// UnsafeMServiceLedgerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MServiceLedgerServer will
-// result in compilation errors.
-type UnsafeMServiceLedgerServer interface {
-	mustEmbedUnimplementedMServiceLedgerServer()
+func _MServiceLedger_GetEliminationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEliminationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetEliminationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_elimination_rules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetEliminationRules(ctx, req.(*GetEliminationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMServiceLedgerServer(s grpc.ServiceRegistrar, srv MServiceLedgerServer) {
-	s.RegisterService(&MServiceLedger_ServiceDesc, srv)
+func _MServiceLedger_GetConsolidatedTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceLedgerServer).GetConsolidatedTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_trial_balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceLedgerServer).GetConsolidatedTrialBalance(ctx, req.(*GetConsolidatedTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrganizationRequest)
+func _MServiceLedger_GetConsolidatedBalanceSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedBalanceSheetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateOrganization(ctx, in)
+		return srv.(MServiceLedgerServer).GetConsolidatedBalanceSheet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_organization",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_balance_sheet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+		return srv.(MServiceLedgerServer).GetConsolidatedBalanceSheet(ctx, req.(*GetConsolidatedBalanceSheetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrganizationRequest)
+func _MServiceLedger_GetConsolidatedIncomeStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedIncomeStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateOrganization(ctx, in)
+		return srv.(MServiceLedgerServer).GetConsolidatedIncomeStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_organization",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_consolidated_income_statement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateOrganization(ctx, req.(*UpdateOrganizationRequest))
+		return srv.(MServiceLedgerServer).GetConsolidatedIncomeStatement(ctx, req.(*GetConsolidatedIncomeStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationRequest)
+func _MServiceLedger_ImportBankStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBankStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteOrganization(ctx, in)
+		return srv.(MServiceLedgerServer).ImportBankStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_organization",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/import_bank_statement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteOrganization(ctx, req.(*DeleteOrganizationRequest))
+		return srv.(MServiceLedgerServer).ImportBankStatement(ctx, req.(*ImportBankStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetOrganizationById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationByIdRequest)
+func _MServiceLedger_AutoMatchBankStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoMatchBankStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetOrganizationById(ctx, in)
+		return srv.(MServiceLedgerServer).AutoMatchBankStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organization_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/auto_match_bank_statement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetOrganizationById(ctx, req.(*GetOrganizationByIdRequest))
+		return srv.(MServiceLedgerServer).AutoMatchBankStatement(ctx, req.(*AutoMatchBankStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetOrganizationsByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationsByMserviceRequest)
+func _MServiceLedger_ConfirmBankMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmBankMatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetOrganizationsByMservice(ctx, in)
+		return srv.(MServiceLedgerServer).ConfirmBankMatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_organizations_by_mservice",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/confirm_bank_match",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetOrganizationsByMservice(ctx, req.(*GetOrganizationsByMserviceRequest))
+		return srv.(MServiceLedgerServer).ConfirmBankMatch(ctx, req.(*ConfirmBankMatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountTypeRequest)
+func _MServiceLedger_UnmatchBankStatementLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmatchBankStatementLineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateAccountType(ctx, in)
+		return srv.(MServiceLedgerServer).UnmatchBankStatementLine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_account_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/unmatch_bank_statement_line",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateAccountType(ctx, req.(*CreateAccountTypeRequest))
+		return srv.(MServiceLedgerServer).UnmatchBankStatementLine(ctx, req.(*UnmatchBankStatementLineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountTypeRequest)
+func _MServiceLedger_DeleteBankStatementLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankStatementLineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateAccountType(ctx, in)
+		return srv.(MServiceLedgerServer).DeleteBankStatementLine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_account_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_bank_statement_line",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateAccountType(ctx, req.(*UpdateAccountTypeRequest))
+		return srv.(MServiceLedgerServer).DeleteBankStatementLine(ctx, req.(*DeleteBankStatementLineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteAccountType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccountTypeRequest)
+func _MServiceLedger_GetBankStatementLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankStatementLinesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteAccountType(ctx, in)
+		return srv.(MServiceLedgerServer).GetBankStatementLines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_account_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_bank_statement_lines",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteAccountType(ctx, req.(*DeleteAccountTypeRequest))
+		return srv.(MServiceLedgerServer).GetBankStatementLines(ctx, req.(*GetBankStatementLinesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetAccountTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountTypeByIdRequest)
+func _MServiceLedger_GetBankReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankReconciliationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetAccountTypeById(ctx, in)
+		return srv.(MServiceLedgerServer).GetBankReconciliation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_type_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_bank_reconciliation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetAccountTypeById(ctx, req.(*GetAccountTypeByIdRequest))
+		return srv.(MServiceLedgerServer).GetBankReconciliation(ctx, req.(*GetBankReconciliationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetAccountTypesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountTypesByMserviceRequest)
+func _MServiceLedger_CreateControlAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateControlAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetAccountTypesByMservice(ctx, in)
+		return srv.(MServiceLedgerServer).CreateControlAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_types_by_mservice",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_control_account",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetAccountTypesByMservice(ctx, req.(*GetAccountTypesByMserviceRequest))
+		return srv.(MServiceLedgerServer).CreateControlAccount(ctx, req.(*CreateControlAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionTypeRequest)
+func _MServiceLedger_UpdateControlAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateControlAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateTransactionType(ctx, in)
+		return srv.(MServiceLedgerServer).UpdateControlAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_transaction_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_control_account",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateTransactionType(ctx, req.(*CreateTransactionTypeRequest))
+		return srv.(MServiceLedgerServer).UpdateControlAccount(ctx, req.(*UpdateControlAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTransactionTypeRequest)
+func _MServiceLedger_DeleteControlAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteControlAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateTransactionType(ctx, in)
+		return srv.(MServiceLedgerServer).DeleteControlAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_transaction_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_control_account",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateTransactionType(ctx, req.(*UpdateTransactionTypeRequest))
+		return srv.(MServiceLedgerServer).DeleteControlAccount(ctx, req.(*DeleteControlAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteTransactionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTransactionTypeRequest)
+func _MServiceLedger_GetControlAccountsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetControlAccountsByOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteTransactionType(ctx, in)
+		return srv.(MServiceLedgerServer).GetControlAccountsByOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_transaction_type",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_control_accounts_by_organization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteTransactionType(ctx, req.(*DeleteTransactionTypeRequest))
+		return srv.(MServiceLedgerServer).GetControlAccountsByOrganization(ctx, req.(*GetControlAccountsByOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetTransactionTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionTypeByIdRequest)
+func _MServiceLedger_GetOpenItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetTransactionTypeById(ctx, in)
+		return srv.(MServiceLedgerServer).GetOpenItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_type_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_open_items",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetTransactionTypeById(ctx, req.(*GetTransactionTypeByIdRequest))
+		return srv.(MServiceLedgerServer).GetOpenItems(ctx, req.(*GetOpenItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetTransactionTypesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionTypesByMserviceRequest)
+func _MServiceLedger_ApplyOpenItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyOpenItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetTransactionTypesByMservice(ctx, in)
+		return srv.(MServiceLedgerServer).ApplyOpenItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_types_by_mservice",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/apply_open_item",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetTransactionTypesByMservice(ctx, req.(*GetTransactionTypesByMserviceRequest))
+		return srv.(MServiceLedgerServer).ApplyOpenItem(ctx, req.(*ApplyOpenItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePartyRequest)
+func _MServiceLedger_DeleteOpenItemApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOpenItemApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateParty(ctx, in)
+		return srv.(MServiceLedgerServer).DeleteOpenItemApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_party",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_open_item_application",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateParty(ctx, req.(*CreatePartyRequest))
+		return srv.(MServiceLedgerServer).DeleteOpenItemApplication(ctx, req.(*DeleteOpenItemApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePartyRequest)
+func _MServiceLedger_GetOpenItemApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenItemApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateParty(ctx, in)
+		return srv.(MServiceLedgerServer).GetOpenItemApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_party",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_open_item_applications",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateParty(ctx, req.(*UpdatePartyRequest))
+		return srv.(MServiceLedgerServer).GetOpenItemApplications(ctx, req.(*GetOpenItemApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePartyRequest)
+func _MServiceLedger_GetPartyBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyBalancesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteParty(ctx, in)
+		return srv.(MServiceLedgerServer).GetPartyBalances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_party",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_party_balances",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteParty(ctx, req.(*DeletePartyRequest))
+		return srv.(MServiceLedgerServer).GetPartyBalances(ctx, req.(*GetPartyBalancesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetPartyById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartyByIdRequest)
+func _MServiceLedger_GetPartyAging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyAgingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetPartyById(ctx, in)
+		return srv.(MServiceLedgerServer).GetPartyAging(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_party_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_party_aging",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetPartyById(ctx, req.(*GetPartyByIdRequest))
+		return srv.(MServiceLedgerServer).GetPartyAging(ctx, req.(*GetPartyAgingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetPartiesByMservice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartiesByMserviceRequest)
+func _MServiceLedger_CreateTaxCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetPartiesByMservice(ctx, in)
+		return srv.(MServiceLedgerServer).CreateTaxCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_parties_by_mservice",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_tax_code",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetPartiesByMservice(ctx, req.(*GetPartiesByMserviceRequest))
+		return srv.(MServiceLedgerServer).CreateTaxCode(ctx, req.(*CreateTaxCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _MServiceLedger_UpdateTaxCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateAccount(ctx, in)
+		return srv.(MServiceLedgerServer).UpdateTaxCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_account",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_tax_code",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(MServiceLedgerServer).UpdateTaxCode(ctx, req.(*UpdateTaxCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountRequest)
+func _MServiceLedger_DeleteTaxCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateAccount(ctx, in)
+		return srv.(MServiceLedgerServer).DeleteTaxCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_account",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_tax_code",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+		return srv.(MServiceLedgerServer).DeleteTaxCode(ctx, req.(*DeleteTaxCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccountRequest)
+func _MServiceLedger_GetTaxCodesByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxCodesByOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteAccount(ctx, in)
+		return srv.(MServiceLedgerServer).GetTaxCodesByOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_account",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_tax_codes_by_organization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+		return srv.(MServiceLedgerServer).GetTaxCodesByOrganization(ctx, req.(*GetTaxCodesByOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetAccountById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountByIdRequest)
+func _MServiceLedger_GetTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetAccountById(ctx, in)
+		return srv.(MServiceLedgerServer).GetTaxReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_account_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_tax_report",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetAccountById(ctx, req.(*GetAccountByIdRequest))
+		return srv.(MServiceLedgerServer).GetTaxReport(ctx, req.(*GetTaxReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetAccountsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountsByOrganizationRequest)
+func _MServiceLedger_CreateFixedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFixedAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetAccountsByOrganization(ctx, in)
+		return srv.(MServiceLedgerServer).CreateFixedAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_accounts_by_organization",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_fixed_asset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetAccountsByOrganization(ctx, req.(*GetAccountsByOrganizationRequest))
+		return srv.(MServiceLedgerServer).CreateFixedAsset(ctx, req.(*CreateFixedAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionRequest)
+func _MServiceLedger_UpdateFixedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFixedAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).CreateTransaction(ctx, in)
+		return srv.(MServiceLedgerServer).UpdateFixedAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/create_transaction",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_fixed_asset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+		return srv.(MServiceLedgerServer).UpdateFixedAsset(ctx, req.(*UpdateFixedAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTransactionRequest)
+func _MServiceLedger_DeleteFixedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFixedAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).UpdateTransaction(ctx, in)
+		return srv.(MServiceLedgerServer).DeleteFixedAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/update_transaction",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_fixed_asset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+		return srv.(MServiceLedgerServer).DeleteFixedAsset(ctx, req.(*DeleteFixedAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTransactionRequest)
+func _MServiceLedger_GetFixedAssetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFixedAssetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).DeleteTransaction(ctx, in)
+		return srv.(MServiceLedgerServer).GetFixedAssetById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/delete_transaction",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_fixed_asset_by_id",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+		return srv.(MServiceLedgerServer).GetFixedAssetById(ctx, req.(*GetFixedAssetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetTransactionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByIdRequest)
+func _MServiceLedger_GetFixedAssetsByOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFixedAssetsByOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetTransactionById(ctx, in)
+		return srv.(MServiceLedgerServer).GetFixedAssetsByOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_fixed_assets_by_organization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetTransactionById(ctx, req.(*GetTransactionByIdRequest))
+		return srv.(MServiceLedgerServer).GetFixedAssetsByOrganization(ctx, req.(*GetFixedAssetsByOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetTransactionWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionWrapperByIdRequest)
+func _MServiceLedger_RunDepreciation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDepreciationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetTransactionWrapperById(ctx, in)
+		return srv.(MServiceLedgerServer).RunDepreciation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_wrapper_by_id",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/run_depreciation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetTransactionWrapperById(ctx, req.(*GetTransactionWrapperByIdRequest))
+		return srv.(MServiceLedgerServer).RunDepreciation(ctx, req.(*RunDepreciationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_GetTransactionWrappersByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionWrappersByDateRequest)
+func _MServiceLedger_DisposeFixedAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisposeFixedAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).GetTransactionWrappersByDate(ctx, in)
+		return srv.(MServiceLedgerServer).DisposeFixedAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_transaction_wrappers_by_date",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/dispose_fixed_asset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).GetTransactionWrappersByDate(ctx, req.(*GetTransactionWrappersByDateRequest))
+		return srv.(MServiceLedgerServer).DisposeFixedAsset(ctx, req.(*DisposeFixedAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceLedger_AddTransactionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTransactionDetailsRequest)
+func _MServiceLedger_GetDepreciationEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepreciationEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceLedgerServer).AddTransactionDetails(ctx, in)
+		return srv.(MServiceLedgerServer).GetDepreciationEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/add_transaction_details",
+		FullMethod: "/org.gaterace.mservice.ledger.MServiceLedger/get_depreciation_entries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceLedgerServer).AddTransactionDetails(ctx, req.(*AddTransactionDetailsRequest))
+		return srv.(MServiceLedgerServer).GetDepreciationEntries(ctx, req.(*GetDepreciationEntriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1309,6 +4845,422 @@ var MServiceLedger_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "add_transaction_details",
 			Handler:    _MServiceLedger_AddTransactionDetails_Handler,
 		},
+		{
+			MethodName: "get_trial_balance",
+			Handler:    _MServiceLedger_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "get_account_balance",
+			Handler:    _MServiceLedger_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "get_account_ledger",
+			Handler:    _MServiceLedger_GetAccountLedger_Handler,
+		},
+		{
+			MethodName: "get_balance_sheet",
+			Handler:    _MServiceLedger_GetBalanceSheet_Handler,
+		},
+		{
+			MethodName: "get_income_statement",
+			Handler:    _MServiceLedger_GetIncomeStatement_Handler,
+		},
+		{
+			MethodName: "create_fiscal_year",
+			Handler:    _MServiceLedger_CreateFiscalYear_Handler,
+		},
+		{
+			MethodName: "delete_fiscal_year",
+			Handler:    _MServiceLedger_DeleteFiscalYear_Handler,
+		},
+		{
+			MethodName: "get_fiscal_years_by_organization",
+			Handler:    _MServiceLedger_GetFiscalYearsByOrganization_Handler,
+		},
+		{
+			MethodName: "get_fiscal_periods_by_year",
+			Handler:    _MServiceLedger_GetFiscalPeriodsByYear_Handler,
+		},
+		{
+			MethodName: "update_fiscal_period_status",
+			Handler:    _MServiceLedger_UpdateFiscalPeriodStatus_Handler,
+		},
+		{
+			MethodName: "close_fiscal_year",
+			Handler:    _MServiceLedger_CloseFiscalYear_Handler,
+		},
+		{
+			MethodName: "reopen_fiscal_year",
+			Handler:    _MServiceLedger_ReopenFiscalYear_Handler,
+		},
+		{
+			MethodName: "reverse_transaction",
+			Handler:    _MServiceLedger_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "void_transaction",
+			Handler:    _MServiceLedger_VoidTransaction_Handler,
+		},
+		{
+			MethodName: "post_transaction",
+			Handler:    _MServiceLedger_PostTransaction_Handler,
+		},
+		{
+			MethodName: "post_journal_entry",
+			Handler:    _MServiceLedger_PostJournalEntry_Handler,
+		},
+		{
+			MethodName: "replace_transaction_details",
+			Handler:    _MServiceLedger_ReplaceTransactionDetails_Handler,
+		},
+		{
+			MethodName: "get_account_tree",
+			Handler:    _MServiceLedger_GetAccountTree_Handler,
+		},
+		{
+			MethodName: "create_account_code_range",
+			Handler:    _MServiceLedger_CreateAccountCodeRange_Handler,
+		},
+		{
+			MethodName: "update_account_code_range",
+			Handler:    _MServiceLedger_UpdateAccountCodeRange_Handler,
+		},
+		{
+			MethodName: "delete_account_code_range",
+			Handler:    _MServiceLedger_DeleteAccountCodeRange_Handler,
+		},
+		{
+			MethodName: "get_account_code_ranges_by_organization",
+			Handler:    _MServiceLedger_GetAccountCodeRangesByOrganization_Handler,
+		},
+		{
+			MethodName: "create_exchange_rate",
+			Handler:    _MServiceLedger_CreateExchangeRate_Handler,
+		},
+		{
+			MethodName: "update_exchange_rate",
+			Handler:    _MServiceLedger_UpdateExchangeRate_Handler,
+		},
+		{
+			MethodName: "delete_exchange_rate",
+			Handler:    _MServiceLedger_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "get_exchange_rates_by_organization",
+			Handler:    _MServiceLedger_GetExchangeRatesByOrganization_Handler,
+		},
+		{
+			MethodName: "revalue_foreign_balances",
+			Handler:    _MServiceLedger_RevalueForeignBalances_Handler,
+		},
+		{
+			MethodName: "create_dimension",
+			Handler:    _MServiceLedger_CreateDimension_Handler,
+		},
+		{
+			MethodName: "update_dimension",
+			Handler:    _MServiceLedger_UpdateDimension_Handler,
+		},
+		{
+			MethodName: "delete_dimension",
+			Handler:    _MServiceLedger_DeleteDimension_Handler,
+		},
+		{
+			MethodName: "get_dimensions_by_organization",
+			Handler:    _MServiceLedger_GetDimensionsByOrganization_Handler,
+		},
+		{
+			MethodName: "create_dimension_value",
+			Handler:    _MServiceLedger_CreateDimensionValue_Handler,
+		},
+		{
+			MethodName: "update_dimension_value",
+			Handler:    _MServiceLedger_UpdateDimensionValue_Handler,
+		},
+		{
+			MethodName: "delete_dimension_value",
+			Handler:    _MServiceLedger_DeleteDimensionValue_Handler,
+		},
+		{
+			MethodName: "get_dimension_balances",
+			Handler:    _MServiceLedger_GetDimensionBalances_Handler,
+		},
+		{
+			MethodName: "create_budget",
+			Handler:    _MServiceLedger_CreateBudget_Handler,
+		},
+		{
+			MethodName: "update_budget",
+			Handler:    _MServiceLedger_UpdateBudget_Handler,
+		},
+		{
+			MethodName: "delete_budget",
+			Handler:    _MServiceLedger_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "get_budgets_by_organization",
+			Handler:    _MServiceLedger_GetBudgetsByOrganization_Handler,
+		},
+		{
+			MethodName: "create_budget_amount",
+			Handler:    _MServiceLedger_CreateBudgetAmount_Handler,
+		},
+		{
+			MethodName: "update_budget_amount",
+			Handler:    _MServiceLedger_UpdateBudgetAmount_Handler,
+		},
+		{
+			MethodName: "delete_budget_amount",
+			Handler:    _MServiceLedger_DeleteBudgetAmount_Handler,
+		},
+		{
+			MethodName: "get_budget_amounts",
+			Handler:    _MServiceLedger_GetBudgetAmounts_Handler,
+		},
+		{
+			MethodName: "load_budget_amounts",
+			Handler:    _MServiceLedger_LoadBudgetAmounts_Handler,
+		},
+		{
+			MethodName: "get_budget_vs_actual",
+			Handler:    _MServiceLedger_GetBudgetVsActual_Handler,
+		},
+		{
+			MethodName: "create_recurring_entry",
+			Handler:    _MServiceLedger_CreateRecurringEntry_Handler,
+		},
+		{
+			MethodName: "update_recurring_entry",
+			Handler:    _MServiceLedger_UpdateRecurringEntry_Handler,
+		},
+		{
+			MethodName: "delete_recurring_entry",
+			Handler:    _MServiceLedger_DeleteRecurringEntry_Handler,
+		},
+		{
+			MethodName: "get_recurring_entry_by_id",
+			Handler:    _MServiceLedger_GetRecurringEntryById_Handler,
+		},
+		{
+			MethodName: "get_recurring_entries_by_organization",
+			Handler:    _MServiceLedger_GetRecurringEntriesByOrganization_Handler,
+		},
+		{
+			MethodName: "get_recurring_instances",
+			Handler:    _MServiceLedger_GetRecurringInstances_Handler,
+		},
+		{
+			MethodName: "create_intercompany_account",
+			Handler:    _MServiceLedger_CreateIntercompanyAccount_Handler,
+		},
+		{
+			MethodName: "update_intercompany_account",
+			Handler:    _MServiceLedger_UpdateIntercompanyAccount_Handler,
+		},
+		{
+			MethodName: "delete_intercompany_account",
+			Handler:    _MServiceLedger_DeleteIntercompanyAccount_Handler,
+		},
+		{
+			MethodName: "get_intercompany_accounts_by_organization",
+			Handler:    _MServiceLedger_GetIntercompanyAccountsByOrganization_Handler,
+		},
+		{
+			MethodName: "post_intercompany_entry",
+			Handler:    _MServiceLedger_PostIntercompanyEntry_Handler,
+		},
+		{
+			MethodName: "create_organization_group",
+			Handler:    _MServiceLedger_CreateOrganizationGroup_Handler,
+		},
+		{
+			MethodName: "update_organization_group",
+			Handler:    _MServiceLedger_UpdateOrganizationGroup_Handler,
+		},
+		{
+			MethodName: "delete_organization_group",
+			Handler:    _MServiceLedger_DeleteOrganizationGroup_Handler,
+		},
+		{
+			MethodName: "get_organization_groups",
+			Handler:    _MServiceLedger_GetOrganizationGroups_Handler,
+		},
+		{
+			MethodName: "create_organization_group_member",
+			Handler:    _MServiceLedger_CreateOrganizationGroupMember_Handler,
+		},
+		{
+			MethodName: "delete_organization_group_member",
+			Handler:    _MServiceLedger_DeleteOrganizationGroupMember_Handler,
+		},
+		{
+			MethodName: "get_organization_group_members",
+			Handler:    _MServiceLedger_GetOrganizationGroupMembers_Handler,
+		},
+		{
+			MethodName: "create_group_account_map",
+			Handler:    _MServiceLedger_CreateGroupAccountMap_Handler,
+		},
+		{
+			MethodName: "update_group_account_map",
+			Handler:    _MServiceLedger_UpdateGroupAccountMap_Handler,
+		},
+		{
+			MethodName: "delete_group_account_map",
+			Handler:    _MServiceLedger_DeleteGroupAccountMap_Handler,
+		},
+		{
+			MethodName: "get_group_account_maps",
+			Handler:    _MServiceLedger_GetGroupAccountMaps_Handler,
+		},
+		{
+			MethodName: "create_elimination_rule",
+			Handler:    _MServiceLedger_CreateEliminationRule_Handler,
+		},
+		{
+			MethodName: "update_elimination_rule",
+			Handler:    _MServiceLedger_UpdateEliminationRule_Handler,
+		},
+		{
+			MethodName: "delete_elimination_rule",
+			Handler:    _MServiceLedger_DeleteEliminationRule_Handler,
+		},
+		{
+			MethodName: "get_elimination_rules",
+			Handler:    _MServiceLedger_GetEliminationRules_Handler,
+		},
+		{
+			MethodName: "get_consolidated_trial_balance",
+			Handler:    _MServiceLedger_GetConsolidatedTrialBalance_Handler,
+		},
+		{
+			MethodName: "get_consolidated_balance_sheet",
+			Handler:    _MServiceLedger_GetConsolidatedBalanceSheet_Handler,
+		},
+		{
+			MethodName: "get_consolidated_income_statement",
+			Handler:    _MServiceLedger_GetConsolidatedIncomeStatement_Handler,
+		},
+		{
+			MethodName: "import_bank_statement",
+			Handler:    _MServiceLedger_ImportBankStatement_Handler,
+		},
+		{
+			MethodName: "auto_match_bank_statement",
+			Handler:    _MServiceLedger_AutoMatchBankStatement_Handler,
+		},
+		{
+			MethodName: "confirm_bank_match",
+			Handler:    _MServiceLedger_ConfirmBankMatch_Handler,
+		},
+		{
+			MethodName: "unmatch_bank_statement_line",
+			Handler:    _MServiceLedger_UnmatchBankStatementLine_Handler,
+		},
+		{
+			MethodName: "delete_bank_statement_line",
+			Handler:    _MServiceLedger_DeleteBankStatementLine_Handler,
+		},
+		{
+			MethodName: "get_bank_statement_lines",
+			Handler:    _MServiceLedger_GetBankStatementLines_Handler,
+		},
+		{
+			MethodName: "get_bank_reconciliation",
+			Handler:    _MServiceLedger_GetBankReconciliation_Handler,
+		},
+		{
+			MethodName: "create_control_account",
+			Handler:    _MServiceLedger_CreateControlAccount_Handler,
+		},
+		{
+			MethodName: "update_control_account",
+			Handler:    _MServiceLedger_UpdateControlAccount_Handler,
+		},
+		{
+			MethodName: "delete_control_account",
+			Handler:    _MServiceLedger_DeleteControlAccount_Handler,
+		},
+		{
+			MethodName: "get_control_accounts_by_organization",
+			Handler:    _MServiceLedger_GetControlAccountsByOrganization_Handler,
+		},
+		{
+			MethodName: "get_open_items",
+			Handler:    _MServiceLedger_GetOpenItems_Handler,
+		},
+		{
+			MethodName: "apply_open_item",
+			Handler:    _MServiceLedger_ApplyOpenItem_Handler,
+		},
+		{
+			MethodName: "delete_open_item_application",
+			Handler:    _MServiceLedger_DeleteOpenItemApplication_Handler,
+		},
+		{
+			MethodName: "get_open_item_applications",
+			Handler:    _MServiceLedger_GetOpenItemApplications_Handler,
+		},
+		{
+			MethodName: "get_party_balances",
+			Handler:    _MServiceLedger_GetPartyBalances_Handler,
+		},
+		{
+			MethodName: "get_party_aging",
+			Handler:    _MServiceLedger_GetPartyAging_Handler,
+		},
+		{
+			MethodName: "create_tax_code",
+			Handler:    _MServiceLedger_CreateTaxCode_Handler,
+		},
+		{
+			MethodName: "update_tax_code",
+			Handler:    _MServiceLedger_UpdateTaxCode_Handler,
+		},
+		{
+			MethodName: "delete_tax_code",
+			Handler:    _MServiceLedger_DeleteTaxCode_Handler,
+		},
+		{
+			MethodName: "get_tax_codes_by_organization",
+			Handler:    _MServiceLedger_GetTaxCodesByOrganization_Handler,
+		},
+		{
+			MethodName: "get_tax_report",
+			Handler:    _MServiceLedger_GetTaxReport_Handler,
+		},
+		{
+			MethodName: "create_fixed_asset",
+			Handler:    _MServiceLedger_CreateFixedAsset_Handler,
+		},
+		{
+			MethodName: "update_fixed_asset",
+			Handler:    _MServiceLedger_UpdateFixedAsset_Handler,
+		},
+		{
+			MethodName: "delete_fixed_asset",
+			Handler:    _MServiceLedger_DeleteFixedAsset_Handler,
+		},
+		{
+			MethodName: "get_fixed_asset_by_id",
+			Handler:    _MServiceLedger_GetFixedAssetById_Handler,
+		},
+		{
+			MethodName: "get_fixed_assets_by_organization",
+			Handler:    _MServiceLedger_GetFixedAssetsByOrganization_Handler,
+		},
+		{
+			MethodName: "run_depreciation",
+			Handler:    _MServiceLedger_RunDepreciation_Handler,
+		},
+		{
+			MethodName: "dispose_fixed_asset",
+			Handler:    _MServiceLedger_DisposeFixedAsset_Handler,
+		},
+		{
+			MethodName: "get_depreciation_entries",
+			Handler:    _MServiceLedger_GetDepreciationEntries_Handler,
+		},
 		{
 			MethodName: "get_server_version",
 			Handler:    _MServiceLedger_GetServerVersion_Handler,
@@ -28,9 +28,15 @@ CREATE TABLE tb_GLAccount
     chvAccountDescription VARCHAR(255) NOT NULL,
     -- general ledger account type identifier
     intAccountTypeId INT NOT NULL,
+    -- parent general ledger account unique identifier, null for a top level account
+    uidParentAccountId BINARY(16) NULL,
+    -- general ledger account code, digits with optional dash separated segments
+    chvAccountCode VARCHAR(32) NULL,
 
 
     PRIMARY KEY (uidGlAccountId),
-    UNIQUE (uidOrganizationId,chvAccountName)
+    UNIQUE (uidOrganizationId,chvAccountName),
+    UNIQUE (uidOrganizationId,chvAccountCode),
+    INDEX (uidParentAccountId)
 ) ENGINE=InnoDB;
 
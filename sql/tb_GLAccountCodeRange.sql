@@ -0,0 +1,33 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLAccountCodeRange;
+
+-- MService general ledger account code range entity
+CREATE TABLE tb_GLAccountCodeRange
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- general ledger account category
+    intAccountCategory INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- lowest leading segment of account codes in the category
+    inbRangeStart BIGINT NOT NULL,
+    -- highest leading segment of account codes in the category
+    inbRangeEnd BIGINT NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,intAccountCategory)
+) ENGINE=InnoDB;
+
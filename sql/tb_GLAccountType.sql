@@ -22,6 +22,10 @@ CREATE TABLE tb_GLAccountType
     intVersion INT NOT NULL,
     -- general ledger account type
     chvAccountType VARCHAR(255) NOT NULL,
+    -- general ledger account category
+    intAccountCategory INT NOT NULL,
+    -- normal balance side of account type
+    intNormalBalance INT NOT NULL,
 
 
     PRIMARY KEY (inbMserviceId,intAccountTypeId)
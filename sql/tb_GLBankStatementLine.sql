@@ -0,0 +1,43 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLBankStatementLine;
+
+-- MService general ledger bank statement line entity
+CREATE TABLE tb_GLBankStatementLine
+(
+
+    -- bank statement line unique identifier
+    inbStatementLineId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- date the bank booked the line
+    dtmStatementDate DATETIME NOT NULL,
+    -- line amount, positive for money received
+    decAmount DECIMAL(19,2) NOT NULL,
+    -- reference of the line assigned by the bank
+    chvReference VARCHAR(64) NOT NULL,
+    -- line description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- reconciliation state of the line
+    intMatchStatus INT NOT NULL,
+    -- general ledger transaction unique identifier of the matched detail
+    inbGlTransactionId BIGINT NULL,
+    -- transaction detail sequence number of the matched detail
+    intSequenceNumber INT NULL,
+    -- date the match was confirmed
+    dtmReconciled DATETIME NULL,
+
+
+    PRIMARY KEY (inbStatementLineId),
+    UNIQUE (uidGlAccountId,chvReference),
+    INDEX (inbGlTransactionId,intSequenceNumber)
+) ENGINE=InnoDB;
+
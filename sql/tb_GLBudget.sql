@@ -0,0 +1,33 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLBudget;
+
+-- MService general ledger budget entity
+CREATE TABLE tb_GLBudget
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- budget name
+    chvBudgetName VARCHAR(32) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- fiscal year identifier
+    intFiscalYear INT NOT NULL,
+    -- budget description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvBudgetName)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,35 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLBudgetAmount;
+
+-- MService general ledger budget amount entity
+CREATE TABLE tb_GLBudgetAmount
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- budget name
+    chvBudgetName VARCHAR(32) NOT NULL,
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- fiscal period number within year
+    intPeriodNumber INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- budgeted amount, positive on the normal side of the account category
+    decAmount DECIMAL(19,2) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvBudgetName,uidGlAccountId,intPeriodNumber)
+) ENGINE=InnoDB;
+
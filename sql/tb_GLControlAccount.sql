@@ -0,0 +1,32 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLControlAccount;
+
+-- MService general ledger control account entity
+CREATE TABLE tb_GLControlAccount
+(
+
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- subledger kept for the account
+    intSubledgerType INT NOT NULL,
+
+
+    PRIMARY KEY (uidGlAccountId),
+    INDEX (uidOrganizationId)
+) ENGINE=InnoDB;
+
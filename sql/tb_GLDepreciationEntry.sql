@@ -0,0 +1,23 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLDepreciationEntry;
+
+-- MService general ledger depreciation entry, the depreciation of a fixed asset posted by one transaction
+CREATE TABLE tb_GLDepreciationEntry
+(
+
+    -- fixed asset unique identifier
+    inbFixedAssetId BIGINT NOT NULL,
+    -- last date the depreciation is for
+    dtmDepreciationDate DATETIME NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- depreciation amount
+    decAmount DECIMAL(19,2) NOT NULL,
+    -- general ledger transaction unique identifier
+    inbGlTransactionId BIGINT NOT NULL,
+
+
+    PRIMARY KEY (inbFixedAssetId,dtmDepreciationDate)
+) ENGINE=InnoDB;
+
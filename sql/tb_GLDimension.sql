@@ -0,0 +1,31 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLDimension;
+
+-- MService general ledger analytical dimension entity
+CREATE TABLE tb_GLDimension
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- dimension name
+    chvDimensionName VARCHAR(32) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- dimension description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvDimensionName)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,33 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLDimensionValue;
+
+-- MService general ledger analytical dimension value entity
+CREATE TABLE tb_GLDimensionValue
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- dimension name
+    chvDimensionName VARCHAR(32) NOT NULL,
+    -- dimension value
+    chvDimensionValue VARCHAR(32) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- dimension value description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvDimensionName,chvDimensionValue)
+) ENGINE=InnoDB;
+
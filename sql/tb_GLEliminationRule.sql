@@ -0,0 +1,40 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLEliminationRule;
+
+-- MService general ledger consolidation elimination rule entity
+CREATE TABLE tb_GLEliminationRule
+(
+
+    -- elimination rule unique identifier
+    inbEliminationRuleId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization group name
+    chvGroupName VARCHAR(32) NOT NULL,
+    -- elimination rule description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- partner organization unique identifier
+    uidPartnerOrganizationId BINARY(16) NOT NULL,
+    -- general ledger account of the partner organization offsetting the account
+    uidPartnerAccountId BINARY(16) NOT NULL,
+
+
+    PRIMARY KEY (inbEliminationRuleId),
+    INDEX (inbMserviceId,chvGroupName)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,33 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLExchangeRate;
+
+-- MService general ledger exchange rate entity
+CREATE TABLE tb_GLExchangeRate
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- foreign currency code
+    chvCurrencyCode CHAR(3) NOT NULL,
+    -- date the exchange rate takes effect
+    dtmEffectiveDate DATETIME NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- base currency units for one unit of the foreign currency
+    decRate DECIMAL(19,8) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvCurrencyCode,dtmEffectiveDate)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,36 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLFiscalPeriod;
+
+-- MService general ledger fiscal period entity
+CREATE TABLE tb_GLFiscalPeriod
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- fiscal year identifier
+    intFiscalYear INT NOT NULL,
+    -- fiscal period number within year
+    intPeriodNumber INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- fiscal period name
+    chvPeriodName VARCHAR(32) NOT NULL,
+    -- starting date of fiscal period
+    dtmStartDate DATETIME NOT NULL,
+    -- ending date of fiscal period
+    dtmEndDate DATETIME NOT NULL,
+    -- posting status of fiscal period
+    intPeriodStatus INT NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,intFiscalYear,intPeriodNumber),
+    INDEX (uidOrganizationId,dtmStartDate)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,35 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLFiscalYear;
+
+-- MService general ledger fiscal year entity
+CREATE TABLE tb_GLFiscalYear
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- fiscal year identifier
+    intFiscalYear INT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- starting date of fiscal year
+    dtmStartDate DATETIME NOT NULL,
+    -- ending date of fiscal year
+    dtmEndDate DATETIME NOT NULL,
+    -- layout of fiscal periods
+    intCalendarType INT NOT NULL,
+    -- has fiscal year been closed?
+    bitIsClosed BOOL NOT NULL,
+    -- identifier of year end closing transaction
+    inbClosingTransactionId BIGINT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,intFiscalYear)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,64 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLFixedAsset;
+
+-- MService general ledger fixed asset entity
+CREATE TABLE tb_GLFixedAsset
+(
+
+    -- fixed asset unique identifier
+    inbFixedAssetId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- fixed asset name
+    chvAssetName VARCHAR(64) NOT NULL,
+    -- fixed asset description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- date the asset was acquired, depreciation starts on it
+    dtmAcquisitionDate DATETIME NOT NULL,
+    -- acquisition cost of the asset
+    decAcquisitionCost DECIMAL(19,2) NOT NULL,
+    -- value of the asset at the end of its useful life
+    decSalvageValue DECIMAL(19,2) NOT NULL,
+    -- useful life of the asset in months
+    intUsefulLifeMonths INT NOT NULL,
+    -- method of spreading the cost of the asset over its useful life
+    intDepreciationMethod INT NOT NULL,
+    -- multiple of the straight line rate for declining balance
+    decDecliningFactor DECIMAL(9,4) NOT NULL,
+    -- general ledger account unique identifier of the asset cost
+    uidAssetAccountId BINARY(16) NOT NULL,
+    -- general ledger account unique identifier of the accumulated depreciation
+    uidAccumulatedAccountId BINARY(16) NOT NULL,
+    -- general ledger account unique identifier of the depreciation expense
+    uidExpenseAccountId BINARY(16) NOT NULL,
+    -- depreciation posted so far
+    decAccumulatedDepreciation DECIMAL(19,2) NOT NULL,
+    -- last date depreciation has been posted for, null before the first run
+    dtmDepreciatedThrough DATETIME NULL,
+    -- state of the asset in the register
+    intAssetStatus INT NOT NULL,
+    -- date the asset was disposed of
+    dtmDisposalDate DATETIME NULL,
+    -- amount received for the asset
+    decDisposalProceeds DECIMAL(19,2) NULL,
+    -- general ledger transaction unique identifier of the disposal
+    inbDisposalTransactionId BIGINT NULL,
+
+
+    PRIMARY KEY (inbFixedAssetId),
+    INDEX (uidOrganizationId)
+) ENGINE=InnoDB;
+
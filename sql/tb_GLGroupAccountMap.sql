@@ -0,0 +1,35 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLGroupAccountMap;
+
+-- MService general ledger group chart of accounts mapping entity
+CREATE TABLE tb_GLGroupAccountMap
+(
+
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization group name
+    chvGroupName VARCHAR(32) NOT NULL,
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- account code in the group chart of accounts
+    chvGroupAccountCode VARCHAR(32) NOT NULL,
+    -- account name in the group chart of accounts
+    chvGroupAccountName VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,chvGroupName,uidGlAccountId)
+) ENGINE=InnoDB;
+
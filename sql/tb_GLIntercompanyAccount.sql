@@ -0,0 +1,33 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLIntercompanyAccount;
+
+-- MService general ledger intercompany account entity
+CREATE TABLE tb_GLIntercompanyAccount
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- partner organization unique identifier
+    uidPartnerOrganizationId BINARY(16) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- account for amounts due from the partner organization
+    uidDueFromAccountId BINARY(16) NOT NULL,
+    -- account for amounts due to the partner organization
+    uidDueToAccountId BINARY(16) NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,uidPartnerOrganizationId)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,48 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLOpenItemApplication;
+
+-- MService general ledger application of a credit open item against a charge open item
+CREATE TABLE tb_GLOpenItemApplication
+(
+
+    -- open item application unique identifier
+    inbApplicationId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- general ledger control account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- transaction party identifier
+    inbPartyId BIGINT NOT NULL,
+    -- general ledger transaction unique identifier of the charge
+    inbChargeTransactionId BIGINT NOT NULL,
+    -- transaction detail sequence number of the charge
+    intChargeSequenceNumber INT NOT NULL,
+    -- general ledger transaction unique identifier of the credit
+    inbCreditTransactionId BIGINT NOT NULL,
+    -- transaction detail sequence number of the credit
+    intCreditSequenceNumber INT NOT NULL,
+    -- amount applied
+    decAmount DECIMAL(19,2) NOT NULL,
+    -- date the application takes effect, the later of the two transaction dates
+    dtmApplied DATETIME NOT NULL,
+
+
+    PRIMARY KEY (inbApplicationId),
+    INDEX (inbChargeTransactionId,intChargeSequenceNumber),
+    INDEX (inbCreditTransactionId,intCreditSequenceNumber),
+    INDEX (uidGlAccountId,inbPartyId)
+) ENGINE=InnoDB;
+
@@ -26,6 +26,14 @@ CREATE TABLE tb_GLOrganization
     dtmFromDate DATETIME NOT NULL,
     -- ending date for organization books
     dtmToDate DATETIME NULL,
+    -- account receiving net income at year end close
+    uidRetainedEarningsAccountId BINARY(16) NULL,
+    -- currency of organization books
+    chvBaseCurrency CHAR(3) NULL,
+    -- account receiving unrealized foreign exchange gains
+    uidFxGainAccountId BINARY(16) NULL,
+    -- account receiving unrealized foreign exchange losses
+    uidFxLossAccountId BINARY(16) NULL,
 
 
     PRIMARY KEY (uidOrganizationId),
@@ -0,0 +1,29 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLOrganizationGroup;
+
+-- MService general ledger organization group entity
+CREATE TABLE tb_GLOrganizationGroup
+(
+
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization group name
+    chvGroupName VARCHAR(32) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- organization group description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,chvGroupName)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,51 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLRecurringEntry;
+
+-- MService general ledger recurring journal entry entity
+CREATE TABLE tb_GLRecurringEntry
+(
+
+    -- recurring journal entry unique identifier
+    inbRecurringEntryId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- transaction description of generated transactions
+    chvTransactionDescription VARCHAR(255) NOT NULL,
+    -- general ledger transaction type identifier
+    intTransactionTypeId INT NOT NULL,
+    -- identifier of transaction from party
+    inbFromPartyId BIGINT NULL,
+    -- identifier of transaction to party
+    inbToPartyId BIGINT NULL,
+    -- how often the entry falls due
+    intFrequency INT NOT NULL,
+    -- day of month the entry falls due
+    intDayOfMonth INT NOT NULL,
+    -- date of the first possible occurrence
+    dtmStartDate DATETIME NOT NULL,
+    -- date of the last possible occurrence
+    dtmEndDate DATETIME NULL,
+    -- date of the next occurrence to generate, null when the schedule has ended
+    dtmNextDate DATETIME NULL,
+    -- why the last attempt to generate the next occurrence failed
+    chvLastError VARCHAR(255) NULL,
+
+
+    PRIMARY KEY (inbRecurringEntryId),
+    INDEX (uidOrganizationId),
+    INDEX (dtmNextDate)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,29 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLRecurringEntryDetail;
+
+-- MService general ledger recurring journal entry template detail
+CREATE TABLE tb_GLRecurringEntryDetail
+(
+
+    -- recurring journal entry unique identifier
+    inbRecurringEntryId BIGINT NOT NULL,
+    -- transaction detail sequence number
+    intSequenceNumber INT NOT NULL,
+    -- general ledger account unique identifier
+    uidGlAccountId BINARY(16) NOT NULL,
+    -- transaction detail amount, converted from the foreign amount when null
+    decAmount DECIMAL(19,2) NULL,
+    -- transaction detail is debit (true) else credit
+    bitIsDebit BOOL NOT NULL,
+    -- foreign currency code of the detail, base currency when null
+    chvCurrencyCode CHAR(3) NULL,
+    -- transaction detail amount in the foreign currency
+    decForeignAmount DECIMAL(19,2) NULL,
+    -- tax code of the detail, its tax line is generated when the entry is posted
+    chvTaxCode VARCHAR(32) NULL,
+
+
+    PRIMARY KEY (inbRecurringEntryId,intSequenceNumber)
+) ENGINE=InnoDB;
+
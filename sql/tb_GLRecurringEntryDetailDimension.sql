@@ -0,0 +1,21 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLRecurringEntryDetailDimension;
+
+-- MService general ledger recurring journal entry template detail dimension value
+CREATE TABLE tb_GLRecurringEntryDetailDimension
+(
+
+    -- recurring journal entry unique identifier
+    inbRecurringEntryId BIGINT NOT NULL,
+    -- transaction detail sequence number
+    intSequenceNumber INT NOT NULL,
+    -- dimension name
+    chvDimensionName VARCHAR(32) NOT NULL,
+    -- dimension value
+    chvDimensionValue VARCHAR(32) NOT NULL,
+
+
+    PRIMARY KEY (inbRecurringEntryId,intSequenceNumber,chvDimensionName)
+) ENGINE=InnoDB;
+
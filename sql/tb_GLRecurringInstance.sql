@@ -0,0 +1,21 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLRecurringInstance;
+
+-- MService general ledger recurring journal entry generated occurrence
+CREATE TABLE tb_GLRecurringInstance
+(
+
+    -- recurring journal entry unique identifier
+    inbRecurringEntryId BIGINT NOT NULL,
+    -- date the occurrence falls due
+    dtmScheduledDate DATETIME NOT NULL,
+    -- date the transaction was generated
+    dtmCreated DATETIME NOT NULL,
+    -- identifier of the generated transaction
+    inbGlTransactionId BIGINT NOT NULL,
+
+
+    PRIMARY KEY (inbRecurringEntryId,dtmScheduledDate)
+) ENGINE=InnoDB;
+
@@ -0,0 +1,37 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLTaxCode;
+
+-- MService general ledger tax code entity
+CREATE TABLE tb_GLTaxCode
+(
+
+    -- organization unique identifier
+    uidOrganizationId BINARY(16) NOT NULL,
+    -- tax code, such as VAT20
+    chvTaxCode VARCHAR(32) NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- MService account id
+    inbMserviceId BIGINT NOT NULL,
+    -- tax code description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- tax rate in percent of the taxable amount
+    decTaxRate DECIMAL(9,4) NOT NULL,
+    -- general ledger account unique identifier of the tax lines
+    uidTaxAccountId BINARY(16) NOT NULL,
+    -- whether tax on the code is recoverable or payable
+    intTaxType INT NOT NULL,
+
+
+    PRIMARY KEY (uidOrganizationId,chvTaxCode)
+) ENGINE=InnoDB;
+
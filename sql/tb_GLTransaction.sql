@@ -36,9 +36,30 @@ CREATE TABLE tb_GLTransaction
     chvPostedViaKey VARCHAR(64) NULL,
     -- date posted on external system
     dtmPostedViaDate DATETIME NULL,
+    -- is this a year end closing entry?
+    bitIsClosingEntry BOOL NOT NULL,
+    -- lifecycle status of transaction
+    intTransactionStatus INT NOT NULL,
+    -- date transaction was posted
+    dtmPosted DATETIME NULL,
+    -- date transaction was voided
+    dtmVoided DATETIME NULL,
+    -- identifier of transaction reversed by this transaction
+    inbReversesTransactionId BIGINT NULL,
+    -- identifier of transaction reversing this transaction
+    inbReversedByTransactionId BIGINT NULL,
+    -- when the transaction is reversed automatically
+    intAutoReverseMode INT NOT NULL,
+    -- date of the automatic reversal
+    dtmAutoReverseDate DATETIME NULL,
+    -- identifier of the matching transaction in the partner organization of an intercompany entry
+    inbIntercompanyTransactionId BIGINT NULL,
+    -- date payment of the open items of the transaction is due, null when due on the transaction date
+    dtmDueDate DATETIME NULL,
 
 
     PRIMARY KEY (inbGlTransactionId),
-    INDEX (uidOrganizationId,dtmTransactionDate)
+    INDEX (uidOrganizationId,dtmTransactionDate),
+    INDEX (dtmAutoReverseDate)
 ) ENGINE=InnoDB;
 
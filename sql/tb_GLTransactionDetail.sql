@@ -16,6 +16,14 @@ CREATE TABLE tb_GLTransactionDetail
     decAmount DECIMAL(19,2) NOT NULL,
     -- transaction detail is debit (true) else credit
     bitIsDebit BOOL NOT NULL,
+    -- foreign currency code of the detail, base currency when null
+    chvCurrencyCode CHAR(3) NULL,
+    -- transaction detail amount in the foreign currency
+    decForeignAmount DECIMAL(19,2) NULL,
+    -- tax code of a detail entered net of tax, or of the tax line generated for it
+    chvTaxCode VARCHAR(32) NULL,
+    -- transaction detail is a tax line generated from the tax code of another detail
+    bitIsTaxLine BOOL NOT NULL DEFAULT 0,
 
 
     PRIMARY KEY (inbGlTransactionId,intSequenceNumber)
@@ -0,0 +1,22 @@
+use mledger;
+
+DROP TABLE IF EXISTS tb_GLTransactionDetailDimension;
+
+-- MService general ledger transaction detail dimension value entity
+CREATE TABLE tb_GLTransactionDetailDimension
+(
+
+    -- general ledger transaction unique identifier
+    inbGlTransactionId BIGINT NOT NULL,
+    -- transaction detail sequence number
+    intSequenceNumber INT NOT NULL,
+    -- dimension name
+    chvDimensionName VARCHAR(32) NOT NULL,
+    -- dimension value
+    chvDimensionValue VARCHAR(32) NOT NULL,
+
+
+    PRIMARY KEY (inbGlTransactionId,intSequenceNumber,chvDimensionName),
+    INDEX (chvDimensionName,chvDimensionValue)
+) ENGINE=InnoDB;
+
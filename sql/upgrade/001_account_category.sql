@@ -0,0 +1,9 @@
+use mledger;
+
+-- classify general ledger account types for financial reports;
+-- existing rows are unclassified (0) until updated with update_account_type
+ALTER TABLE tb_GLAccountType
+    ADD COLUMN intAccountCategory INT NOT NULL DEFAULT 0 AFTER chvAccountType;
+
+ALTER TABLE tb_GLAccountType
+    ALTER COLUMN intAccountCategory DROP DEFAULT;
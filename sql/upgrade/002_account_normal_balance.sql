@@ -0,0 +1,39 @@
+use mledger;
+
+-- add the normal balance side to general ledger account types
+ALTER TABLE tb_GLAccountType
+    ADD COLUMN intNormalBalance INT NOT NULL DEFAULT 0 AFTER intAccountCategory;
+
+-- best effort classification of account types not yet assigned a category,
+-- based on the free text account type
+-- 1 asset, 2 liability, 3 equity, 4 revenue, 5 expense, 6 contra asset
+UPDATE tb_GLAccountType SET intAccountCategory =
+    CASE
+        WHEN LOWER(chvAccountType) LIKE '%accumulated%' THEN 6
+        WHEN LOWER(chvAccountType) LIKE '%contra%asset%' THEN 6
+        WHEN LOWER(chvAccountType) LIKE '%asset%' THEN 1
+        WHEN LOWER(chvAccountType) LIKE '%liabilit%' THEN 2
+        WHEN LOWER(chvAccountType) LIKE '%equity%' THEN 3
+        WHEN LOWER(chvAccountType) LIKE '%expense%' THEN 5
+        WHEN LOWER(chvAccountType) LIKE '%revenue%' THEN 4
+        WHEN LOWER(chvAccountType) LIKE '%income%' THEN 4
+        ELSE 0
+    END
+WHERE intAccountCategory = 0;
+
+-- normal balance follows from the category: 1 debit, 2 credit
+UPDATE tb_GLAccountType SET intNormalBalance =
+    CASE
+        WHEN intAccountCategory IN (1, 5, 7, 8, 9) THEN 1
+        WHEN intAccountCategory IN (2, 3, 4, 6, 10) THEN 2
+        ELSE 0
+    END;
+
+ALTER TABLE tb_GLAccountType
+    ALTER COLUMN intNormalBalance DROP DEFAULT;
+
+-- account types listed here could not be classified and must be
+-- updated with update_account_type before running financial reports
+SELECT inbMserviceId, intAccountTypeId, chvAccountType
+FROM tb_GLAccountType
+WHERE intAccountCategory = 0 AND bitIsDeleted = 0;
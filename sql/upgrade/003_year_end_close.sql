@@ -0,0 +1,20 @@
+use mledger;
+
+-- account receiving net income at year end close, set with update_organization
+ALTER TABLE tb_GLOrganization
+    ADD COLUMN uidRetainedEarningsAccountId BINARY(16) NULL AFTER dtmToDate;
+
+-- year end close status of fiscal years
+ALTER TABLE tb_GLFiscalYear
+    ADD COLUMN bitIsClosed BOOL NOT NULL DEFAULT 0 AFTER intCalendarType,
+    ADD COLUMN inbClosingTransactionId BIGINT NULL AFTER bitIsClosed;
+
+ALTER TABLE tb_GLFiscalYear
+    ALTER COLUMN bitIsClosed DROP DEFAULT;
+
+-- closing entries are left out of the income statement
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN bitIsClosingEntry BOOL NOT NULL DEFAULT 0 AFTER dtmPostedViaDate;
+
+ALTER TABLE tb_GLTransaction
+    ALTER COLUMN bitIsClosingEntry DROP DEFAULT;
@@ -0,0 +1,17 @@
+use mledger;
+
+-- void status and reversal links of general ledger transactions
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN bitIsVoid BOOL NOT NULL DEFAULT 0 AFTER bitIsClosingEntry,
+    ADD COLUMN dtmVoided DATETIME NULL AFTER bitIsVoid,
+    ADD COLUMN inbReversesTransactionId BIGINT NULL AFTER dtmVoided,
+    ADD COLUMN inbReversedByTransactionId BIGINT NULL AFTER inbReversesTransactionId;
+
+ALTER TABLE tb_GLTransaction
+    ALTER COLUMN bitIsVoid DROP DEFAULT;
+
+-- transactions deleted along with their details become visible again as void,
+-- which leaves account balances unchanged
+UPDATE tb_GLTransaction AS t SET t.bitIsDeleted = 0, t.bitIsVoid = 1, t.dtmVoided = t.dtmDeleted
+WHERE t.bitIsDeleted = 1
+AND EXISTS (SELECT 1 FROM tb_GLTransactionDetail AS d WHERE d.inbGlTransactionId = t.inbGlTransactionId);
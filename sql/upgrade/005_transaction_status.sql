@@ -0,0 +1,28 @@
+use mledger;
+
+-- draft, posted and void lifecycle of general ledger transactions
+-- 1 draft, 2 posted, 3 void
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN intTransactionStatus INT NOT NULL DEFAULT 0 AFTER bitIsClosingEntry,
+    ADD COLUMN dtmPosted DATETIME NULL AFTER intTransactionStatus;
+
+-- existing transactions with details count in balances, so they are posted;
+-- those still waiting for details stay drafts
+UPDATE tb_GLTransaction AS t SET
+    t.intTransactionStatus =
+        CASE
+            WHEN t.bitIsVoid = 1 THEN 3
+            WHEN EXISTS (SELECT 1 FROM tb_GLTransactionDetail AS d WHERE d.inbGlTransactionId = t.inbGlTransactionId) THEN 2
+            ELSE 1
+        END,
+    t.dtmPosted =
+        CASE
+            WHEN EXISTS (SELECT 1 FROM tb_GLTransactionDetail AS d WHERE d.inbGlTransactionId = t.inbGlTransactionId) THEN t.dtmModified
+            ELSE NULL
+        END;
+
+ALTER TABLE tb_GLTransaction
+    ALTER COLUMN intTransactionStatus DROP DEFAULT;
+
+ALTER TABLE tb_GLTransaction
+    DROP COLUMN bitIsVoid;
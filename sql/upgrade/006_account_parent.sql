@@ -0,0 +1,6 @@
+use mledger;
+
+-- parent account, so the chart of accounts forms a tree
+ALTER TABLE tb_GLAccount
+    ADD COLUMN uidParentAccountId BINARY(16) NULL AFTER intAccountTypeId,
+    ADD INDEX (uidParentAccountId);
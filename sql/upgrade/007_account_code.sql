@@ -0,0 +1,8 @@
+use mledger;
+
+-- account code alongside the account name, unique within the organization when set
+ALTER TABLE tb_GLAccount
+    ADD COLUMN chvAccountCode VARCHAR(32) NULL AFTER uidParentAccountId,
+    ADD UNIQUE (uidOrganizationId,chvAccountCode);
+
+-- account code ranges are kept in tb_GLAccountCodeRange, created by running tb_GLAccountCodeRange.sql
@@ -0,0 +1,12 @@
+use mledger;
+
+-- currency of organization books, needed before details can be entered in a foreign currency
+ALTER TABLE tb_GLOrganization
+    ADD COLUMN chvBaseCurrency CHAR(3) NULL AFTER uidRetainedEarningsAccountId;
+
+-- foreign currency and amount of a detail, decAmount stays in the base currency
+ALTER TABLE tb_GLTransactionDetail
+    ADD COLUMN chvCurrencyCode CHAR(3) NULL AFTER bitIsDebit,
+    ADD COLUMN decForeignAmount DECIMAL(19,2) NULL AFTER chvCurrencyCode;
+
+-- exchange rates are kept in tb_GLExchangeRate, created by running tb_GLExchangeRate.sql
@@ -0,0 +1,6 @@
+use mledger;
+
+-- accounts receiving unrealized foreign exchange gains and losses from a revaluation run
+ALTER TABLE tb_GLOrganization
+    ADD COLUMN uidFxGainAccountId BINARY(16) NULL AFTER chvBaseCurrency,
+    ADD COLUMN uidFxLossAccountId BINARY(16) NULL AFTER uidFxGainAccountId;
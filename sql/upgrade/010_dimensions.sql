@@ -0,0 +1,4 @@
+use mledger;
+
+-- analytical dimensions are kept in tb_GLDimension, tb_GLDimensionValue and tb_GLTransactionDetailDimension,
+-- created by running tb_GLDimension.sql, tb_GLDimensionValue.sql and tb_GLTransactionDetailDimension.sql
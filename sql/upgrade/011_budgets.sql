@@ -0,0 +1,4 @@
+use mledger;
+
+-- budgets are kept in tb_GLBudget and tb_GLBudgetAmount,
+-- created by running tb_GLBudget.sql and tb_GLBudgetAmount.sql
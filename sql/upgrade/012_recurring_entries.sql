@@ -0,0 +1,4 @@
+use mledger;
+
+-- recurring journal entries are kept in tb_GLRecurringEntry, tb_GLRecurringEntryDetail,
+-- tb_GLRecurringEntryDetailDimension and tb_GLRecurringInstance, created by running their tb_*.sql scripts
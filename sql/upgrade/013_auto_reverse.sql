@@ -0,0 +1,10 @@
+use mledger;
+
+-- auto-reversing transactions, reversed when posted or by the server scheduler on the reversal date
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN intAutoReverseMode INT NOT NULL DEFAULT 0 AFTER inbReversedByTransactionId,
+    ADD COLUMN dtmAutoReverseDate DATETIME NULL AFTER intAutoReverseMode,
+    ADD INDEX (dtmAutoReverseDate);
+
+ALTER TABLE tb_GLTransaction
+    ALTER COLUMN intAutoReverseMode DROP DEFAULT;
@@ -0,0 +1,13 @@
+use mledger;
+
+-- link between the two transactions of an intercompany entry
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN inbIntercompanyTransactionId BIGINT NULL AFTER dtmAutoReverseDate;
+
+-- due to and due from accounts are kept in tb_GLIntercompanyAccount, created by running tb_GLIntercompanyAccount.sql
+
+-- transaction details posted to an account of another organization can be found with
+-- SELECT t.inbGlTransactionId, d.intSequenceNumber FROM tb_GLTransaction AS t
+-- JOIN tb_GLTransactionDetail AS d ON t.inbGlTransactionId = d.inbGlTransactionId
+-- JOIN tb_GLAccount AS a ON d.uidGlAccountId = a.uidGlAccountId
+-- WHERE t.uidOrganizationId <> a.uidOrganizationId;
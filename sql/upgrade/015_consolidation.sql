@@ -0,0 +1,4 @@
+use mledger;
+
+-- organization groups for consolidated reports are kept in tb_GLOrganizationGroup, tb_GLOrganizationGroupMember,
+-- tb_GLGroupAccountMap and tb_GLEliminationRule, created by running their tb_*.sql scripts
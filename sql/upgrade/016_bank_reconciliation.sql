@@ -0,0 +1,3 @@
+use mledger;
+
+-- imported bank statement lines are kept in tb_GLBankStatementLine, created by running tb_GLBankStatementLine.sql
@@ -0,0 +1,4 @@
+use mledger;
+
+-- receivable and payable control accounts are kept in tb_GLControlAccount, created by running tb_GLControlAccount.sql
+-- applications of payments against open items are kept in tb_GLOpenItemApplication, created by running tb_GLOpenItemApplication.sql
@@ -0,0 +1,5 @@
+use mledger;
+
+-- due date of the open items of a transaction, aged by the party aging report
+ALTER TABLE tb_GLTransaction
+    ADD COLUMN dtmDueDate DATETIME NULL AFTER inbIntercompanyTransactionId;
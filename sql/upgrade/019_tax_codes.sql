@@ -0,0 +1,12 @@
+use mledger;
+
+-- tax codes are kept in tb_GLTaxCode, created by running tb_GLTaxCode.sql
+
+-- tax code of a detail entered net of tax, and the flag of the tax line generated for it
+ALTER TABLE tb_GLTransactionDetail
+    ADD COLUMN chvTaxCode VARCHAR(32) NULL AFTER decForeignAmount,
+    ADD COLUMN bitIsTaxLine BOOL NOT NULL DEFAULT 0 AFTER chvTaxCode;
+
+-- tax code of a recurring entry template detail, its tax line is generated when the entry is posted
+ALTER TABLE tb_GLRecurringEntryDetail
+    ADD COLUMN chvTaxCode VARCHAR(32) NULL AFTER decForeignAmount;
@@ -0,0 +1,4 @@
+use mledger;
+
+-- fixed assets are kept in tb_GLFixedAsset, created by running tb_GLFixedAsset.sql
+-- depreciation posted for fixed assets is kept in tb_GLDepreciationEntry, created by running tb_GLDepreciationEntry.sql